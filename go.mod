@@ -5,13 +5,11 @@ go 1.23
 require (
 	github.com/blang/semver/v4 v4.0.0
 	github.com/eclipse/paho.mqtt.golang v1.5.0
-
 	github.com/edgexfoundry/go-mod-bootstrap/v4 v4.0.0-dev.29
 	github.com/edgexfoundry/go-mod-configuration/v4 v4.0.0-dev.14
-	github.com/edgexfoundry/go-mod-core-contracts/v4 v4.0.0-dev.33
+	github.com/edgexfoundry/go-mod-core-contracts/v4 v4.0.0
 	github.com/edgexfoundry/go-mod-messaging/v4 v4.0.0-dev.18
 	github.com/edgexfoundry/go-mod-secrets/v4 v4.0.0-dev.12
-
 	github.com/fxamacker/cbor/v2 v2.7.0
 	github.com/go-co-op/gocron/v2 v2.16.0
 	github.com/gomodule/redigo v1.9.2
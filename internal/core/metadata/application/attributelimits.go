@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// deviceResourceAttributeLimitsValidation validates the Attributes of the device resource against the
+// MaxAttributeDepth and MaxAttributeKeys limitations, 0 disables the limitation
+func deviceResourceAttributeLimitsValidation(r models.DeviceResource, dic *di.Container) errors.EdgeX {
+	writable := container.ConfigurationFrom(dic.Get).Writable
+	if writable.MaxAttributeDepth == 0 && writable.MaxAttributeKeys == 0 || len(r.Attributes) == 0 {
+		return nil
+	}
+	depth, keys := attributeDepthAndKeys(r.Attributes)
+	if writable.MaxAttributeDepth > 0 && depth > writable.MaxAttributeDepth {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("DeviceResource %s attributes are nested %d levels deep, which exceeds the maximum limitation %d", r.Name, depth, writable.MaxAttributeDepth), nil)
+	}
+	if writable.MaxAttributeKeys > 0 && keys > writable.MaxAttributeKeys {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("DeviceResource %s attributes have %d keys, which exceeds the maximum limitation %d", r.Name, keys, writable.MaxAttributeKeys), nil)
+	}
+	return nil
+}
+
+func deviceProfileAttributeLimitsValidation(p models.DeviceProfile, dic *di.Container) errors.EdgeX {
+	for _, r := range p.DeviceResources {
+		if err := deviceResourceAttributeLimitsValidation(r, dic); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
+
+// attributeDepthAndKeys returns the nesting depth of the attribute value, where the top-level Attributes map is 1 level
+// deep and every nested map or array adds a level, along with the number of the map keys at all the levels
+func attributeDepthAndKeys(value any) (depth uint32, keys uint32) {
+	var children []any
+	switch v := value.(type) {
+	case map[string]any:
+		keys = uint32(len(v))
+		for _, child := range v {
+			children = append(children, child)
+		}
+	case map[string]string:
+		return 1, uint32(len(v))
+	case []any:
+		children = v
+	default:
+		return 0, 0
+	}
+	var deepest uint32
+	for _, child := range children {
+		childDepth, childKeys := attributeDepthAndKeys(child)
+		deepest = max(deepest, childDepth)
+		keys += childKeys
+	}
+	return deepest + 1, keys
+}
@@ -0,0 +1,88 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// nestedAttributes returns the attributes nested depth levels deep, with one key at each level
+func nestedAttributes(depth int) map[string]any {
+	attributes := map[string]any{"leaf": 1}
+	for i := 1; i < depth; i++ {
+		attributes = map[string]any{"nested": attributes}
+	}
+	return attributes
+}
+
+func TestAttributeDepthAndKeys(t *testing.T) {
+	tests := []struct {
+		name          string
+		attributes    any
+		expectedDepth uint32
+		expectedKeys  uint32
+	}{
+		{"flat", map[string]any{"primaryTable": "COILS", "startingAddress": 1}, 1, 2},
+		{"nested map", map[string]any{"register": map[string]any{"address": 1, "count": 2}}, 2, 3},
+		{"nested array of maps", map[string]any{"registers": []any{map[string]any{"address": 1}, map[string]any{"address": 2}}}, 3, 3},
+		{"pathologically deep", nestedAttributes(1000), 1000, 1000},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			depth, keys := attributeDepthAndKeys(testCase.attributes)
+			assert.Equal(t, testCase.expectedDepth, depth)
+			assert.Equal(t, testCase.expectedKeys, keys)
+		})
+	}
+}
+
+func TestDeviceProfileAttributeLimitsValidation(t *testing.T) {
+	dic := labelsTestDic(false, &mocks.DBClient{})
+	configuration := container.ConfigurationFrom(dic.Get)
+	profile := func(attributes map[string]any) models.DeviceProfile {
+		return models.DeviceProfile{
+			Name:            "sensor",
+			DeviceResources: []models.DeviceResource{{Name: "flat"}, {Name: "temperature", Attributes: attributes}},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		maxDepth      uint32
+		maxKeys       uint32
+		attributes    map[string]any
+		expectedError bool
+	}{
+		{"valid - limitations disabled", 0, 0, nestedAttributes(1000), false},
+		{"valid - within the limitations", 3, 3, nestedAttributes(3), false},
+		{"invalid - pathologically deep", 8, 0, nestedAttributes(1000), true},
+		{"invalid - too many keys", 0, 2, map[string]any{"a": 1, "b": 2, "c": 3}, true},
+		{"invalid - too many nested keys", 0, 2, map[string]any{"a": map[string]any{"b": 1, "c": 2}}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			configuration.Writable.MaxAttributeDepth = testCase.maxDepth
+			configuration.Writable.MaxAttributeKeys = testCase.maxKeys
+			err := deviceProfileAttributeLimitsValidation(profile(testCase.attributes), dic)
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				assert.Contains(t, err.Error(), "temperature")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
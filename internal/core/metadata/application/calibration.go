@@ -0,0 +1,147 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// CalibrationKey is the key of the calibration block in the ResourceProperties.Optional of the field-calibrated device
+// resource. The block is a map of the CalibrationCoefficientsKey, CalibrationDateKey and CalibrationOperatorKey, e.g.
+// {"coefficients": {"gain": 1.02, "offset": -0.5}, "date": "2025-03-01", "operator": "jdoe"}. The coefficients are a
+// non-empty map of the numbers, the date is required as an RFC 3339 timestamp or a YYYY-MM-DD date, and the operator is
+// optional. core-metadata only validates and stores the block, the calibration is applied downstream.
+const CalibrationKey = "calibration"
+
+const (
+	CalibrationCoefficientsKey = "coefficients"
+	CalibrationDateKey         = "date"
+	CalibrationOperatorKey     = "operator"
+)
+
+// calibrationPageSize is the number of the device profiles queried per page to find the stale calibrations
+const calibrationPageSize = 100
+
+// StaleCalibration is a device resource whose calibration is older than the calibration max age
+type StaleCalibration struct {
+	ProfileName     string `json:"profileName"`
+	ResourceName    string `json:"resourceName"`
+	CalibrationDate string `json:"calibrationDate"`
+	Operator        string `json:"operator,omitempty"`
+}
+
+// parseCalibrationDate parses the calibration date as an RFC 3339 timestamp or a YYYY-MM-DD date
+func parseCalibrationDate(date string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, date); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, date)
+}
+
+func deviceResourceCalibrationValidation(r models.DeviceResource) errors.EdgeX {
+	value, ok := r.Properties.Optional[CalibrationKey]
+	if !ok || value == nil {
+		return nil
+	}
+	calibration, ok := value.(map[string]any)
+	if !ok {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s calibration %v is not an object", r.Name, value), nil)
+	}
+
+	coefficients, ok := calibration[CalibrationCoefficientsKey].(map[string]any)
+	if !ok || len(coefficients) == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s calibration requires the non-empty coefficients, but coefficients is %v", r.Name, calibration[CalibrationCoefficientsKey]), nil)
+	}
+	for name, coefficient := range coefficients {
+		if _, ok := optionalNumber(coefficient); !ok {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s calibration coefficient %s %v is not a number", r.Name, name, coefficient), nil)
+		}
+	}
+	date, ok := calibration[CalibrationDateKey].(string)
+	if !ok {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s calibration requires the date, but date is %v", r.Name, calibration[CalibrationDateKey]), nil)
+	}
+	if _, err := parseCalibrationDate(date); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s calibration date %s is neither an RFC 3339 timestamp nor a YYYY-MM-DD date", r.Name, date), err)
+	}
+	if operatorValue, ok := calibration[CalibrationOperatorKey]; ok && operatorValue != nil {
+		if operator, ok := operatorValue.(string); !ok || strings.TrimSpace(operator) == "" {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s calibration operator %v is not a non-empty string", r.Name, operatorValue), nil)
+		}
+	}
+
+	return nil
+}
+
+// staleCalibration returns the report entry of the device resource if its calibration date is before the cutoff
+func staleCalibration(profileName string, r models.DeviceResource, cutoff time.Time) (StaleCalibration, bool) {
+	calibration, ok := r.Properties.Optional[CalibrationKey].(map[string]any)
+	if !ok {
+		return StaleCalibration{}, false
+	}
+	date, _ := calibration[CalibrationDateKey].(string)
+	calibrated, err := parseCalibrationDate(date)
+	if err != nil || !calibrated.Before(cutoff) {
+		return StaleCalibration{}, false
+	}
+	operator, _ := calibration[CalibrationOperatorKey].(string)
+	return StaleCalibration{ProfileName: profileName, ResourceName: r.Name, CalibrationDate: date, Operator: operator}, true
+}
+
+// DeviceResourcesWithStaleCalibration returns the device resources whose calibration is older than the maxAge across the
+// device profiles, ordered as the device profiles are queried. The empty maxAge falls back to the
+// Writable.CalibrationMaxAge. The device profiles are queried page by page, and only the report entries in the offset
+// and limit range are kept.
+func DeviceResourcesWithStaleCalibration(maxAge string, offset, limit int, dic *di.Container) (stale []StaleCalibration, totalCount uint32, err errors.EdgeX) {
+	if maxAge == "" {
+		maxAge = container.ConfigurationFrom(dic.Get).Writable.CalibrationMaxAge
+	}
+	if maxAge == "" {
+		return nil, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "calibration max age is neither specified nor configured by CalibrationMaxAge", nil)
+	}
+	age, parseErr := time.ParseDuration(maxAge)
+	if parseErr != nil || age <= 0 {
+		return nil, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("calibration max age %s is not a positive duration", maxAge), parseErr)
+	}
+	cutoff := time.Now().Add(-age)
+
+	dbClient := container.DBClientFrom(dic.Get)
+	stale = []StaleCalibration{}
+	for profileOffset := 0; ; profileOffset += calibrationPageSize {
+		profiles, err := dbClient.AllDeviceProfiles(profileOffset, calibrationPageSize, nil)
+		if err != nil {
+			return stale, totalCount, errors.NewCommonEdgeXWrapper(err)
+		}
+		for _, p := range profiles {
+			for _, r := range p.DeviceResources {
+				entry, ok := staleCalibration(p.Name, r, cutoff)
+				if !ok {
+					continue
+				}
+				if int(totalCount) >= offset && (limit < 0 || len(stale) < limit) {
+					stale = append(stale, entry)
+				}
+				totalCount++
+			}
+		}
+		if len(profiles) < calibrationPageSize {
+			break
+		}
+	}
+	if _, err = utils.CheckCountRange(totalCount, offset, limit); err != nil {
+		return []StaleCalibration{}, totalCount, err
+	}
+	return stale, totalCount, nil
+}
@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func calibratedResource(name string, date string) models.DeviceResource {
+	return models.DeviceResource{Name: name, Properties: models.ResourceProperties{
+		ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R,
+		Optional: map[string]any{CalibrationKey: map[string]any{
+			CalibrationCoefficientsKey: map[string]any{"gain": 1.02, "offset": -0.5},
+			CalibrationDateKey:         date,
+			CalibrationOperatorKey:     "jdoe",
+		}},
+	}}
+}
+
+func TestDeviceResourceCalibrationValidation(t *testing.T) {
+	coefficients := map[string]any{"gain": 1.02}
+	tests := []struct {
+		name          string
+		calibration   any
+		expectedError bool
+	}{
+		{"valid - date", map[string]any{CalibrationCoefficientsKey: coefficients, CalibrationDateKey: "2025-03-01"}, false},
+		{"valid - timestamp with operator", map[string]any{CalibrationCoefficientsKey: coefficients, CalibrationDateKey: "2025-03-01T08:30:00Z", CalibrationOperatorKey: "jdoe"}, false},
+		{"invalid - not an object", "gain=1.02", true},
+		{"invalid - no coefficients", map[string]any{CalibrationDateKey: "2025-03-01"}, true},
+		{"invalid - empty coefficients", map[string]any{CalibrationCoefficientsKey: map[string]any{}, CalibrationDateKey: "2025-03-01"}, true},
+		{"invalid - coefficient not a number", map[string]any{CalibrationCoefficientsKey: map[string]any{"gain": "high"}, CalibrationDateKey: "2025-03-01"}, true},
+		{"invalid - no date", map[string]any{CalibrationCoefficientsKey: coefficients}, true},
+		{"invalid - date", map[string]any{CalibrationCoefficientsKey: coefficients, CalibrationDateKey: "2025-13-01"}, true},
+		{"invalid - empty operator", map[string]any{CalibrationCoefficientsKey: coefficients, CalibrationDateKey: "2025-03-01", CalibrationOperatorKey: " "}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			resource := models.DeviceResource{Name: "temperature", Properties: models.ResourceProperties{
+				ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R, Optional: map[string]any{CalibrationKey: testCase.calibration},
+			}}
+			err := deviceResourceOptionalPropertiesValidation(resource)
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				assert.Contains(t, err.Error(), resource.Name)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeviceResourcesWithStaleCalibration(t *testing.T) {
+	recent := time.Now().AddDate(0, -1, 0).Format(time.DateOnly)
+	old := time.Now().AddDate(-2, 0, 0).Format(time.RFC3339)
+	profile := models.DeviceProfile{Name: "thermostat", DeviceResources: []models.DeviceResource{
+		calibratedResource("recent", recent),
+		calibratedResource("old", old),
+		{Name: "uncalibrated", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32}},
+	}}
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AllDeviceProfiles", 0, calibrationPageSize, []string(nil)).Return([]models.DeviceProfile{profile}, nil)
+	dic := labelsTestDic(false, dbClientMock)
+
+	_, _, err := DeviceResourcesWithStaleCalibration("", 0, -1, dic)
+	require.Error(t, err, "the max age is neither specified nor configured")
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	container.ConfigurationFrom(dic.Get).Writable.CalibrationMaxAge = "8760h"
+	stale, totalCount, err := DeviceResourcesWithStaleCalibration("", 0, -1, dic)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), totalCount)
+	assert.Equal(t, []StaleCalibration{{ProfileName: "thermostat", ResourceName: "old", CalibrationDate: old, Operator: "jdoe"}}, stale)
+
+	stale, totalCount, err = DeviceResourcesWithStaleCalibration("24h", 0, -1, dic)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), totalCount, "the specified max age should override the CalibrationMaxAge")
+	assert.Equal(t, "recent", stale[0].ResourceName)
+
+	_, _, err = DeviceResourcesWithStaleCalibration("a year", 0, -1, dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
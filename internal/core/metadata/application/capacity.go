@@ -14,6 +14,62 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
+// ResourceCapacity reports the resource capacity of a device profile against the MaxResources limitation
+type ResourceCapacity struct {
+	ProfileName string `json:"profileName"`
+	// ResourceCount is the number of the device resources of the profile
+	ResourceCount uint32 `json:"resourceCount"`
+	// InUse is whether the profile is used by any device, only the update of the profile in use is checked against the
+	// MaxResources limitation, the other profiles are checked when a device starts to use them
+	InUse bool `json:"inUse"`
+	// InUseResourceCount is the total number of the device resources in use by all the devices
+	InUseResourceCount uint32 `json:"inUseResourceCount"`
+	// MaxResources is the configured MaxResources, 0 is unlimited
+	MaxResources uint32 `json:"maxResources"`
+	// Unlimited is whether the resources are not limited, and then Remaining is meaningless
+	Unlimited bool `json:"unlimited"`
+	// Remaining is the number of the device resources that can be added before the MaxResources limitation is exceeded
+	Remaining uint32 `json:"remaining"`
+}
+
+// ProfileResourceCapacity returns the resource capacity of the device profile, so the clients can check the profile
+// update against the MaxResources limitation before submitting it. Nothing is written.
+func ProfileResourceCapacity(profileName string, dic *di.Container) (ResourceCapacity, errors.EdgeX) {
+	capacity := ResourceCapacity{ProfileName: profileName}
+	if profileName == "" {
+		return capacity, errors.NewCommonEdgeX(errors.KindContractInvalid, "profile name is empty", nil)
+	}
+	config := container.ConfigurationFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+	lock := container.CapacityCheckLockFrom(dic.Get)
+	lock.Lock()
+	defer lock.Unlock()
+
+	var err errors.EdgeX
+	capacity.ResourceCount, err = resourceCountByProfile(profileName, dic)
+	if err != nil {
+		return capacity, errors.NewCommonEdgeX(errors.Kind(err), "get resource count failed", err)
+	}
+	capacity.InUse, err = isProfileInUse(profileName, dic)
+	if err != nil {
+		return capacity, errors.NewCommonEdgeX(errors.Kind(err), "check profile in use failed", err)
+	}
+	capacity.InUseResourceCount, err = dbClient.InUseResourceCount()
+	if err != nil {
+		return capacity, errors.NewCommonEdgeX(errors.Kind(err), "query in use resource count failed", err)
+	}
+	capacity.MaxResources = config.Writable.MaxResources
+	if capacity.MaxResources == 0 {
+		capacity.Unlimited = true
+		return capacity, nil
+	}
+	// the in use resources may exceed the limitation lowered after they are added
+	if capacity.InUseResourceCount < capacity.MaxResources {
+		capacity.Remaining = capacity.MaxResources - capacity.InUseResourceCount
+	}
+	return capacity, nil
+}
+
 func checkCapacityWithNewDevice(d models.Device, dic *di.Container) errors.EdgeX {
 	config := container.ConfigurationFrom(dic.Get)
 	dbClient := container.DBClientFrom(dic.Get)
@@ -154,3 +210,17 @@ func resourceCountByProfile(profileName string, dic *di.Container) (uint32, erro
 	}
 	return uint32(len(profile.DeviceResources)), nil
 }
+
+// checkCommandCapacity checks the device commands of the profile against the MaxCommands limitation, 0 is unlimited
+func checkCommandCapacity(profile models.DeviceProfile, dic *di.Container) errors.EdgeX {
+	maxCommands := container.ConfigurationFrom(dic.Get).Writable.MaxCommands
+	if maxCommands == 0 {
+		return nil
+	}
+	if count := uint32(len(profile.DeviceCommands)); count > maxCommands {
+		return errors.NewCommonEdgeX(
+			errors.KindStatusConflict,
+			fmt.Sprintf("device profile '%s' has '%d' commands, which exceeds the maximum limitation '%d'", profile.Name, count, maxCommands), nil)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileResourceCapacity(t *testing.T) {
+	profile := models.DeviceProfile{Name: "thermostat", DeviceResources: []models.DeviceResource{{Name: "a"}, {Name: "b"}}}
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile doesn't exist", nil)
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", profile.Name).Return(profile, nil)
+	dbClientMock.On("DeviceProfileByName", "unknown").Return(models.DeviceProfile{}, notFound)
+	dbClientMock.On("DeviceCountByProfileName", profile.Name).Return(uint32(1), nil)
+	dbClientMock.On("InUseResourceCount").Return(uint32(8), nil)
+	dic := labelsTestDic(false, dbClientMock)
+	dic.Update(di.ServiceConstructorMap{
+		container.CapacityCheckLockName: func(get di.Get) interface{} {
+			return utils.NewCapacityCheckLock()
+		},
+	})
+	configuration := container.ConfigurationFrom(dic.Get)
+
+	tests := []struct {
+		name              string
+		maxResources      uint32
+		expectedUnlimited bool
+		expectedRemaining uint32
+	}{
+		{"unlimited", 0, true, 0},
+		{"headroom", 10, false, 2},
+		{"full", 8, false, 0},
+		{"exceeded by lowered limitation", 5, false, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			configuration.Writable.MaxResources = testCase.maxResources
+			capacity, err := ProfileResourceCapacity(profile.Name, dic)
+			require.NoError(t, err)
+			assert.Equal(t, profile.Name, capacity.ProfileName)
+			assert.Equal(t, uint32(2), capacity.ResourceCount)
+			assert.True(t, capacity.InUse)
+			assert.Equal(t, uint32(8), capacity.InUseResourceCount)
+			assert.Equal(t, testCase.maxResources, capacity.MaxResources)
+			assert.Equal(t, testCase.expectedUnlimited, capacity.Unlimited)
+			assert.Equal(t, testCase.expectedRemaining, capacity.Remaining)
+		})
+	}
+
+	_, err := ProfileResourceCapacity("unknown", dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+
+	_, err = ProfileResourceCapacity("", dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	dbClientMock.AssertNotCalled(t, "UpdateDeviceProfile", mock.Anything)
+}
+
+func TestCheckCommandCapacity(t *testing.T) {
+	profile := models.DeviceProfile{Name: "thermostat", DeviceCommands: []models.DeviceCommand{{Name: "a"}, {Name: "b"}, {Name: "c"}}}
+	dic := labelsTestDic(false, &mocks.DBClient{})
+	configuration := container.ConfigurationFrom(dic.Get)
+
+	tests := []struct {
+		name          string
+		maxCommands   uint32
+		expectedError bool
+	}{
+		{"unlimited", 0, false},
+		{"headroom", 4, false},
+		{"full", 3, false},
+		{"exceeded", 2, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			configuration.Writable.MaxCommands = testCase.maxCommands
+			err := checkCommandCapacity(profile, dic)
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindStatusConflict, errors.Kind(err))
+				assert.Contains(t, err.Error(), "'3' commands")
+				assert.Contains(t, err.Error(), "maximum limitation '2'")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
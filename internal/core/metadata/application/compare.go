@@ -0,0 +1,157 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// compareProfilesPageSize is the number of the device profiles queried per page from each side of the comparison
+const compareProfilesPageSize = 100
+
+// DeviceProfilesComparison is the drift between the device profiles of the local and a remote core-metadata service
+type DeviceProfilesComparison struct {
+	// LocalOnly and RemoteOnly are the names of the device profiles only present on one side
+	LocalOnly  []string `json:"localOnly,omitempty"`
+	RemoteOnly []string `json:"remoteOnly,omitempty"`
+	// Different are the device profiles present on both sides with different content
+	Different []DeviceProfileDifference `json:"different,omitempty"`
+}
+
+// DeviceProfileDifference is the content difference of a device profile present on both sides. The changes are from
+// the local device profile to the remote one, e.g. the added resources are only present on the remote side.
+type DeviceProfileDifference struct {
+	Name string `json:"name"`
+	// ModifiedFields are the basic info fields with different values, e.g. Manufacturer or Labels
+	ModifiedFields []string             `json:"modifiedFields,omitempty"`
+	Changes        DeviceProfileChanges `json:"changes"`
+}
+
+// CompareProfilesWithRemote compares the device profiles with the device profiles of the remote core-metadata service.
+// Both sides are queried page by page, and only the digests of the local device profiles are kept in memory, so the
+// full content is only queried again for the device profiles which differ.
+func CompareProfilesWithRemote(remoteClient interfaces.DeviceProfileClient, dic *di.Container) (DeviceProfilesComparison, errors.EdgeX) {
+	var comparison DeviceProfilesComparison
+	ctx := context.Background()
+	dbClient := container.DBClientFrom(dic.Get)
+
+	localDigests := make(map[string][sha256.Size]byte)
+	var localNames []string
+	for offset := 0; ; offset += compareProfilesPageSize {
+		profiles, err := dbClient.AllDeviceProfiles(offset, compareProfilesPageSize, nil)
+		if err != nil {
+			return comparison, errors.NewCommonEdgeX(errors.Kind(err), "failed to query the local device profiles", err)
+		}
+		for _, p := range profiles {
+			digest, err := deviceProfileDigest(dtos.FromDeviceProfileModelToDTO(p))
+			if err != nil {
+				return comparison, errors.NewCommonEdgeXWrapper(err)
+			}
+			localDigests[p.Name] = digest
+			localNames = append(localNames, p.Name)
+		}
+		if len(profiles) < compareProfilesPageSize {
+			break
+		}
+	}
+
+	remoteNames := make(map[string]bool)
+	for offset := 0; ; offset += compareProfilesPageSize {
+		res, err := remoteClient.AllDeviceProfiles(ctx, nil, offset, compareProfilesPageSize)
+		if err != nil {
+			return comparison, errors.NewCommonEdgeX(errors.Kind(err), "failed to query the remote device profiles", err)
+		}
+		for _, remote := range res.Profiles {
+			remoteNames[remote.Name] = true
+			localDigest, ok := localDigests[remote.Name]
+			if !ok {
+				comparison.RemoteOnly = append(comparison.RemoteOnly, remote.Name)
+				continue
+			}
+			remoteDigest, err := deviceProfileDigest(remote)
+			if err != nil {
+				return comparison, errors.NewCommonEdgeXWrapper(err)
+			}
+			if remoteDigest == localDigest {
+				continue
+			}
+			local, err := dbClient.DeviceProfileByName(remote.Name)
+			if err != nil {
+				return comparison, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query the local device profile %s", remote.Name), err)
+			}
+			comparison.Different = append(comparison.Different, deviceProfileDifference(dtos.FromDeviceProfileModelToDTO(local), remote))
+		}
+		if len(res.Profiles) < compareProfilesPageSize {
+			break
+		}
+	}
+
+	for _, name := range localNames {
+		if !remoteNames[name] {
+			comparison.LocalOnly = append(comparison.LocalOnly, name)
+		}
+	}
+	slices.Sort(comparison.LocalOnly)
+	slices.Sort(comparison.RemoteOnly)
+	slices.SortFunc(comparison.Different, func(a, b DeviceProfileDifference) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return comparison, nil
+}
+
+// deviceProfileDigest returns the digest of the device profile content, which excludes the id and the timestamps
+// assigned by each service
+func deviceProfileDigest(p dtos.DeviceProfile) ([sha256.Size]byte, errors.EdgeX) {
+	p.Id = ""
+	p.DBTimestamp = dtos.DBTimestamp{}
+	p.ApiVersion = ""
+	data, err := json.Marshal(p)
+	if err != nil {
+		return [sha256.Size]byte{}, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to encode the device profile %s", p.Name), err)
+	}
+	return sha256.Sum256(data), nil
+}
+
+// DeviceProfileDiff returns the difference of the incoming device profile from the stored device profile of the same
+// name, e.g. to review an update before it replaces the whole stored profile. The device resources and device commands
+// are matched by name, so a renamed one is reported as removed and added.
+func DeviceProfileDiff(incoming models.DeviceProfile, dic *di.Container) (DeviceProfileDifference, errors.EdgeX) {
+	stored, err := container.DBClientFrom(dic.Get).DeviceProfileByName(incoming.Name)
+	if err != nil {
+		return DeviceProfileDifference{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return deviceProfileDifference(dtos.FromDeviceProfileModelToDTO(stored), dtos.FromDeviceProfileModelToDTO(incoming)), nil
+}
+
+func deviceProfileDifference(local, remote dtos.DeviceProfile) DeviceProfileDifference {
+	difference := DeviceProfileDifference{Name: local.Name, Changes: diffDeviceProfiles(local, remote)}
+	if local.Manufacturer != remote.Manufacturer {
+		difference.ModifiedFields = append(difference.ModifiedFields, "Manufacturer")
+	}
+	if local.Description != remote.Description {
+		difference.ModifiedFields = append(difference.ModifiedFields, "Description")
+	}
+	if local.Model != remote.Model {
+		difference.ModifiedFields = append(difference.ModifiedFields, "Model")
+	}
+	if !slices.Equal(local.Labels, remote.Labels) {
+		difference.ModifiedFields = append(difference.ModifiedFields, "Labels")
+	}
+	return difference
+}
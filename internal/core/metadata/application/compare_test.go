@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+
+	clientMocks "github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareProfilesWithRemote(t *testing.T) {
+	resource := models.DeviceResource{Name: "temperature", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R}}
+	same := models.DeviceProfile{Id: "local-id-1", Name: "same", Manufacturer: "IOTech", DeviceResources: []models.DeviceResource{resource}}
+	drifted := models.DeviceProfile{Id: "local-id-2", Name: "drifted", Manufacturer: "IOTech", DeviceResources: []models.DeviceResource{resource}}
+	localOnly := models.DeviceProfile{Id: "local-id-3", Name: "localOnly", DeviceResources: []models.DeviceResource{resource}}
+
+	remoteSame := dtos.FromDeviceProfileModelToDTO(same)
+	remoteSame.Id = "remote-id-1"
+	remoteSame.Created = 1
+	remoteDrifted := dtos.FromDeviceProfileModelToDTO(drifted)
+	remoteDrifted.Manufacturer = "Other"
+	remoteDrifted.DeviceResources = append(remoteDrifted.DeviceResources, dtos.DeviceResource{Name: "humidity", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R}})
+	remoteOnly := dtos.FromDeviceProfileModelToDTO(models.DeviceProfile{Name: "remoteOnly", DeviceResources: []models.DeviceResource{resource}})
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AllDeviceProfiles", 0, compareProfilesPageSize, []string(nil)).Return([]models.DeviceProfile{same, drifted, localOnly}, nil)
+	dbClientMock.On("DeviceProfileByName", drifted.Name).Return(drifted, nil)
+	remoteClient := &clientMocks.DeviceProfileClient{}
+	remoteClient.On("AllDeviceProfiles", mock.Anything, []string(nil), 0, compareProfilesPageSize).Return(
+		responses.NewMultiDeviceProfilesResponse("", "", 200, 3, []dtos.DeviceProfile{remoteSame, remoteDrifted, remoteOnly}), nil)
+
+	comparison, err := CompareProfilesWithRemote(remoteClient, labelsTestDic(false, dbClientMock))
+	require.NoError(t, err)
+	assert.Equal(t, []string{localOnly.Name}, comparison.LocalOnly)
+	assert.Equal(t, []string{remoteOnly.Name}, comparison.RemoteOnly)
+	require.Len(t, comparison.Different, 1, "the profiles differing only in the id and timestamps should be equal")
+	assert.Equal(t, drifted.Name, comparison.Different[0].Name)
+	assert.Equal(t, []string{"Manufacturer"}, comparison.Different[0].ModifiedFields)
+	assert.Equal(t, []string{"humidity"}, comparison.Different[0].Changes.AddedResources)
+}
+
+func TestDeviceProfileDiff(t *testing.T) {
+	temperature := models.DeviceResource{Name: "temperature", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R, Units: "C"}}
+	humidity := models.DeviceResource{Name: "humidity", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R}}
+	stored := models.DeviceProfile{Id: "stored-id", Name: "thermostat", Manufacturer: "IOTech", DeviceResources: []models.DeviceResource{temperature, humidity}}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", stored.Name).Return(stored, nil)
+	dbClientMock.On("DeviceProfileByName", "unknown").Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dic := labelsTestDic(false, dbClientMock)
+
+	fahrenheit := temperature
+	fahrenheit.Properties.Units = "F"
+	relativeHumidity := humidity
+	relativeHumidity.Name = "relativeHumidity"
+	incoming := models.DeviceProfile{Name: stored.Name, Manufacturer: "Dell", DeviceResources: []models.DeviceResource{fahrenheit, relativeHumidity}}
+
+	difference, err := DeviceProfileDiff(incoming, dic)
+	require.NoError(t, err)
+	assert.Equal(t, stored.Name, difference.Name)
+	assert.Equal(t, []string{"Manufacturer"}, difference.ModifiedFields)
+	assert.Equal(t, []string{"temperature"}, difference.Changes.ModifiedResources, "the changed Units should be reported as a modified resource")
+	assert.Equal(t, []string{"humidity"}, difference.Changes.RemovedResources, "the renamed resource should be reported as removed")
+	assert.Equal(t, []string{"relativeHumidity"}, difference.Changes.AddedResources, "the renamed resource should be reported as added")
+	dbClientMock.AssertNotCalled(t, "UpdateDeviceProfile", mock.Anything)
+
+	unchanged, err := DeviceProfileDiff(stored, dic)
+	require.NoError(t, err)
+	assert.Empty(t, unchanged.ModifiedFields)
+	assert.Equal(t, DeviceProfileChanges{}, unchanged.Changes)
+
+	_, err = DeviceProfileDiff(models.DeviceProfile{Name: "unknown"}, dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+}
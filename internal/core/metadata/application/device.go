@@ -106,7 +106,9 @@ func AddDevice(d models.Device, ctx context.Context, dic *di.Container, bypassVa
 	}
 
 	deviceDTO := dtos.FromDeviceModelToDTO(addedDevice)
-	go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionAdd, d.ServiceName, deviceDTO, ctx, dic)
+	if err := notifySystemEvent(common.DeviceSystemEventType, common.SystemEventActionAdd, d.ServiceName, deviceDTO, ctx, dic); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
 
 	return addedDevice.Id, nil
 }
@@ -173,7 +175,9 @@ func DeleteDeviceByName(name string, ctx context.Context, dic *di.Container) err
 	}
 
 	deviceDTO := dtos.FromDeviceModelToDTO(device)
-	go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionDelete, device.ServiceName, deviceDTO, ctx, dic)
+	if err := notifySystemEvent(common.DeviceSystemEventType, common.SystemEventActionDelete, device.ServiceName, deviceDTO, ctx, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	return nil
 }
@@ -293,10 +297,14 @@ func updateDeviceInDB(device models.Device, oldServiceName string, ctx context.C
 
 	deviceDTO := dtos.FromDeviceModelToDTO(device)
 	if oldServiceName != "" {
-		go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionUpdate, oldServiceName, deviceDTO, ctx, dic)
+		if err := notifySystemEvent(common.DeviceSystemEventType, common.SystemEventActionUpdate, oldServiceName, deviceDTO, ctx, dic); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
 	}
 
-	go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionUpdate, device.ServiceName, deviceDTO, ctx, dic)
+	if err := notifySystemEvent(common.DeviceSystemEventType, common.SystemEventActionUpdate, device.ServiceName, deviceDTO, ctx, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	return nil
 }
@@ -7,10 +7,14 @@ package application
 
 import (
 	"context"
+	"fmt"
+	"strings"
+
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -27,8 +31,15 @@ func AddDeviceProfileDeviceCommand(profileName string, deviceCommand models.Devi
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	original := cloneDeviceProfile(profile)
 
 	profile.DeviceCommands = append(profile.DeviceCommands, deviceCommand)
+	if err = deviceCommandReadWriteValidation(deviceCommand, profile.DeviceResources); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err = checkCommandCapacity(profile, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
 	validateErr := profileDTO.Validate()
@@ -42,7 +53,9 @@ func AddDeviceProfileDeviceCommand(profileName string, deviceCommand models.Devi
 	}
 
 	lc.Debugf("DeviceProfile deviceCommands added on DB successfully. Correlation-id: %s ", correlation.FromContext(ctx))
-	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
+	if err := notifyUpdateDeviceProfileSystemEvent(original, profileDTO, ctx, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	return nil
 }
@@ -55,6 +68,7 @@ func PatchDeviceProfileDeviceCommand(profileName string, dto dtos.UpdateDeviceCo
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	original := cloneDeviceProfile(profile)
 
 	// Find matched deviceCommand
 	index := -1
@@ -69,6 +83,9 @@ func PatchDeviceProfileDeviceCommand(profileName string, dto dtos.UpdateDeviceCo
 	}
 
 	requests.ReplaceDeviceCommandModelFieldsWithDTO(&profile.DeviceCommands[index], dto)
+	if err = deviceCommandReadWriteValidation(profile.DeviceCommands[index], profile.DeviceResources); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	err = dbClient.UpdateDeviceProfile(profile)
 	if err != nil {
@@ -77,7 +94,9 @@ func PatchDeviceProfileDeviceCommand(profileName string, dto dtos.UpdateDeviceCo
 
 	lc.Debugf("DeviceProfile deviceCommands patched on DB successfully. Correlation-id: %s ", correlation.FromContext(ctx))
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
-	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
+	if err := notifyUpdateDeviceProfileSystemEvent(original, profileDTO, ctx, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	return nil
 }
@@ -108,6 +127,7 @@ func DeleteDeviceCommandByName(profileName string, commandName string, ctx conte
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	original := cloneDeviceProfile(profile)
 
 	index := -1
 	for i := range profile.DeviceCommands {
@@ -132,6 +152,43 @@ func DeleteDeviceCommandByName(profileName string, commandName string, ctx conte
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
-	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
+	if err := notifyUpdateDeviceProfileSystemEvent(original, profileDTO, ctx, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// deviceCommandReadWriteValidation validates the ReadWrite of the device command is consistent with the capabilities of
+// the device resources of its resource operations, e.g. a command can't be written if one of its resources is
+// read-only. The resources not found are left to the DTO validation.
+func deviceCommandReadWriteValidation(command models.DeviceCommand, resources []models.DeviceResource) errors.EdgeX {
+	for _, ro := range command.ResourceOperations {
+		r, err := resourceByName(resources, ro.DeviceResource)
+		if err != nil {
+			continue
+		}
+		for _, access := range []struct {
+			permission string
+			capability string
+		}{
+			{common.ReadWrite_R, "readable"},
+			{common.ReadWrite_W, "writable"},
+		} {
+			if strings.Contains(command.ReadWrite, access.permission) && !strings.Contains(r.Properties.ReadWrite, access.permission) {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf(
+					"DeviceCommand %s readWrite %s conflicts with the DeviceResource %s, which is not %s as its readWrite is %s",
+					command.Name, command.ReadWrite, r.Name, access.capability, r.Properties.ReadWrite), nil)
+			}
+		}
+	}
+	return nil
+}
+
+func deviceProfileCommandReadWriteValidation(p models.DeviceProfile) errors.EdgeX {
+	for _, command := range p.DeviceCommands {
+		if err := deviceCommandReadWriteValidation(command, p.DeviceResources); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
 	return nil
 }
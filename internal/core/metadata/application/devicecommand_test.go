@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceCommandReadWriteValidation(t *testing.T) {
+	resources := []models.DeviceResource{
+		{Name: "temperature", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R}},
+		{Name: "setPoint", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_W}},
+		{Name: "mode", Properties: models.ResourceProperties{ValueType: common.ValueTypeString, ReadWrite: common.ReadWrite_RW}},
+		{Name: "fan", Properties: models.ResourceProperties{ValueType: common.ValueTypeBool, ReadWrite: common.ReadWrite_WR}},
+	}
+	command := func(readWrite string, resourceNames ...string) models.DeviceCommand {
+		c := models.DeviceCommand{Name: "thermostat", ReadWrite: readWrite}
+		for _, name := range resourceNames {
+			c.ResourceOperations = append(c.ResourceOperations, models.ResourceOperation{DeviceResource: name})
+		}
+		return c
+	}
+
+	tests := []struct {
+		name             string
+		command          models.DeviceCommand
+		expectedResource string
+	}{
+		{"valid - read command of readable resources", command(common.ReadWrite_R, "temperature", "mode", "fan"), ""},
+		{"valid - write command of writable resources", command(common.ReadWrite_W, "setPoint", "mode", "fan"), ""},
+		{"valid - read-write command of read-write resources", command(common.ReadWrite_RW, "mode", "fan"), ""},
+		{"valid - unknown resource left to the DTO validation", command(common.ReadWrite_RW, "unknown"), ""},
+		{"invalid - write command of read-only resource", command(common.ReadWrite_W, "mode", "temperature"), "temperature"},
+		{"invalid - read command of write-only resource", command(common.ReadWrite_R, "setPoint"), "setPoint"},
+		{"invalid - read-write command mixing read-only and write-only resources", command(common.ReadWrite_WR, "temperature", "setPoint"), "temperature"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := deviceCommandReadWriteValidation(testCase.command, resources)
+			if testCase.expectedResource == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			assert.Contains(t, err.Error(), testCase.command.Name)
+			assert.Contains(t, err.Error(), testCase.expectedResource)
+
+			profile := models.DeviceProfile{Name: "profile", DeviceResources: resources, DeviceCommands: []models.DeviceCommand{testCase.command}}
+			assert.Error(t, deviceProfileCommandReadWriteValidation(profile))
+		})
+	}
+}
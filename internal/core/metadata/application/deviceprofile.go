@@ -7,7 +7,11 @@ package application
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
@@ -17,6 +21,7 @@ import (
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
@@ -30,7 +35,7 @@ func AddDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Conta
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
-	err = deviceProfileUoMValidation(d, dic)
+	err = prepareDeviceProfile(&d, dic)
 	if err != nil {
 		return "", errors.NewCommonEdgeXWrapper(err)
 	}
@@ -48,11 +53,172 @@ func AddDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Conta
 	)
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(addedDeviceProfile)
-	go publishSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionAdd, common.CoreMetaDataServiceKey, profileDTO, ctx, dic)
+	if err := notifySystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionAdd, common.CoreMetaDataServiceKey, profileDTO, ctx, dic); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
 
 	return addedDeviceProfile.Id, nil
 }
 
+// deviceProfileCheck is a named server-side check of the device profile to add
+type deviceProfileCheck struct {
+	name  string
+	check func(models.DeviceProfile, *di.Container) errors.EdgeX
+}
+
+// deviceProfileChecks are the checks of the device profile to add or update, in the order they are run
+var deviceProfileChecks = []deviceProfileCheck{
+	{"unitsOfMeasure", deviceProfileUoMValidation},
+	{"optionalProperties", func(d models.DeviceProfile, _ *di.Container) errors.EdgeX {
+		return deviceProfileOptionalPropertiesValidation(d)
+	}},
+	{"engineeringRange", deviceProfileEngineeringRangeValidation},
+	{"scaling", func(d models.DeviceProfile, _ *di.Container) errors.EdgeX { return deviceProfileScalingValidation(d) }},
+	{"readWrite", func(d models.DeviceProfile, _ *di.Container) errors.EdgeX {
+		return deviceProfileCommandReadWriteValidation(d)
+	}},
+	{"protocolAttributes", deviceProfileProtocolAttributesValidation},
+	{"attributeLimits", deviceProfileAttributeLimitsValidation},
+	{"sampleInterval", deviceProfileSampleIntervalBoundsValidation},
+	{"commandCapacity", checkCommandCapacity},
+}
+
+// prepareDeviceProfile applies the defaults and the normalizations to the device profile to add, and validates it
+func prepareDeviceProfile(d *models.DeviceProfile, dic *di.Container) errors.EdgeX {
+	applyDefaultLabels(d, false, dic)
+	normalizeDeviceProfileLabels(d, dic)
+	inferDeviceProfileResourceDefaults(d, dic)
+
+	err := normalizeDeviceProfileDefaultValues(d)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, c := range deviceProfileChecks {
+		if err = c.check(*d, dic); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
+
+// DeviceProfileCheckFailure is a failed server-side check of the device profile
+type DeviceProfileCheckFailure struct {
+	Check   string `json:"check"`
+	Message string `json:"message"`
+}
+
+// DeviceProfileValidationResult is the result of the dry-run validation of the device profile
+type DeviceProfileValidationResult struct {
+	Valid    bool                        `json:"valid"`
+	Failures []DeviceProfileCheckFailure `json:"failures,omitempty"`
+}
+
+// ValidateDeviceProfile runs the server-side checks of AddDeviceProfile on the device profile without adding it, so
+// nothing is written and no system event is published. Every check is run, rather than stopping at the first failure,
+// and each failed check is reported. The error is only returned when the checks can't be run, e.g. on a DB failure.
+func ValidateDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Container) (DeviceProfileValidationResult, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	var result DeviceProfileValidationResult
+
+	exists, err := dbClient.DeviceProfileNameExists(d.Name)
+	if err != nil {
+		return result, errors.NewCommonEdgeXWrapper(err)
+	}
+	if exists {
+		result.Failures = append(result.Failures, DeviceProfileCheckFailure{Check: "duplicateName", Message: fmt.Sprintf("device profile name %s already exists", d.Name)})
+	}
+
+	applyDefaultLabels(&d, false, dic)
+	normalizeDeviceProfileLabels(&d, dic)
+	inferDeviceProfileResourceDefaults(&d, dic)
+	if err = normalizeDeviceProfileDefaultValues(&d); err != nil {
+		result.Failures = append(result.Failures, DeviceProfileCheckFailure{Check: "defaultValues", Message: err.Message()})
+	}
+	for _, c := range deviceProfileChecks {
+		if err = c.check(d, dic); err != nil {
+			result.Failures = append(result.Failures, DeviceProfileCheckFailure{Check: c.name, Message: err.Message()})
+		}
+	}
+
+	result.Valid = len(result.Failures) == 0
+	lc.Debugf("DeviceProfile %s is validated with %d failed checks. Correlation-id: %s ", d.Name, len(result.Failures), correlation.FromContext(ctx))
+	return result, nil
+}
+
+// AddDeviceProfiles adds the device profiles atomically, so either all or none of them are added. Every profile is
+// validated before any of them is stored, and the validation failures of all the profiles are reported together, each
+// identified by its index and name. The system event of each added profile is published after the profiles are stored.
+// If Writable.SystemEvent.FailOperationOnPublishError is enabled, the events are published synchronously and the publish
+// failures of all the profiles are returned together with the ids, since the profiles are already stored.
+func AddDeviceProfiles(profiles []models.DeviceProfile, ctx context.Context, dic *di.Container) (ids []string, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	if len(profiles) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "no device profiles to add", nil)
+	}
+
+	// the profiles are normalized in place, which shouldn't change the profiles of the caller
+	profiles = slices.Clone(profiles)
+	var failures []string
+	names := make(map[string]int, len(profiles))
+	for i := range profiles {
+		if first, ok := names[profiles[i].Name]; ok {
+			failures = append(failures, fmt.Sprintf("[%d] %s: duplicates the device profile name of [%d]", i, profiles[i].Name, first))
+			continue
+		}
+		names[profiles[i].Name] = i
+		if err := prepareDeviceProfile(&profiles[i], dic); err != nil {
+			failures = append(failures, fmt.Sprintf("[%d] %s: %s", i, profiles[i].Name, err.Message()))
+		}
+	}
+	if len(failures) > 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("%d of %d device profiles are invalid, none is added: %s", len(failures), len(profiles), strings.Join(failures, "; ")), nil)
+	}
+
+	correlationId := correlation.FromContext(ctx)
+	addedDeviceProfiles, err := dbClient.AddDeviceProfiles(profiles)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	ids = make([]string, len(addedDeviceProfiles))
+	var publishFailures []string
+	var publishErrKind errors.ErrKind
+	for i, addedDeviceProfile := range addedDeviceProfiles {
+		ids[i] = addedDeviceProfile.Id
+		lc.Debugf(
+			"DeviceProfile created on DB successfully. DeviceProfile-id: %s, Correlation-id: %s ",
+			addedDeviceProfile.Id,
+			correlationId,
+		)
+		profileDTO := dtos.FromDeviceProfileModelToDTO(addedDeviceProfile)
+		if err := notifySystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionAdd, common.CoreMetaDataServiceKey, profileDTO, ctx, dic); err != nil {
+			if publishErrKind == "" {
+				publishErrKind = errors.Kind(err)
+			}
+			publishFailures = append(publishFailures, fmt.Sprintf("[%d] %s: %s", i, addedDeviceProfile.Name, err.Error()))
+		}
+	}
+	if len(publishFailures) > 0 {
+		return ids, errors.NewCommonEdgeX(publishErrKind,
+			fmt.Sprintf("%d device profiles are added, but %d of their system events failed to publish: %s", len(ids), len(publishFailures), strings.Join(publishFailures, "; ")), nil)
+	}
+	return ids, nil
+}
+
+// logDeviceProfileUpdateDiff logs the difference of the updated device profile from the stored one at the Debug level
+func logDeviceProfileUpdateDiff(original, updated models.DeviceProfile, ctx context.Context, lc logger.LoggingClient) {
+	difference := deviceProfileDifference(dtos.FromDeviceProfileModelToDTO(original), dtos.FromDeviceProfileModelToDTO(updated))
+	data, err := json.Marshal(difference)
+	if err != nil {
+		lc.Warnf("failed to encode the difference of the updated DeviceProfile %s: %v", updated.Name, err)
+		return
+	}
+	lc.Debugf("DeviceProfile %s is updated with the difference %s. Correlation-id: %s ", updated.Name, data, correlation.FromContext(ctx))
+}
+
 // The UpdateDeviceProfile function accepts the device profile model from the controller functions
 // and invokes updateDeviceProfile function in the infrastructure layer
 func UpdateDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Container) (err errors.EdgeX) {
@@ -60,10 +226,19 @@ func UpdateDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Co
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	config := container.ConfigurationFrom(dic.Get)
 
-	err = deviceProfileUoMValidation(d, dic)
+	applyDefaultLabels(&d, true, dic)
+	normalizeDeviceProfileLabels(&d, dic)
+	inferDeviceProfileResourceDefaults(&d, dic)
+
+	err = normalizeDeviceProfileDefaultValues(&d)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	for _, c := range deviceProfileChecks {
+		if err = c.check(d, dic); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
 
 	if config.Writable.MaxResources > 0 {
 		if err = checkResourceCapacityByUpdateProfile(d, dic); err != nil {
@@ -71,6 +246,23 @@ func UpdateDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Co
 		}
 	}
 
+	var original models.DeviceProfile
+	if config.Writable.SystemEvent.IncludeProfileChanges || config.Writable.ProfileChangeNotifications.Enabled || config.Writable.LogProfileUpdateDiff ||
+		config.Writable.ProfileChange.StrictResourceRemoval || config.Writable.ResourceHistoryLimit > 0 {
+		original, err = dbClient.DeviceProfileByName(d.Name)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if config.Writable.ProfileChange.StrictResourceRemoval {
+		if err = checkReferencedRemovals(original, d, dic); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if config.Writable.LogProfileUpdateDiff {
+		logDeviceProfileUpdateDiff(original, d, ctx, lc)
+	}
+
 	err = dbClient.UpdateDeviceProfile(d)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
@@ -87,11 +279,97 @@ func UpdateDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Co
 	}
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
-	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
+	if err := notifyUpdateDeviceProfileSystemEvent(original, profileDTO, ctx, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	return nil
 }
 
+// checkReferencedRemovals rejects the device profile update removing the device resources or device commands referenced
+// by the auto events of the devices on the profile, with the same source matching as the auto event validation. The
+// devices are only queried if the update removes any and the profile is in use.
+func checkReferencedRemovals(original, updated models.DeviceProfile, dic *di.Container) errors.EdgeX {
+	changes := diffDeviceProfiles(dtos.FromDeviceProfileModelToDTO(original), dtos.FromDeviceProfileModelToDTO(updated))
+	if len(changes.RemovedResources) == 0 && len(changes.RemovedCommands) == 0 {
+		return nil
+	}
+	inUse, err := isProfileInUse(original.Name, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if !inUse {
+		return nil
+	}
+	devices, err := container.DBClientFrom(dic.Get).DevicesByProfileName(0, -1, original.Name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	var referenced, deviceNames []string
+	for _, device := range devices {
+		referencing := false
+		for _, a := range device.AutoEvents {
+			regex, regErr := regexp.CompilePOSIX(a.SourceName)
+			for _, name := range changes.RemovedResources {
+				if name == a.SourceName || (regErr == nil && name == regex.FindString(name)) {
+					referenced = append(referenced, name)
+					referencing = true
+				}
+			}
+			for _, name := range changes.RemovedCommands {
+				if name == a.SourceName {
+					referenced = append(referenced, name)
+					referencing = true
+				}
+			}
+		}
+		if referencing {
+			deviceNames = append(deviceNames, device.Name)
+		}
+	}
+	if len(referenced) == 0 {
+		return nil
+	}
+	slices.Sort(referenced)
+	return errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf(
+		"fail to remove the device resources or device commands %v from the device profile %s, which are referenced by the auto events of the devices %v",
+		slices.Compact(referenced), original.Name, deviceNames), nil)
+}
+
+// UpsertDeviceProfile creates the device profile if the name is new and updates it otherwise, with the same validation
+// and system event as AddDeviceProfile and UpdateDeviceProfile. The concurrent upserts of a new name are resolved to one
+// create and one update by the unique name constraint of the database. It returns the id of the created profile and
+// whether the profile is created.
+func UpsertDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Container) (id string, created bool, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	exists, err := dbClient.DeviceProfileNameExists(d.Name)
+	if err != nil {
+		return "", false, errors.NewCommonEdgeXWrapper(err)
+	}
+	if !exists {
+		id, err = AddDeviceProfile(d, ctx, dic)
+		if err == nil {
+			return id, true, nil
+		}
+		if errors.Kind(err) != errors.KindDuplicateName {
+			return "", false, errors.NewCommonEdgeXWrapper(err)
+		}
+		// the profile is created by a concurrent upsert after the existence check, so update it instead
+		lc.Debugf("DeviceProfile %s is created concurrently, upsert updates it instead. Correlation-id: %s ", d.Name, correlation.FromContext(ctx))
+	}
+
+	if container.ConfigurationFrom(dic.Get).Writable.ProfileChange.StrictDeviceProfileChanges {
+		return "", false, errors.NewCommonEdgeX(errors.KindServiceLocked, "profile change is not allowed when StrictDeviceProfileChanges config is enabled", nil)
+	}
+	if err = UpdateDeviceProfile(d, ctx, dic); err != nil {
+		return "", false, errors.NewCommonEdgeXWrapper(err)
+	}
+	return "", false, nil
+}
+
 func isProfileInUse(profileName string, dic *di.Container) (bool, errors.EdgeX) {
 	dbClient := container.DBClientFrom(dic.Get)
 	count, err := dbClient.DeviceCountByProfileName(profileName)
@@ -106,12 +384,10 @@ func DeviceProfileByName(name string, ctx context.Context, dic *di.Container) (d
 	if name == "" {
 		return deviceProfile, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
-	dbClient := container.DBClientFrom(dic.Get)
-	dp, err := dbClient.DeviceProfileByName(name)
+	deviceProfile, err = cachedDeviceProfileByName(name, dic)
 	if err != nil {
 		return deviceProfile, errors.NewCommonEdgeXWrapper(err)
 	}
-	deviceProfile = dtos.FromDeviceProfileModelToDTO(dp)
 	return deviceProfile, nil
 }
 
@@ -149,18 +425,28 @@ func DeleteDeviceProfileByName(name string, ctx context.Context, dic *di.Contain
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	container.DeviceResourceHistoryFrom(dic.Get).RemoveProfile(name)
+	container.DeviceProfileCacheFrom(dic.Get).Invalidate(name)
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
-	go publishSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionDelete, common.CoreMetaDataServiceKey, profileDTO, ctx, dic)
+	if err := notifySystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionDelete, common.CoreMetaDataServiceKey, profileDTO, ctx, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	return nil
 }
 
-// AllDeviceProfiles query the device profiles with offset, and limit
-func AllDeviceProfiles(offset int, limit int, labels []string, dic *di.Container) (deviceProfiles []dtos.DeviceProfile, totalCount uint32, err errors.EdgeX) {
+// AllDeviceProfiles query the device profiles with offset, and limit. The device profiles can be filtered by labels and
+// by the text contained in the name case-insensitively, both filters apply if specified.
+func AllDeviceProfiles(offset int, limit int, labels []string, nameContains string, dic *di.Container) (deviceProfiles []dtos.DeviceProfile, totalCount uint32, err errors.EdgeX) {
 	dbClient := container.DBClientFrom(dic.Get)
 
-	totalCount, err = dbClient.DeviceProfileCountByLabels(labels)
+	labels = normalizeQueryLabels(labels, dic)
+	if nameContains != "" {
+		totalCount, err = dbClient.DeviceProfileCountByNameContains(nameContains, labels)
+	} else {
+		totalCount, err = dbClient.DeviceProfileCountByLabels(labels)
+	}
 	if err != nil {
 		return deviceProfiles, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -169,7 +455,12 @@ func AllDeviceProfiles(offset int, limit int, labels []string, dic *di.Container
 		return []dtos.DeviceProfile{}, totalCount, err
 	}
 
-	dps, err := dbClient.AllDeviceProfiles(offset, limit, labels)
+	var dps []models.DeviceProfile
+	if nameContains != "" {
+		dps, err = dbClient.DeviceProfilesByNameContains(offset, limit, nameContains, labels)
+	} else {
+		dps, err = dbClient.AllDeviceProfiles(offset, limit, labels)
+	}
 	if err != nil {
 		return deviceProfiles, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -272,7 +563,9 @@ func PatchDeviceProfileBasicInfo(ctx context.Context, dto dtos.UpdateDeviceProfi
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
+	original := deviceProfile
 	requests.ReplaceDeviceProfileModelBasicInfoFieldsWithDTO(&deviceProfile, dto)
+	normalizeDeviceProfileLabels(&deviceProfile, dic)
 	err = dbClient.UpdateDeviceProfile(deviceProfile)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
@@ -284,15 +577,18 @@ func PatchDeviceProfileBasicInfo(ctx context.Context, dto dtos.UpdateDeviceProfi
 	)
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(deviceProfile)
-	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
+	if err := notifyUpdateDeviceProfileSystemEvent(original, profileDTO, ctx, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	return nil
 }
 
 // AllDeviceProfileBasicInfos query the device profile basic infos with offset, and limit
-func AllDeviceProfileBasicInfos(offset int, limit int, labels []string, dic *di.Container) (deviceProfileBasicInfos []dtos.DeviceProfileBasicInfo, totalCount uint32, err errors.EdgeX) {
+func AllDeviceProfileBasicInfos(offset int, limit int, labels []string, dic *di.Container) (deviceProfileBasicInfos []DeviceProfileBasicInfoWithCounts, totalCount uint32, err errors.EdgeX) {
 	dbClient := container.DBClientFrom(dic.Get)
 
+	labels = normalizeQueryLabels(labels, dic)
 	totalCount, err = dbClient.DeviceProfileCountByLabels(labels)
 	if err != nil {
 		return deviceProfileBasicInfos, totalCount, errors.NewCommonEdgeXWrapper(err)
@@ -306,13 +602,29 @@ func AllDeviceProfileBasicInfos(offset int, limit int, labels []string, dic *di.
 	if err != nil {
 		return deviceProfileBasicInfos, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
-	deviceProfileBasicInfos = make([]dtos.DeviceProfileBasicInfo, len(dps))
+	deviceProfileBasicInfos = make([]DeviceProfileBasicInfoWithCounts, len(dps))
 	for i, dp := range dps {
-		deviceProfileBasicInfos[i] = dtos.FromDeviceProfileModelToBasicInfoDTO(dp)
+		deviceProfileBasicInfos[i] = fromDeviceProfileModelToBasicInfoWithCounts(dp)
 	}
 	return deviceProfileBasicInfos, totalCount, nil
 }
 
+// DeviceProfileBasicInfoWithCounts is the basic info of the device profile along with the numbers of its device
+// resources and device commands, so the profile listings don't have to query each full device profile for them
+type DeviceProfileBasicInfoWithCounts struct {
+	dtos.DeviceProfileBasicInfo `json:",inline"`
+	ResourceCount               int `json:"resourceCount"`
+	CommandCount                int `json:"commandCount"`
+}
+
+func fromDeviceProfileModelToBasicInfoWithCounts(dp models.DeviceProfile) DeviceProfileBasicInfoWithCounts {
+	return DeviceProfileBasicInfoWithCounts{
+		DeviceProfileBasicInfo: dtos.FromDeviceProfileModelToBasicInfoDTO(dp),
+		ResourceCount:          len(dp.DeviceResources),
+		CommandCount:           len(dp.DeviceCommands),
+	}
+}
+
 func deviceProfileByDTO(dbClient interfaces.DBClient, dto dtos.UpdateDeviceProfileBasicInfo) (deviceProfile models.DeviceProfile, err errors.EdgeX) {
 	// The ID or Name is required by DTO and the DTO also accepts empty string ID if the Name is provided
 	if dto.Id != nil && *dto.Id != "" {
@@ -333,14 +645,24 @@ func deviceProfileByDTO(dbClient interfaces.DBClient, dto dtos.UpdateDeviceProfi
 }
 
 func deviceProfileUoMValidation(p models.DeviceProfile, dic *di.Container) errors.EdgeX {
-	if container.ConfigurationFrom(dic.Get).Writable.UoM.Validation {
-		uom := container.UnitsOfMeasureFrom(dic.Get)
-		for _, dr := range p.DeviceResources {
-			if ok := uom.Validate(dr.Properties.Units); !ok {
-				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s units %s is invalid", dr.Name, dr.Properties.Units), nil)
-			}
+	for _, dr := range p.DeviceResources {
+		if err := deviceResourceUoMValidation(dr, dic); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
 		}
 	}
 
 	return nil
 }
+
+func deviceProfileOptionalPropertiesValidation(p models.DeviceProfile) errors.EdgeX {
+	for _, dr := range p.DeviceResources {
+		if err := deviceResourceOptionalPropertiesValidation(dr); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if err := deviceResourceAliasesCollisionValidation(p.DeviceResources); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return nil
+}
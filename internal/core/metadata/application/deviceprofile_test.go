@@ -0,0 +1,227 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddDeviceProfiles(t *testing.T) {
+	scale := 2.0
+	maximum := 200.0
+	valid := func(name string) models.DeviceProfile {
+		return models.DeviceProfile{Name: name, DeviceResources: []models.DeviceResource{
+			{Name: "temperature", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32}},
+		}}
+	}
+	overflow := valid("overflow")
+	overflow.DeviceResources[0].Properties = models.ResourceProperties{ValueType: common.ValueTypeUint8, Scale: &scale, Maximum: &maximum}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AddDeviceProfiles", mock.Anything).Return(func(profiles []models.DeviceProfile) ([]models.DeviceProfile, errors.EdgeX) {
+		added := make([]models.DeviceProfile, len(profiles))
+		for i, p := range profiles {
+			p.Id = p.Name + "-id"
+			added[i] = p
+		}
+		return added, nil
+	})
+	dic := labelsTestDic(false, dbClientMock)
+
+	ids, err := AddDeviceProfiles([]models.DeviceProfile{valid("thermostat"), valid("sensor")}, context.Background(), dic)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"thermostat-id", "sensor-id"}, ids)
+	dbClientMock.AssertNumberOfCalls(t, "AddDeviceProfiles", 1)
+
+	tests := []struct {
+		name             string
+		profiles         []models.DeviceProfile
+		expectedFailures []string
+	}{
+		{"invalid profile", []models.DeviceProfile{valid("thermostat"), overflow, valid("sensor")}, []string{"[1] overflow"}},
+		{"duplicated names", []models.DeviceProfile{valid("thermostat"), valid("sensor"), valid("thermostat")}, []string{"[2] thermostat", "[0]"}},
+		{"all failures", []models.DeviceProfile{overflow, valid("sensor"), overflow}, []string{"2 of 3", "[0] overflow", "[2] overflow"}},
+		{"no profiles", nil, []string{"no device profiles"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			ids, err := AddDeviceProfiles(testCase.profiles, context.Background(), dic)
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			assert.Nil(t, ids)
+			for _, failure := range testCase.expectedFailures {
+				assert.Contains(t, err.Error(), failure)
+			}
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "AddDeviceProfiles", 1)
+}
+
+func TestAddDeviceProfiles_DBFailure(t *testing.T) {
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AddDeviceProfiles", mock.Anything).Return(nil,
+		errors.NewCommonEdgeX(errors.KindDuplicateName, "device profile name sensor already exists", nil))
+	dic := labelsTestDic(false, dbClientMock)
+
+	ids, err := AddDeviceProfiles([]models.DeviceProfile{{Name: "thermostat"}, {Name: "sensor"}}, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindDuplicateName, errors.Kind(err))
+	assert.Nil(t, ids, "no profile should be reported as added when the transaction is rolled back")
+}
+
+func TestAddDeviceProfiles_PublishFailure(t *testing.T) {
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AddDeviceProfiles", mock.Anything).Return(func(profiles []models.DeviceProfile) ([]models.DeviceProfile, errors.EdgeX) {
+		added := slices.Clone(profiles)
+		for i := range added {
+			added[i].Id = added[i].Name + "-id"
+		}
+		return added, nil
+	})
+	dic := labelsTestDic(false, dbClientMock)
+	// no messaging client is available, so every synchronous publish fails
+	container.ConfigurationFrom(dic.Get).Writable.SystemEvent.FailOperationOnPublishError = true
+
+	ids, err := AddDeviceProfiles([]models.DeviceProfile{{Name: "thermostat"}, {Name: "sensor"}}, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	assert.Contains(t, err.Error(), "2 of their system events")
+	assert.Contains(t, err.Error(), "[0] thermostat")
+	assert.Contains(t, err.Error(), "[1] sensor")
+	assert.Equal(t, []string{"thermostat-id", "sensor-id"}, ids, "the stored profiles should still be reported")
+}
+
+func TestValidateDeviceProfile(t *testing.T) {
+	scale := 2.0
+	maximum := 200.0
+	valid := models.DeviceProfile{Name: "sensor", DeviceResources: []models.DeviceResource{
+		{Name: "temperature", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R}},
+	}}
+	invalid := models.DeviceProfile{Name: "thermostat", DeviceResources: []models.DeviceResource{
+		{Name: "level", Properties: models.ResourceProperties{ValueType: common.ValueTypeUint8, ReadWrite: common.ReadWrite_R, Scale: &scale, Maximum: &maximum}},
+	}, DeviceCommands: []models.DeviceCommand{
+		{Name: "first", ReadWrite: common.ReadWrite_R, ResourceOperations: []models.ResourceOperation{{DeviceResource: "level"}}},
+		{Name: "second", ReadWrite: common.ReadWrite_R, ResourceOperations: []models.ResourceOperation{{DeviceResource: "level"}}},
+	}}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileNameExists", valid.Name).Return(false, nil)
+	dbClientMock.On("DeviceProfileNameExists", invalid.Name).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", "unavailable").Return(false, errors.NewCommonEdgeX(errors.KindDatabaseError, "db unavailable", nil))
+	dic := labelsTestDic(false, dbClientMock)
+	container.ConfigurationFrom(dic.Get).Writable.MaxCommands = 1
+
+	result, err := ValidateDeviceProfile(valid, context.Background(), dic)
+	require.NoError(t, err)
+	assert.Equal(t, DeviceProfileValidationResult{Valid: true}, result)
+
+	result, err = ValidateDeviceProfile(invalid, context.Background(), dic)
+	require.NoError(t, err)
+	assert.False(t, result.Valid)
+	var checks []string
+	for _, failure := range result.Failures {
+		checks = append(checks, failure.Check)
+		assert.NotEmpty(t, failure.Message)
+	}
+	assert.Equal(t, []string{"duplicateName", "scaling", "commandCapacity"}, checks, "every failed check should be reported")
+	dbClientMock.AssertNotCalled(t, "AddDeviceProfile", mock.Anything)
+
+	_, err = ValidateDeviceProfile(models.DeviceProfile{Name: "unavailable"}, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindDatabaseError, errors.Kind(err))
+}
+
+func TestCheckReferencedRemovals(t *testing.T) {
+	original := models.DeviceProfile{Name: "thermostat", DeviceResources: []models.DeviceResource{
+		{Name: "temperature"}, {Name: "humidity"}, {Name: "pressure"},
+	}, DeviceCommands: []models.DeviceCommand{
+		{Name: "climate", ResourceOperations: []models.ResourceOperation{{DeviceResource: "temperature"}}},
+	}}
+	withoutHumidity := cloneDeviceProfile(original)
+	withoutHumidity.DeviceResources = slices.DeleteFunc(withoutHumidity.DeviceResources, func(r models.DeviceResource) bool { return r.Name == "humidity" })
+	withoutClimate := cloneDeviceProfile(original)
+	withoutClimate.DeviceCommands = nil
+	withoutPressure := cloneDeviceProfile(original)
+	withoutPressure.DeviceResources = slices.DeleteFunc(withoutPressure.DeviceResources, func(r models.DeviceResource) bool { return r.Name == "pressure" })
+	unused := original
+	unused.Name = "unused"
+	unusedWithoutHumidity := withoutHumidity
+	unusedWithoutHumidity.Name = unused.Name
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceCountByProfileName", original.Name).Return(uint32(2), nil)
+	dbClientMock.On("DeviceCountByProfileName", unused.Name).Return(uint32(0), nil)
+	dbClientMock.On("DevicesByProfileName", 0, -1, original.Name).Return([]models.Device{
+		{Name: "hvac-1", AutoEvents: []models.AutoEvent{{SourceName: "hum.*"}}},
+		{Name: "hvac-2", AutoEvents: []models.AutoEvent{{SourceName: "climate"}, {SourceName: "temperature"}}},
+	}, nil)
+	dic := labelsTestDic(false, dbClientMock)
+
+	tests := []struct {
+		name             string
+		original         models.DeviceProfile
+		updated          models.DeviceProfile
+		expectedConflict []string
+	}{
+		{"resource referenced by an auto event pattern", original, withoutHumidity, []string{"humidity", "hvac-1"}},
+		{"command referenced by an auto event", original, withoutClimate, []string{"climate", "hvac-2"}},
+		{"unreferenced resource", original, withoutPressure, nil},
+		{"nothing removed", original, original, nil},
+		{"profile without devices", unused, unusedWithoutHumidity, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := checkReferencedRemovals(testCase.original, testCase.updated, dic)
+			if testCase.expectedConflict == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, errors.KindStatusConflict, errors.Kind(err))
+			for _, name := range testCase.expectedConflict {
+				assert.Contains(t, err.Error(), name)
+			}
+		})
+	}
+	dbClientMock.AssertNotCalled(t, "DevicesByProfileName", 0, -1, unused.Name)
+}
+
+func TestUpdateDeviceProfile_StrictResourceRemoval(t *testing.T) {
+	original := models.DeviceProfile{Name: "thermostat", DeviceResources: []models.DeviceResource{
+		{Name: "temperature", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R}},
+		{Name: "humidity", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R}},
+	}}
+	updated := cloneDeviceProfile(original)
+	updated.DeviceResources = updated.DeviceResources[:1]
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", original.Name).Return(original, nil)
+	dbClientMock.On("DeviceCountByProfileName", original.Name).Return(uint32(1), nil)
+	dbClientMock.On("DevicesByProfileName", 0, -1, original.Name).Return([]models.Device{
+		{Name: "hvac-1", AutoEvents: []models.AutoEvent{{SourceName: "humidity"}}},
+	}, nil)
+	dic := labelsTestDic(false, dbClientMock)
+	container.ConfigurationFrom(dic.Get).Writable.ProfileChange.StrictResourceRemoval = true
+
+	err := UpdateDeviceProfile(updated, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindStatusConflict, errors.Kind(err))
+	assert.Contains(t, err.Error(), "humidity")
+	dbClientMock.AssertNotCalled(t, "UpdateDeviceProfile", mock.Anything)
+}
@@ -9,12 +9,19 @@ package application
 import (
 	"context"
 	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -43,12 +50,74 @@ func DeviceResourceByProfileNameAndResourceName(profileName string, resourceName
 	return resource, nil
 }
 
+// VirtualDeviceResourcesByProfileName queries the virtual device resources, which are computed from other resources
+// instead of read from the device, of the device profile
+func VirtualDeviceResourcesByProfileName(profileName string, dic *di.Container) (resources []dtos.DeviceResource, err errors.EdgeX) {
+	return deviceResourcesByProfileName(profileName, isVirtualResource, dic)
+}
+
+// NullableDeviceResourcesByProfileName query the nullable device resources by profileName
+func NullableDeviceResourcesByProfileName(profileName string, dic *di.Container) (resources []dtos.DeviceResource, err errors.EdgeX) {
+	return deviceResourcesByProfileName(profileName, isNullableResource, dic)
+}
+
+// UniqueKeyDeviceResourcesByProfileName queries the unique key device resources of the device profile, whose values
+// together uniquely identify a reading set of a device
+func UniqueKeyDeviceResourcesByProfileName(profileName string, dic *di.Container) (resources []dtos.DeviceResource, err errors.EdgeX) {
+	return deviceResourcesByProfileName(profileName, isUniqueKeyResource, dic)
+}
+
+// DeviceResourcesByCoalesceGroup queries the readable device resources of the device profile grouped by their
+// coalesceGroup optional property, so the device services can batch the reads of the resources in the same group.
+// The resources without a coalesceGroup are not returned.
+func DeviceResourcesByCoalesceGroup(profileName string, dic *di.Container) (groups map[string][]dtos.DeviceResource, err errors.EdgeX) {
+	resources, err := deviceResourcesByProfileName(profileName, func(r models.DeviceResource) bool {
+		return coalesceGroup(r) != ""
+	}, dic)
+	if err != nil {
+		return groups, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	groups = make(map[string][]dtos.DeviceResource)
+	for _, r := range resources {
+		group := r.Properties.Optional[CoalesceGroupKey].(string)
+		groups[group] = append(groups[group], r)
+	}
+	return groups, nil
+}
+
+// deviceResourcesByProfileName returns the device resources of the profile which match the filter
+func deviceResourcesByProfileName(profileName string, filter func(models.DeviceResource) bool, dic *di.Container) (resources []dtos.DeviceResource, err errors.EdgeX) {
+	if profileName == "" {
+		return resources, errors.NewCommonEdgeX(errors.KindContractInvalid, "profile name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	profile, err := dbClient.DeviceProfileByName(profileName)
+	if err != nil {
+		return resources, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	resources = []dtos.DeviceResource{}
+	for _, r := range profile.DeviceResources {
+		if filter(r) {
+			resources = append(resources, dtos.FromDeviceResourceModelToDTO(r))
+		}
+	}
+	return resources, nil
+}
+
+// resourceByName finds the device resource by its name, or by one of its aliases if no resource has the name
 func resourceByName(resources []models.DeviceResource, resourceName string) (models.DeviceResource, errors.EdgeX) {
 	for _, r := range resources {
 		if r.Name == resourceName {
 			return r, nil
 		}
 	}
+	for _, r := range resources {
+		if slices.Contains(resourceAliases(r), resourceName) {
+			return r, nil
+		}
+	}
 	return models.DeviceResource{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("resource %s not exists", resourceName), nil)
 }
 
@@ -61,11 +130,41 @@ func AddDeviceProfileResource(profileName string, resource models.DeviceResource
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	original := cloneDeviceProfile(profile)
 
+	inferDeviceResourceDefaults(&resource, dic)
+	err = normalizeDeviceResourceDefaultValue(&resource)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 	err = deviceResourceUoMValidation(resource, dic)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	err = deviceResourceOptionalPropertiesValidation(resource)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = deviceResourceEngineeringRangeValidation(resource, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = deviceResourceScalingValidation(resource)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = deviceResourceProtocolAttributesValidation(profile, resource, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = deviceResourceAttributeLimitsValidation(resource, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = deviceResourceSampleIntervalBoundsValidation(resource, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	if config.Writable.MaxResources > 0 {
 		if err = checkResourceCapacityByNewResource(profileName, resource, dic); err != nil {
@@ -74,6 +173,10 @@ func AddDeviceProfileResource(profileName string, resource models.DeviceResource
 	}
 
 	profile.DeviceResources = append(profile.DeviceResources, resource)
+	err = deviceResourceAliasesCollisionValidation(profile.DeviceResources)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
 	validateErr := profileDTO.Validate()
@@ -87,7 +190,9 @@ func AddDeviceProfileResource(profileName string, resource models.DeviceResource
 	}
 
 	lc.Debugf("DeviceProfile deviceResources added on DB successfully. Correlation-id: %s ", correlation.FromContext(ctx))
-	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
+	if err := notifyUpdateDeviceProfileSystemEvent(original, profileDTO, ctx, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	return nil
 }
@@ -100,6 +205,7 @@ func PatchDeviceProfileResource(profileName string, dto dtos.UpdateDeviceResourc
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	original := cloneDeviceProfile(profile)
 
 	// Find matched deviceResource
 	index := -1
@@ -114,6 +220,12 @@ func PatchDeviceProfileResource(profileName string, dto dtos.UpdateDeviceResourc
 	}
 
 	requests.ReplaceDeviceResourceModelFieldsWithDTO(&profile.DeviceResources[index], dto)
+	if err = deviceProfileCommandReadWriteValidation(profile); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err = deviceResourceProtocolAttributesValidation(profile, profile.DeviceResources[index], dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	err = dbClient.UpdateDeviceProfile(profile)
 	if err != nil {
@@ -122,7 +234,9 @@ func PatchDeviceProfileResource(profileName string, dto dtos.UpdateDeviceResourc
 
 	lc.Debugf("DeviceProfile deviceResources patched on DB successfully. Correlation-id: %s ", correlation.FromContext(ctx))
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
-	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
+	if err := notifyUpdateDeviceProfileSystemEvent(original, profileDTO, ctx, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	return nil
 }
@@ -153,6 +267,7 @@ func DeleteDeviceResourceByName(profileName string, resourceName string, ctx con
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	original := cloneDeviceProfile(profile)
 
 	index := -1
 	for i := range profile.DeviceResources {
@@ -177,17 +292,732 @@ func DeleteDeviceResourceByName(profileName string, resourceName string, ctx con
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
-	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
+	if err := notifyUpdateDeviceProfileSystemEvent(original, profileDTO, ctx, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 	return nil
 }
 
+// deviceResourceUoMValidation validates the units of the device resource per Writable.UoM. The invalid units are only
+// logged in the warn Mode, so the rest of the device resource is still validated.
 func deviceResourceUoMValidation(r models.DeviceResource, dic *di.Container) errors.EdgeX {
-	if container.ConfigurationFrom(dic.Get).Writable.UoM.Validation {
+	uomConfig := container.ConfigurationFrom(dic.Get).Writable.UoM
+	if uomConfig.Validation {
 		uom := container.UnitsOfMeasureFrom(dic.Get)
+		var violations []string
 		if ok := uom.Validate(r.Properties.Units); !ok {
-			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s units %s is invalid", r.Name, r.Properties.Units), nil)
+			violations = append(violations, fmt.Sprintf("DeviceResource %s units %s is invalid", r.Name, r.Properties.Units))
+		}
+		if displayUnit, ok := r.Properties.Optional[DisplayUnitKey].(string); ok && !uom.Validate(displayUnit) {
+			violations = append(violations, fmt.Sprintf("DeviceResource %s displayUnit %s is invalid", r.Name, displayUnit))
+		}
+		if len(violations) > 0 {
+			if uomConfig.Mode != config.UoMModeWarn {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, violations[0], nil)
+			}
+			lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+			for _, violation := range violations {
+				lc.Warn(violation)
+			}
+		}
+	}
+
+	return deviceResourcePhysicalLimitsValidation(r, dic)
+}
+
+// deviceResourcePhysicalLimitsValidation checks the Minimum and the Maximum of the numeric device resource are within the
+// physical limits of its units, e.g. not below the absolute zero of a temperature, per Writable.UoM.PhysicalLimitsMode.
+// The violation is only logged in the warn mode.
+func deviceResourcePhysicalLimitsValidation(r models.DeviceResource, dic *di.Container) errors.EdgeX {
+	mode := container.ConfigurationFrom(dic.Get).Writable.UoM.PhysicalLimitsMode
+	if mode == "" || !isNumericValueType(r.Properties.ValueType) || (r.Properties.Minimum == nil && r.Properties.Maximum == nil) {
+		return nil
+	}
+	uom := container.UnitsOfMeasureFrom(dic.Get)
+	physicalMin, physicalMax, found := uom.PhysicalLimits(r.Properties.Units)
+	if !found {
+		return nil
+	}
+
+	var violation string
+	for _, bound := range []struct {
+		name  string
+		value *float64
+	}{{"minimum", r.Properties.Minimum}, {"maximum", r.Properties.Maximum}} {
+		if bound.value == nil {
+			continue
+		}
+		if physicalMin != nil && *bound.value < *physicalMin {
+			violation = fmt.Sprintf("DeviceResource %s %s %v is below the physical minimum %v of the units %s", r.Name, bound.name, *bound.value, *physicalMin, r.Properties.Units)
+			break
+		}
+		if physicalMax != nil && *bound.value > *physicalMax {
+			violation = fmt.Sprintf("DeviceResource %s %s %v is above the physical maximum %v of the units %s", r.Name, bound.name, *bound.value, *physicalMax, r.Properties.Units)
+			break
+		}
+	}
+	if violation == "" {
+		return nil
+	}
+	if mode == config.UoMModeReject {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, violation, nil)
+	}
+	bootstrapContainer.LoggingClientFrom(dic.Get).Warn(violation)
+	return nil
+}
+
+// SampleIntervalKey is the key of the expected sampling interval in the ResourceProperties.Optional of the device
+// resource. The value is a duration string, e.g. "500ms" or "1m", only validated and stored by core-metadata.
+const SampleIntervalKey = "sampleInterval"
+
+// CommandTimeoutKey is the key of the command timeout hint in the ResourceProperties.Optional of the writable device
+// resource, e.g. of a slow actuator. The value is a duration string, e.g. "30s", only validated and stored by
+// core-metadata, which the command services can honor.
+const CommandTimeoutKey = "commandTimeout"
+
+// AccessRolesKey is the key of the roles allowed to command the device resource in the ResourceProperties.Optional of
+// the device resource. The value is a list of non-empty role names, only validated and stored by core-metadata, which
+// the command services can enforce.
+const AccessRolesKey = "accessRoles"
+
+// IsVirtualKey is the key of the virtual flag in the ResourceProperties.Optional of the device resource. A virtual
+// device resource is computed from other resources by the device service instead of read from the device, so it must be
+// read-only.
+const IsVirtualKey = "isVirtual"
+
+// AliasesKey is the key of the alias names in the ResourceProperties.Optional of the device resource. The value is a
+// list of non-empty names, e.g. the resource names on another platform, which can be used to query the device resource
+// by name. The aliases must not collide with the other resource names or aliases in the same profile.
+const AliasesKey = "aliases"
+
+// NullableKey is the key of the nullable flag in the ResourceProperties.Optional of the device resource. The reading of
+// a nullable device resource can be legitimately absent, which the consumers interpret. A nullable device resource must
+// not declare a DefaultValue, which implies a non-null value. The resource is not nullable if the key is not set.
+const NullableKey = "nullable"
+
+// UniqueKeyKey is the key of the unique key flag in the ResourceProperties.Optional of the device resource. The
+// values of the unique key resources of a profile together uniquely identify a reading set of a device, which the
+// consumers can dedup by. A unique key resource must be readable and must not be nullable. core-metadata only validates
+// and stores the flag.
+const UniqueKeyKey = "uniqueKey"
+
+// CoalesceGroupKey is the key of the read-coalescing hint in the ResourceProperties.Optional of the readable device
+// resource. The value is a non-empty group name, the device services can read the resources in the same group together
+// in one device transaction. core-metadata only validates and stores the hint.
+const CoalesceGroupKey = "coalesceGroup"
+
+// FallbackPolicyKey is the key of the fallback policy in the ResourceProperties.Optional of the readable device
+// resource, which tells the device services what to report when the resource can't be read from the device. The value
+// is one of the FallbackPolicyDefault, FallbackPolicyLastKnown and FallbackPolicyNone, and the "default" policy requires
+// a DefaultValue. core-metadata only validates and stores the policy.
+const FallbackPolicyKey = "fallbackPolicy"
+
+const (
+	// FallbackPolicyDefault reports the DefaultValue of the device resource
+	FallbackPolicyDefault = "default"
+	// FallbackPolicyLastKnown reports the last-known-good value of the device resource
+	FallbackPolicyLastKnown = "lastKnown"
+	// FallbackPolicyNone reports nothing
+	FallbackPolicyNone = "none"
+)
+
+// EncodingKey is the key of the encoding in the ResourceProperties.Optional of the Binary, Object and ObjectArray device
+// resources, which tells the producers and the consumers how the binary readings are serialized. The value is one of the
+// EncodingBase64, EncodingHex and EncodingRaw. core-metadata only validates and stores the encoding.
+const EncodingKey = "encoding"
+
+const (
+	// EncodingBase64 serializes the binary reading as the standard base64 string
+	EncodingBase64 = "base64"
+	// EncodingHex serializes the binary reading as the hexadecimal string
+	EncodingHex = "hex"
+	// EncodingRaw keeps the binary reading as the raw bytes
+	EncodingRaw = "raw"
+)
+
+// encodingValueTypes are the value types the encoding is allowed on
+var encodingValueTypes = []string{common.ValueTypeBinary, common.ValueTypeObject, common.ValueTypeObjectArray}
+
+// DisplayUnitKey, ConversionFactorKey and ConversionOffsetKey are the keys of the display unit intent in the
+// ResourceProperties.Optional of the numeric device resource. The consumers convert the raw value in the Units to the
+// DisplayUnit by value*conversionFactor + conversionOffset, core-metadata only validates and stores them. The
+// DisplayUnit is validated against the units of measure like the Units.
+const (
+	DisplayUnitKey      = "displayUnit"
+	ConversionFactorKey = "conversionFactor"
+	ConversionOffsetKey = "conversionOffset"
+)
+
+// CritLowKey, WarnLowKey, WarnHighKey and CritHighKey are the keys of the alarm thresholds in the
+// ResourceProperties.Optional of the numeric device resource, which the alarming services consume. Any of them can be
+// declared, and the declared ones must be ordered as critLow <= warnLow <= warnHigh <= critHigh. core-metadata only
+// validates and stores them.
+const (
+	CritLowKey  = "critLow"
+	WarnLowKey  = "warnLow"
+	WarnHighKey = "warnHigh"
+	CritHighKey = "critHigh"
+)
+
+// alarmThresholdKeys are the alarm threshold keys from the lowest to the highest threshold
+var alarmThresholdKeys = []string{CritLowKey, WarnLowKey, WarnHighKey, CritHighKey}
+
+// deviceResourceOptionalPropertiesValidation validates the well-known optional properties of the device resource
+func deviceResourceOptionalPropertiesValidation(r models.DeviceResource) errors.EdgeX {
+	if err := deviceResourceSampleIntervalValidation(r); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := deviceResourceCommandTimeoutValidation(r); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := deviceResourceAccessRolesValidation(r); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := deviceResourceIsVirtualValidation(r); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := deviceResourceAliasesValidation(r); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := deviceResourceDisplayUnitValidation(r); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := deviceResourceNullableValidation(r); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := deviceResourceUniqueKeyValidation(r); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := deviceResourceCoalesceGroupValidation(r); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := deviceResourceFallbackPolicyValidation(r); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := deviceResourceAlarmThresholdsValidation(r); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := deviceResourceEncodingValidation(r); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := deviceResourceCalibrationValidation(r); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return nil
+}
+
+func deviceResourceAccessRolesValidation(r models.DeviceResource) errors.EdgeX {
+	_, err := optionalStringList(r, AccessRolesKey)
+	return err
+}
+
+func deviceResourceAliasesValidation(r models.DeviceResource) errors.EdgeX {
+	aliases, err := optionalStringList(r, AliasesKey)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, alias := range aliases {
+		if alias == r.Name {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s alias %s is the same as the resource name", r.Name, alias), nil)
+		}
+	}
+
+	return nil
+}
+
+// optionalStringList returns the non-empty list of non-blank strings of the key in the ResourceProperties.Optional of
+// the device resource, or nil if the key is not set
+func optionalStringList(r models.DeviceResource, key string) ([]string, errors.EdgeX) {
+	value, ok := r.Properties.Optional[key]
+	if !ok || value == nil {
+		return nil, nil
+	}
+	var items []any
+	switch v := value.(type) {
+	case []any:
+		items = v
+	case []string:
+		for _, item := range v {
+			items = append(items, item)
+		}
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s %s %v is not a list of strings", r.Name, key, value), nil)
+	}
+	if len(items) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s %s must not be empty", r.Name, key), nil)
+	}
+	list := make([]string, 0, len(items))
+	for _, item := range items {
+		s, ok := item.(string)
+		if !ok || strings.TrimSpace(s) == "" {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s %s contains the invalid value %v", r.Name, key, item), nil)
+		}
+		list = append(list, s)
+	}
+
+	return list, nil
+}
+
+// resourceAliases returns the aliases of the device resource, the invalid aliases are ignored
+func resourceAliases(r models.DeviceResource) []string {
+	aliases, err := optionalStringList(r, AliasesKey)
+	if err != nil {
+		return nil
+	}
+	return aliases
+}
+
+// deviceResourceAliasesCollisionValidation validates the aliases of the device resources in the same profile don't
+// collide with the other resource names or aliases
+func deviceResourceAliasesCollisionValidation(resources []models.DeviceResource) errors.EdgeX {
+	owners := make(map[string]string, len(resources))
+	for _, r := range resources {
+		owners[r.Name] = r.Name
+	}
+	for _, r := range resources {
+		for _, alias := range resourceAliases(r) {
+			if owner, ok := owners[alias]; ok && owner != r.Name {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s alias %s collides with the resource %s", r.Name, alias, owner), nil)
+			}
+			owners[alias] = r.Name
 		}
 	}
 
 	return nil
 }
+
+func deviceResourceIsVirtualValidation(r models.DeviceResource) errors.EdgeX {
+	value, ok := r.Properties.Optional[IsVirtualKey]
+	if !ok || value == nil {
+		return nil
+	}
+	isVirtual, ok := value.(bool)
+	if !ok {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s isVirtual %v is not a boolean", r.Name, value), nil)
+	}
+	if isVirtual && r.Properties.ReadWrite != common.ReadWrite_R {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s is virtual and must be read-only, but readWrite is %s", r.Name, r.Properties.ReadWrite), nil)
+	}
+
+	return nil
+}
+
+func deviceResourceDisplayUnitValidation(r models.DeviceResource) errors.EdgeX {
+	displayUnitValue, hasDisplayUnit := r.Properties.Optional[DisplayUnitKey]
+	factorValue, hasFactor := r.Properties.Optional[ConversionFactorKey]
+	offsetValue, hasOffset := r.Properties.Optional[ConversionOffsetKey]
+	if !hasDisplayUnit && !hasFactor && !hasOffset {
+		return nil
+	}
+	if !isNumericValueType(r.Properties.ValueType) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s displayUnit and conversion are only allowed for the numeric value types, but valueType is %s", r.Name, r.Properties.ValueType), nil)
+	}
+	if displayUnit, ok := displayUnitValue.(string); !ok || strings.TrimSpace(displayUnit) == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s conversion requires a non-empty displayUnit, but displayUnit is %v", r.Name, displayUnitValue), nil)
+	}
+	if hasFactor {
+		factor, ok := optionalNumber(factorValue)
+		if !ok {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s conversionFactor %v is not a number", r.Name, factorValue), nil)
+		}
+		if factor == 0 {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s conversionFactor must not be zero", r.Name), nil)
+		}
+	}
+	if hasOffset {
+		if _, ok := optionalNumber(offsetValue); !ok {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s conversionOffset %v is not a number", r.Name, offsetValue), nil)
+		}
+	}
+
+	return nil
+}
+
+func deviceResourceAlarmThresholdsValidation(r models.DeviceResource) errors.EdgeX {
+	var lowerKey string
+	var lower float64
+	for _, key := range alarmThresholdKeys {
+		value, ok := r.Properties.Optional[key]
+		if !ok || value == nil {
+			continue
+		}
+		if !isNumericValueType(r.Properties.ValueType) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s alarm thresholds are only allowed for the numeric value types, but valueType is %s", r.Name, r.Properties.ValueType), nil)
+		}
+		threshold, ok := optionalNumber(value)
+		if !ok {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s %s %v is not a number", r.Name, key, value), nil)
+		}
+		if lowerKey != "" && threshold < lower {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s %s %v must not be less than %s %v", r.Name, key, threshold, lowerKey, lower), nil)
+		}
+		lowerKey, lower = key, threshold
+	}
+
+	return nil
+}
+
+// optionalNumber returns the number of the optional property value, which may be decoded as any numeric type
+func optionalNumber(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+func isNumericValueType(valueType string) bool {
+	switch valueType {
+	case common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64,
+		common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64,
+		common.ValueTypeFloat32, common.ValueTypeFloat64:
+		return true
+	}
+	return false
+}
+
+func isVirtualResource(r models.DeviceResource) bool {
+	isVirtual, ok := r.Properties.Optional[IsVirtualKey].(bool)
+	return ok && isVirtual
+}
+
+func deviceResourceNullableValidation(r models.DeviceResource) errors.EdgeX {
+	value, ok := r.Properties.Optional[NullableKey]
+	if !ok || value == nil {
+		return nil
+	}
+	nullable, ok := value.(bool)
+	if !ok {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s nullable %v is not a boolean", r.Name, value), nil)
+	}
+	if nullable && r.Properties.DefaultValue != "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s is nullable and must not declare the non-null defaultValue %s", r.Name, r.Properties.DefaultValue), nil)
+	}
+
+	return nil
+}
+
+func isNullableResource(r models.DeviceResource) bool {
+	nullable, ok := r.Properties.Optional[NullableKey].(bool)
+	return ok && nullable
+}
+
+func deviceResourceUniqueKeyValidation(r models.DeviceResource) errors.EdgeX {
+	value, ok := r.Properties.Optional[UniqueKeyKey]
+	if !ok || value == nil {
+		return nil
+	}
+	uniqueKey, ok := value.(bool)
+	if !ok {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s uniqueKey %v is not a boolean", r.Name, value), nil)
+	}
+	if !uniqueKey {
+		return nil
+	}
+	if !strings.Contains(r.Properties.ReadWrite, common.ReadWrite_R) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s is a unique key and must be readable, but readWrite is %s", r.Name, r.Properties.ReadWrite), nil)
+	}
+	if isNullableResource(r) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s is a unique key and must not be nullable", r.Name), nil)
+	}
+
+	return nil
+}
+
+func isUniqueKeyResource(r models.DeviceResource) bool {
+	uniqueKey, ok := r.Properties.Optional[UniqueKeyKey].(bool)
+	return ok && uniqueKey
+}
+
+func deviceResourceCoalesceGroupValidation(r models.DeviceResource) errors.EdgeX {
+	value, ok := r.Properties.Optional[CoalesceGroupKey]
+	if !ok || value == nil {
+		return nil
+	}
+	group, ok := value.(string)
+	if !ok {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s coalesceGroup %v is not a string", r.Name, value), nil)
+	}
+	if strings.TrimSpace(group) == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s coalesceGroup must not be empty", r.Name), nil)
+	}
+	if !strings.Contains(r.Properties.ReadWrite, common.ReadWrite_R) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s coalesceGroup is only allowed on the readable resource, but readWrite is %s", r.Name, r.Properties.ReadWrite), nil)
+	}
+
+	return nil
+}
+
+// coalesceGroup returns the coalesceGroup of the device resource, or empty if the resource is not in a group
+func coalesceGroup(r models.DeviceResource) string {
+	group, _ := r.Properties.Optional[CoalesceGroupKey].(string)
+	return group
+}
+
+func deviceResourceFallbackPolicyValidation(r models.DeviceResource) errors.EdgeX {
+	value, ok := r.Properties.Optional[FallbackPolicyKey]
+	if !ok || value == nil {
+		return nil
+	}
+	policy, ok := value.(string)
+	if !ok {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s fallbackPolicy %v is not a string", r.Name, value), nil)
+	}
+	switch policy {
+	case FallbackPolicyDefault:
+		if r.Properties.DefaultValue == "" {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s fallbackPolicy %s requires the defaultValue", r.Name, policy), nil)
+		}
+	case FallbackPolicyLastKnown, FallbackPolicyNone:
+	default:
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s fallbackPolicy %s is not one of %s, %s and %s", r.Name, policy, FallbackPolicyDefault, FallbackPolicyLastKnown, FallbackPolicyNone), nil)
+	}
+	if !strings.Contains(r.Properties.ReadWrite, common.ReadWrite_R) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s fallbackPolicy is only allowed on the readable resource, but readWrite is %s", r.Name, r.Properties.ReadWrite), nil)
+	}
+
+	return nil
+}
+
+func deviceResourceEncodingValidation(r models.DeviceResource) errors.EdgeX {
+	value, ok := r.Properties.Optional[EncodingKey]
+	if !ok || value == nil {
+		return nil
+	}
+	encoding, ok := value.(string)
+	if !ok {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s encoding %v is not a string", r.Name, value), nil)
+	}
+	switch encoding {
+	case EncodingBase64, EncodingHex, EncodingRaw:
+	default:
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s encoding %s is not one of %s, %s and %s", r.Name, encoding, EncodingBase64, EncodingHex, EncodingRaw), nil)
+	}
+	if !slices.Contains(encodingValueTypes, r.Properties.ValueType) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s encoding is only allowed on the %s resource, but valueType is %s", r.Name, strings.Join(encodingValueTypes, ", "), r.Properties.ValueType), nil)
+	}
+
+	return nil
+}
+
+func deviceResourceSampleIntervalValidation(r models.DeviceResource) errors.EdgeX {
+	value, ok := r.Properties.Optional[SampleIntervalKey]
+	if !ok || value == nil {
+		return nil
+	}
+	interval, ok := value.(string)
+	if !ok {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s sampleInterval %v is not a duration string", r.Name, value), nil)
+	}
+	duration, err := time.ParseDuration(interval)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s sampleInterval %s is not a valid duration", r.Name, interval), err)
+	}
+	if duration <= 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s sampleInterval %s must be positive", r.Name, interval), nil)
+	}
+
+	return nil
+}
+
+func deviceResourceCommandTimeoutValidation(r models.DeviceResource) errors.EdgeX {
+	value, ok := r.Properties.Optional[CommandTimeoutKey]
+	if !ok || value == nil {
+		return nil
+	}
+	if !strings.Contains(r.Properties.ReadWrite, common.ReadWrite_W) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s commandTimeout is only allowed on the writable resource, but readWrite is %s", r.Name, r.Properties.ReadWrite), nil)
+	}
+	timeout, ok := value.(string)
+	if !ok {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s commandTimeout %v is not a duration string", r.Name, value), nil)
+	}
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s commandTimeout %s is not a valid duration", r.Name, timeout), err)
+	}
+	if duration <= 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s commandTimeout %s must be positive", r.Name, timeout), nil)
+	}
+
+	return nil
+}
+
+// ResourceSelector selects the device resources across the device profiles. A device resource matches if its name
+// matches the NamePattern in the path.Match syntax and its Tags contain all the Tags of the selector.
+type ResourceSelector struct {
+	NamePattern string
+	Tags        map[string]any
+}
+
+func (s ResourceSelector) match(r models.DeviceResource) bool {
+	if s.NamePattern != "" {
+		if ok, _ := path.Match(s.NamePattern, r.Name); !ok {
+			return false
+		}
+	}
+	for k, v := range s.Tags {
+		tag, ok := r.Tags[k]
+		if !ok || fmt.Sprint(tag) != fmt.Sprint(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// ResourcePropertyUpdate describes the device resources of a device profile which are updated by BulkUpdateResourceProperty
+type ResourcePropertyUpdate struct {
+	ProfileName   string
+	ResourceNames []string
+}
+
+// resourcePropertySetters defines the device resource properties which can be updated by BulkUpdateResourceProperty,
+// the ValueType is excluded since changing it requires migrating the related values
+var resourcePropertySetters = map[string]func(p *models.ResourceProperties, value string) error{
+	"readWrite":    func(p *models.ResourceProperties, value string) error { p.ReadWrite = value; return nil },
+	"units":        func(p *models.ResourceProperties, value string) error { p.Units = value; return nil },
+	"defaultValue": defaultValuePropertySetter,
+	"assertion":    func(p *models.ResourceProperties, value string) error { p.Assertion = value; return nil },
+	"mediaType":    func(p *models.ResourceProperties, value string) error { p.MediaType = value; return nil },
+	"minimum":      floatPropertySetter(func(p *models.ResourceProperties) **float64 { return &p.Minimum }),
+	"maximum":      floatPropertySetter(func(p *models.ResourceProperties) **float64 { return &p.Maximum }),
+	"scale":        floatPropertySetter(func(p *models.ResourceProperties) **float64 { return &p.Scale }),
+	"offset":       floatPropertySetter(func(p *models.ResourceProperties) **float64 { return &p.Offset }),
+	"base":         floatPropertySetter(func(p *models.ResourceProperties) **float64 { return &p.Base }),
+	"mask": func(p *models.ResourceProperties, value string) error {
+		if value == "" {
+			p.Mask = nil
+			return nil
+		}
+		v, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		p.Mask = &v
+		return nil
+	},
+	"shift": func(p *models.ResourceProperties, value string) error {
+		if value == "" {
+			p.Shift = nil
+			return nil
+		}
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		p.Shift = &v
+		return nil
+	},
+}
+
+// defaultValuePropertySetter sets the DefaultValue normalized by the value type of the resource
+func defaultValuePropertySetter(p *models.ResourceProperties, value string) (err error) {
+	p.DefaultValue, err = normalizeDefaultValue(p.ValueType, value)
+	return err
+}
+
+// floatPropertySetter returns the setter of the optional float property, an empty value unsets the property
+func floatPropertySetter(field func(p *models.ResourceProperties) **float64) func(p *models.ResourceProperties, value string) error {
+	return func(p *models.ResourceProperties, value string) error {
+		if value == "" {
+			*field(p) = nil
+			return nil
+		}
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		*field(p) = &v
+		return nil
+	}
+}
+
+// BulkUpdateResourceProperty sets the property of all device resources matched by the selector across the device
+// profiles to the newValue. All updated profiles are validated by the same checks as UpdateDeviceProfile before any of
+// them is persisted, and an update system event is published for each updated profile. The profiles are persisted one
+// by one, so if persisting or publishing fails, the updates already applied are returned along with the error. With
+// dryRun, the matched resources are validated and returned without persisting any change.
+func BulkUpdateResourceProperty(selector ResourceSelector, property string, newValue string, dryRun bool, ctx context.Context, dic *di.Container) ([]ResourcePropertyUpdate, errors.EdgeX) {
+	if selector.NamePattern == "" && len(selector.Tags) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "resource selector is empty", nil)
+	}
+	if _, err := path.Match(selector.NamePattern, ""); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid resource name pattern %s", selector.NamePattern), err)
+	}
+	setter, ok := resourcePropertySetters[property]
+	if !ok {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("resource property %s is not supported for bulk update", property), nil)
+	}
+	if !dryRun && container.ConfigurationFrom(dic.Get).Writable.ProfileChange.StrictDeviceProfileChanges {
+		return nil, errors.NewCommonEdgeX(errors.KindServiceLocked, "profile change is not allowed when StrictDeviceProfileChanges config is enabled", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	profiles, err := dbClient.AllDeviceProfiles(0, -1, nil)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	var updatedProfiles, originalProfiles []models.DeviceProfile
+	updates := []ResourcePropertyUpdate{}
+	for _, profile := range profiles {
+		original := cloneDeviceProfile(profile)
+		update := ResourcePropertyUpdate{ProfileName: profile.Name}
+		for i, r := range profile.DeviceResources {
+			if !selector.match(r) {
+				continue
+			}
+			if err := setter(&profile.DeviceResources[i].Properties, newValue); err != nil {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid value %s for resource property %s", newValue, property), err)
+			}
+			update.ResourceNames = append(update.ResourceNames, r.Name)
+		}
+		if len(update.ResourceNames) == 0 {
+			continue
+		}
+		// the updated profile is checked as a whole like UpdateDeviceProfile does
+		for _, c := range deviceProfileChecks {
+			if err := c.check(profile, dic); err != nil {
+				return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("device profile %s is invalid after the update", profile.Name), err)
+			}
+		}
+		profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
+		if err := profileDTO.Validate(); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device profile %s is invalid after the update", profile.Name), err)
+		}
+		updatedProfiles = append(updatedProfiles, profile)
+		originalProfiles = append(originalProfiles, original)
+		updates = append(updates, update)
+	}
+
+	if dryRun {
+		return updates, nil
+	}
+
+	for i, profile := range updatedProfiles {
+		if err := dbClient.UpdateDeviceProfile(profile); err != nil {
+			return updates[:i], errors.NewCommonEdgeX(errors.Kind(err),
+				fmt.Sprintf("failed to update device profile %s, %d of %d profiles are updated", profile.Name, i, len(updatedProfiles)), err)
+		}
+		lc.Debugf("DeviceProfile %s resource property %s bulk updated on DB successfully. Correlation-id: %s ", profile.Name, property, correlation.FromContext(ctx))
+		if err := notifyUpdateDeviceProfileSystemEvent(originalProfiles[i], dtos.FromDeviceProfileModelToDTO(profile), ctx, dic); err != nil {
+			return updates[:i+1], errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	return updates, nil
+}
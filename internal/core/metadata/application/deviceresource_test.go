@@ -0,0 +1,777 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/utils"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func bulkUpdateTestProfiles() []models.DeviceProfile {
+	properties := models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R, Units: "F"}
+	return []models.DeviceProfile{
+		{
+			Name: "thermostat",
+			DeviceResources: []models.DeviceResource{
+				{Name: "temperature-indoor", Properties: properties, Tags: map[string]any{"group": "temperature"}},
+				{Name: "humidity", Properties: properties},
+			},
+		},
+		{
+			Name: "sensor",
+			DeviceResources: []models.DeviceResource{
+				{Name: "temperature", Properties: properties, Tags: map[string]any{"group": "temperature"}},
+			},
+		},
+		{
+			Name:            "camera",
+			DeviceResources: []models.DeviceResource{{Name: "frame", Properties: properties}},
+		},
+	}
+}
+
+func TestBulkUpdateResourceProperty(t *testing.T) {
+	tests := []struct {
+		name            string
+		selector        ResourceSelector
+		property        string
+		newValue        string
+		dryRun          bool
+		strict          bool
+		expectedUpdates []ResourcePropertyUpdate
+		errorKind       errors.ErrKind
+	}{
+		{"update units by name pattern", ResourceSelector{NamePattern: "temperature*"}, "units", "C", false, false,
+			[]ResourcePropertyUpdate{{ProfileName: "thermostat", ResourceNames: []string{"temperature-indoor"}}, {ProfileName: "sensor", ResourceNames: []string{"temperature"}}}, ""},
+		{"update minimum by tag", ResourceSelector{Tags: map[string]any{"group": "temperature"}}, "minimum", "-40", false, false,
+			[]ResourcePropertyUpdate{{ProfileName: "thermostat", ResourceNames: []string{"temperature-indoor"}}, {ProfileName: "sensor", ResourceNames: []string{"temperature"}}}, ""},
+		{"dry run", ResourceSelector{NamePattern: "humidity"}, "units", "%", true, true,
+			[]ResourcePropertyUpdate{{ProfileName: "thermostat", ResourceNames: []string{"humidity"}}}, ""},
+		{"no matched resource", ResourceSelector{NamePattern: "pressure"}, "units", "Pa", false, false, []ResourcePropertyUpdate{}, ""},
+		{"empty selector", ResourceSelector{}, "units", "C", false, false, nil, errors.KindContractInvalid},
+		{"invalid name pattern", ResourceSelector{NamePattern: "["}, "units", "C", false, false, nil, errors.KindContractInvalid},
+		{"unsupported property", ResourceSelector{NamePattern: "*"}, "valueType", common.ValueTypeInt32, false, false, nil, errors.KindContractInvalid},
+		{"invalid value", ResourceSelector{NamePattern: "*"}, "minimum", "abc", false, false, nil, errors.KindContractInvalid},
+		{"invalid profile after update", ResourceSelector{NamePattern: "*"}, "readWrite", "X", false, false, nil, errors.KindContractInvalid},
+		{"strict profile changes", ResourceSelector{NamePattern: "*"}, "units", "C", false, true, nil, errors.KindServiceLocked},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			configuration := &config.ConfigurationStruct{}
+			configuration.Writable.ProfileChange.StrictDeviceProfileChanges = testCase.strict
+			dbClientMock := &mocks.DBClient{}
+			dbClientMock.On("AllDeviceProfiles", 0, -1, []string(nil)).Return(bulkUpdateTestProfiles(), nil)
+			dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+			dbClientMock.On("DevicesByProfileName", 0, -1, mock.Anything).Return([]models.Device{}, nil)
+			dbClientMock.On("DeviceCountByProfileName", mock.Anything).Return(uint32(0), nil)
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.DeviceProfileCacheName: func(get di.Get) interface{} {
+					return utils.NewDeviceProfileCache()
+				},
+				container.DeviceResourceHistoryName: func(get di.Get) interface{} {
+					return utils.NewDeviceResourceHistory()
+				},
+				container.ConfigurationName: func(get di.Get) interface{} {
+					return configuration
+				},
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+				bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+					return logger.NewMockClient()
+				},
+			})
+			// the system events are published before returning, so the mock calls are not appended while asserting
+			syncSystemEventPublish(dic)
+
+			updates, err := BulkUpdateResourceProperty(testCase.selector, testCase.property, testCase.newValue, testCase.dryRun, context.Background(), dic)
+			if testCase.errorKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.errorKind, errors.Kind(err))
+				dbClientMock.AssertNotCalled(t, "UpdateDeviceProfile", mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedUpdates, updates)
+			if testCase.dryRun {
+				dbClientMock.AssertNotCalled(t, "UpdateDeviceProfile", mock.Anything)
+				return
+			}
+			dbClientMock.AssertNumberOfCalls(t, "UpdateDeviceProfile", len(testCase.expectedUpdates))
+			for _, call := range dbClientMock.Calls {
+				if call.Method != "UpdateDeviceProfile" {
+					continue
+				}
+				profile := call.Arguments.Get(0).(models.DeviceProfile)
+				for _, r := range profile.DeviceResources {
+					if !testCase.selector.match(r) {
+						continue
+					}
+					switch testCase.property {
+					case "units":
+						assert.Equal(t, testCase.newValue, r.Properties.Units)
+					case "minimum":
+						require.NotNil(t, r.Properties.Minimum)
+						assert.Equal(t, -40.0, *r.Properties.Minimum)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestBulkUpdateResourceProperty_ProfileChecks(t *testing.T) {
+	// the command timeout of a writable resource is rejected by the optional properties check once it is read-only
+	valve := models.DeviceProfile{Name: "valve", DeviceResources: []models.DeviceResource{{
+		Name:       "valve",
+		Properties: models.ResourceProperties{ValueType: common.ValueTypeBool, ReadWrite: common.ReadWrite_RW, Optional: map[string]any{CommandTimeoutKey: "30s"}},
+	}}}
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AllDeviceProfiles", 0, -1, []string(nil)).Return([]models.DeviceProfile{valve}, nil)
+	dic := labelsTestDic(false, dbClientMock)
+
+	updates, err := BulkUpdateResourceProperty(ResourceSelector{NamePattern: "valve"}, "readWrite", common.ReadWrite_R, false, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	assert.Contains(t, err.Error(), "device profile valve is invalid after the update")
+	assert.Nil(t, updates)
+	dbClientMock.AssertNotCalled(t, "UpdateDeviceProfile", mock.Anything)
+}
+
+func TestBulkUpdateResourceProperty_PartialFailure(t *testing.T) {
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AllDeviceProfiles", 0, -1, []string(nil)).Return(bulkUpdateTestProfiles(), nil)
+	dbClientMock.On("UpdateDeviceProfile", mock.MatchedBy(func(p models.DeviceProfile) bool { return p.Name == "thermostat" })).Return(nil)
+	dbClientMock.On("UpdateDeviceProfile", mock.MatchedBy(func(p models.DeviceProfile) bool { return p.Name == "sensor" })).
+		Return(errors.NewCommonEdgeX(errors.KindDatabaseError, "connection lost", nil))
+	dbClientMock.On("DeviceCountByProfileName", mock.Anything).Return(uint32(0), nil)
+	dic := labelsTestDic(false, dbClientMock)
+	syncSystemEventPublish(dic)
+
+	updates, err := BulkUpdateResourceProperty(ResourceSelector{NamePattern: "temperature*"}, "units", "C", false, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindDatabaseError, errors.Kind(err))
+	assert.Contains(t, err.Error(), "1 of 2 profiles are updated")
+	assert.Equal(t, []ResourcePropertyUpdate{{ProfileName: "thermostat", ResourceNames: []string{"temperature-indoor"}}}, updates,
+		"the already persisted update should be reported")
+}
+
+func TestDeviceResourceCommandTimeoutValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		readWrite     string
+		optional      map[string]any
+		expectedError bool
+	}{
+		{"valid - no command timeout", common.ReadWrite_R, map[string]any{"foo": "bar"}, false},
+		{"valid - writable", common.ReadWrite_W, map[string]any{CommandTimeoutKey: "30s"}, false},
+		{"valid - read-write", common.ReadWrite_RW, map[string]any{CommandTimeoutKey: "1m30s"}, false},
+		{"valid - write-read", common.ReadWrite_WR, map[string]any{CommandTimeoutKey: "500ms"}, false},
+		{"invalid - read-only", common.ReadWrite_R, map[string]any{CommandTimeoutKey: "30s"}, true},
+		{"invalid - malformed duration", common.ReadWrite_RW, map[string]any{CommandTimeoutKey: "slow"}, true},
+		{"invalid - not a string", common.ReadWrite_RW, map[string]any{CommandTimeoutKey: float64(30)}, true},
+		{"invalid - zero duration", common.ReadWrite_RW, map[string]any{CommandTimeoutKey: "0s"}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			resource := models.DeviceResource{
+				Name:       "valve",
+				Properties: models.ResourceProperties{ValueType: common.ValueTypeBool, ReadWrite: testCase.readWrite, Optional: testCase.optional},
+			}
+			err := deviceResourceCommandTimeoutValidation(resource)
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				assert.Contains(t, err.Error(), resource.Name)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeviceResourceSampleIntervalValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		optional      map[string]any
+		expectedError bool
+	}{
+		{"valid - no optional", nil, false},
+		{"valid - no sample interval", map[string]any{"foo": "bar"}, false},
+		{"valid - duration", map[string]any{SampleIntervalKey: "500ms"}, false},
+		{"valid - compound duration", map[string]any{SampleIntervalKey: "1m30s"}, false},
+		{"invalid - malformed duration", map[string]any{SampleIntervalKey: "fast"}, true},
+		{"invalid - missing unit", map[string]any{SampleIntervalKey: "10"}, true},
+		{"invalid - not a string", map[string]any{SampleIntervalKey: float64(10)}, true},
+		{"invalid - zero duration", map[string]any{SampleIntervalKey: "0s"}, true},
+		{"invalid - negative duration", map[string]any{SampleIntervalKey: "-1s"}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			resource := models.DeviceResource{
+				Name:       "temperature",
+				Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R, Optional: testCase.optional},
+			}
+			err := deviceResourceSampleIntervalValidation(resource)
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				assert.Contains(t, err.Error(), resource.Name)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeviceResourceAccessRolesValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		optional      map[string]any
+		expectedError bool
+	}{
+		{"valid - no access roles", nil, false},
+		{"valid - decoded roles", map[string]any{AccessRolesKey: []any{"operator", "admin"}}, false},
+		{"valid - string roles", map[string]any{AccessRolesKey: []string{"operator"}}, false},
+		{"invalid - empty list", map[string]any{AccessRolesKey: []any{}}, true},
+		{"invalid - empty role", map[string]any{AccessRolesKey: []any{"operator", ""}}, true},
+		{"invalid - blank role", map[string]any{AccessRolesKey: []string{" "}}, true},
+		{"invalid - non-string role", map[string]any{AccessRolesKey: []any{"operator", float64(1)}}, true},
+		{"invalid - not a list", map[string]any{AccessRolesKey: "operator"}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			resource := models.DeviceResource{
+				Name:       "setPoint",
+				Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW, Optional: testCase.optional},
+			}
+			err := deviceResourceOptionalPropertiesValidation(resource)
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				assert.Contains(t, err.Error(), resource.Name)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeviceResourceIsVirtualValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		readWrite     string
+		optional      map[string]any
+		expectedError bool
+	}{
+		{"valid - not virtual", common.ReadWrite_RW, nil, false},
+		{"valid - virtual and read-only", common.ReadWrite_R, map[string]any{IsVirtualKey: true}, false},
+		{"valid - explicitly not virtual and writable", common.ReadWrite_RW, map[string]any{IsVirtualKey: false}, false},
+		{"invalid - virtual and writable", common.ReadWrite_RW, map[string]any{IsVirtualKey: true}, true},
+		{"invalid - virtual and write-only", common.ReadWrite_W, map[string]any{IsVirtualKey: true}, true},
+		{"invalid - not a boolean", common.ReadWrite_R, map[string]any{IsVirtualKey: "true"}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			resource := models.DeviceResource{
+				Name:       "average",
+				Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: testCase.readWrite, Optional: testCase.optional},
+			}
+			err := deviceResourceOptionalPropertiesValidation(resource)
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				assert.Contains(t, err.Error(), resource.Name)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeviceResourceNullableValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		defaultValue  string
+		optional      map[string]any
+		expectedError bool
+	}{
+		{"valid - not nullable", "0", nil, false},
+		{"valid - nullable without defaultValue", "", map[string]any{NullableKey: true}, false},
+		{"valid - explicitly not nullable with defaultValue", "0", map[string]any{NullableKey: false}, false},
+		{"invalid - nullable with defaultValue", "0", map[string]any{NullableKey: true}, true},
+		{"invalid - not a boolean", "", map[string]any{NullableKey: "true"}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			resource := models.DeviceResource{
+				Name:       "temperature",
+				Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R, DefaultValue: testCase.defaultValue, Optional: testCase.optional},
+			}
+			err := deviceResourceOptionalPropertiesValidation(resource)
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				assert.Contains(t, err.Error(), resource.Name)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeviceResourceUniqueKeyValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		readWrite     string
+		optional      map[string]any
+		expectedError bool
+	}{
+		{"valid - not a unique key", common.ReadWrite_W, nil, false},
+		{"valid - readable unique key", common.ReadWrite_R, map[string]any{UniqueKeyKey: true}, false},
+		{"valid - read-write unique key", common.ReadWrite_RW, map[string]any{UniqueKeyKey: true}, false},
+		{"valid - explicitly not a unique key", common.ReadWrite_W, map[string]any{UniqueKeyKey: false}, false},
+		{"invalid - write-only unique key", common.ReadWrite_W, map[string]any{UniqueKeyKey: true}, true},
+		{"invalid - nullable unique key", common.ReadWrite_R, map[string]any{UniqueKeyKey: true, NullableKey: true}, true},
+		{"invalid - not a boolean", common.ReadWrite_R, map[string]any{UniqueKeyKey: "true"}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			resource := models.DeviceResource{
+				Name:       "serialNumber",
+				Properties: models.ResourceProperties{ValueType: common.ValueTypeString, ReadWrite: testCase.readWrite, Optional: testCase.optional},
+			}
+			err := deviceResourceOptionalPropertiesValidation(resource)
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				assert.Contains(t, err.Error(), resource.Name)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeviceResourceCoalesceGroupValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		readWrite     string
+		optional      map[string]any
+		expectedError bool
+	}{
+		{"valid - no coalesceGroup", common.ReadWrite_W, nil, false},
+		{"valid - readable resource", common.ReadWrite_R, map[string]any{CoalesceGroupKey: "holdingRegisters"}, false},
+		{"valid - read-write resource", common.ReadWrite_RW, map[string]any{CoalesceGroupKey: "holdingRegisters"}, false},
+		{"invalid - write-only resource", common.ReadWrite_W, map[string]any{CoalesceGroupKey: "holdingRegisters"}, true},
+		{"invalid - empty group", common.ReadWrite_R, map[string]any{CoalesceGroupKey: " "}, true},
+		{"invalid - not a string", common.ReadWrite_R, map[string]any{CoalesceGroupKey: 1}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			resource := models.DeviceResource{
+				Name:       "temperature",
+				Properties: models.ResourceProperties{ValueType: common.ValueTypeInt16, ReadWrite: testCase.readWrite, Optional: testCase.optional},
+			}
+			err := deviceResourceOptionalPropertiesValidation(resource)
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				assert.Contains(t, err.Error(), resource.Name)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeviceResourceFallbackPolicyValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		readWrite     string
+		defaultValue  string
+		optional      map[string]any
+		expectedError bool
+	}{
+		{"valid - no fallbackPolicy", common.ReadWrite_R, "", nil, false},
+		{"valid - default with defaultValue", common.ReadWrite_R, "0", map[string]any{FallbackPolicyKey: FallbackPolicyDefault}, false},
+		{"valid - lastKnown", common.ReadWrite_RW, "", map[string]any{FallbackPolicyKey: FallbackPolicyLastKnown}, false},
+		{"valid - none", common.ReadWrite_R, "", map[string]any{FallbackPolicyKey: FallbackPolicyNone}, false},
+		{"invalid - default without defaultValue", common.ReadWrite_R, "", map[string]any{FallbackPolicyKey: FallbackPolicyDefault}, true},
+		{"invalid - unknown policy", common.ReadWrite_R, "", map[string]any{FallbackPolicyKey: "previous"}, true},
+		{"invalid - not a string", common.ReadWrite_R, "", map[string]any{FallbackPolicyKey: true}, true},
+		{"invalid - write-only resource", common.ReadWrite_W, "", map[string]any{FallbackPolicyKey: FallbackPolicyLastKnown}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			resource := models.DeviceResource{
+				Name: "temperature",
+				Properties: models.ResourceProperties{
+					ValueType: common.ValueTypeInt16, ReadWrite: testCase.readWrite, DefaultValue: testCase.defaultValue, Optional: testCase.optional,
+				},
+			}
+			err := deviceResourceOptionalPropertiesValidation(resource)
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				assert.Contains(t, err.Error(), resource.Name)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeviceResourceEncodingValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		valueType     string
+		optional      map[string]any
+		expectedError bool
+	}{
+		{"valid - no encoding", common.ValueTypeBinary, nil, false},
+		{"valid - binary base64", common.ValueTypeBinary, map[string]any{EncodingKey: EncodingBase64}, false},
+		{"valid - object hex", common.ValueTypeObject, map[string]any{EncodingKey: EncodingHex}, false},
+		{"valid - object array raw", common.ValueTypeObjectArray, map[string]any{EncodingKey: EncodingRaw}, false},
+		{"invalid - unknown encoding", common.ValueTypeBinary, map[string]any{EncodingKey: "base32"}, true},
+		{"invalid - not a string", common.ValueTypeBinary, map[string]any{EncodingKey: 64}, true},
+		{"invalid - numeric resource", common.ValueTypeInt16, map[string]any{EncodingKey: EncodingBase64}, true},
+		{"invalid - string resource", common.ValueTypeString, map[string]any{EncodingKey: EncodingRaw}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			resource := models.DeviceResource{
+				Name:       "image",
+				Properties: models.ResourceProperties{ValueType: testCase.valueType, ReadWrite: common.ReadWrite_R, Optional: testCase.optional},
+			}
+			err := deviceResourceOptionalPropertiesValidation(resource)
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				assert.Contains(t, err.Error(), resource.Name)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeviceResourcesByCoalesceGroup(t *testing.T) {
+	grouped := func(name, group string) models.DeviceResource {
+		return models.DeviceResource{Name: name, Properties: models.ResourceProperties{
+			ValueType: common.ValueTypeInt16, ReadWrite: common.ReadWrite_R, Optional: map[string]any{CoalesceGroupKey: group},
+		}}
+	}
+	profile := models.DeviceProfile{
+		Name: "plc",
+		DeviceResources: []models.DeviceResource{
+			grouped("temperature", "holdingRegisters"),
+			grouped("status", "coils"),
+			{Name: "serialNumber", Properties: models.ResourceProperties{ValueType: common.ValueTypeString, ReadWrite: common.ReadWrite_R}},
+			grouped("pressure", "holdingRegisters"),
+		},
+	}
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", profile.Name).Return(profile, nil)
+	dbClientMock.On("DeviceProfileByName", "notFound").Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	groups, err := DeviceResourcesByCoalesceGroup(profile.Name, dic)
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	require.Len(t, groups["holdingRegisters"], 2)
+	assert.Equal(t, "temperature", groups["holdingRegisters"][0].Name)
+	assert.Equal(t, "pressure", groups["holdingRegisters"][1].Name)
+	require.Len(t, groups["coils"], 1)
+	assert.Equal(t, "status", groups["coils"][0].Name)
+
+	_, err = DeviceResourcesByCoalesceGroup("notFound", dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+
+	_, err = DeviceResourcesByCoalesceGroup("", dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestDeviceResourceAlarmThresholdsValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		valueType     string
+		optional      map[string]any
+		expectedError bool
+	}{
+		{"valid - no thresholds", common.ValueTypeString, nil, false},
+		{"valid - all thresholds", common.ValueTypeFloat32, map[string]any{CritLowKey: -10, WarnLowKey: 0.5, WarnHighKey: 80.0, CritHighKey: 100}, false},
+		{"valid - equal thresholds", common.ValueTypeInt16, map[string]any{WarnHighKey: 80, CritHighKey: 80}, false},
+		{"valid - partial thresholds", common.ValueTypeUint8, map[string]any{CritLowKey: 1, CritHighKey: 200}, false},
+		{"invalid - warnLow below critLow", common.ValueTypeFloat64, map[string]any{CritLowKey: 0, WarnLowKey: -5}, true},
+		{"invalid - critHigh below warnHigh", common.ValueTypeFloat64, map[string]any{WarnHighKey: 90, CritHighKey: 80}, true},
+		{"invalid - warnHigh below critLow", common.ValueTypeFloat64, map[string]any{CritLowKey: 10, WarnHighKey: 5}, true},
+		{"invalid - not a number", common.ValueTypeInt32, map[string]any{WarnHighKey: "80"}, true},
+		{"invalid - not numeric value type", common.ValueTypeString, map[string]any{CritHighKey: 100}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			resource := models.DeviceResource{
+				Name:       "temperature",
+				Properties: models.ResourceProperties{ValueType: testCase.valueType, ReadWrite: common.ReadWrite_R, Optional: testCase.optional},
+			}
+			err := deviceResourceOptionalPropertiesValidation(resource)
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				assert.Contains(t, err.Error(), resource.Name)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func aliasesTestResource(name string, aliases ...any) models.DeviceResource {
+	r := models.DeviceResource{
+		Name:       name,
+		Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW},
+	}
+	if len(aliases) > 0 {
+		r.Properties.Optional = map[string]any{AliasesKey: aliases}
+	}
+	return r
+}
+
+func TestDeviceResourceAliasesValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		resources     []models.DeviceResource
+		expectedError bool
+	}{
+		{"valid - no aliases", []models.DeviceResource{aliasesTestResource("temperature"), aliasesTestResource("humidity")}, false},
+		{"valid - unique aliases", []models.DeviceResource{aliasesTestResource("temperature", "temp", "t"), aliasesTestResource("humidity", "hum")}, false},
+		{"invalid - blank alias", []models.DeviceResource{aliasesTestResource("temperature", " ")}, true},
+		{"invalid - alias same as its name", []models.DeviceResource{aliasesTestResource("temperature", "temperature")}, true},
+		{"invalid - alias collides with other resource name", []models.DeviceResource{aliasesTestResource("temperature", "humidity"), aliasesTestResource("humidity")}, true},
+		{"invalid - alias collides with other resource alias", []models.DeviceResource{aliasesTestResource("temperature", "t"), aliasesTestResource("humidity", "t")}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := deviceProfileOptionalPropertiesValidation(models.DeviceProfile{Name: "sensor", DeviceResources: testCase.resources})
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeviceResourceByProfileNameAndResourceName_Alias(t *testing.T) {
+	profile := models.DeviceProfile{
+		Name:            "sensor",
+		DeviceResources: []models.DeviceResource{aliasesTestResource("temperature", "temp"), aliasesTestResource("humidity", "hum")},
+	}
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", profile.Name).Return(profile, nil)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	resource, err := DeviceResourceByProfileNameAndResourceName(profile.Name, "hum", dic)
+	require.NoError(t, err)
+	assert.Equal(t, "humidity", resource.Name, "the alias should resolve to the canonical resource")
+
+	resource, err = DeviceResourceByProfileNameAndResourceName(profile.Name, "temperature", dic)
+	require.NoError(t, err)
+	assert.Equal(t, "temperature", resource.Name)
+
+	_, err = DeviceResourceByProfileNameAndResourceName(profile.Name, "pressure", dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+}
+
+func TestDeviceResourceDisplayUnitValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		valueType     string
+		optional      map[string]any
+		expectedError bool
+	}{
+		{"valid - no display unit", common.ValueTypeFloat32, nil, false},
+		{"valid - display unit only", common.ValueTypeFloat32, map[string]any{DisplayUnitKey: "degF"}, false},
+		{"valid - display unit and conversion", common.ValueTypeInt16, map[string]any{DisplayUnitKey: "degF", ConversionFactorKey: 1.8, ConversionOffsetKey: float64(32)}, false},
+		{"valid - integer conversion", common.ValueTypeUint32, map[string]any{DisplayUnitKey: "ms", ConversionFactorKey: 1000}, false},
+		{"invalid - non-numeric value type", common.ValueTypeString, map[string]any{DisplayUnitKey: "degF"}, true},
+		{"invalid - conversion without display unit", common.ValueTypeFloat32, map[string]any{ConversionFactorKey: 1.8}, true},
+		{"invalid - empty display unit", common.ValueTypeFloat32, map[string]any{DisplayUnitKey: ""}, true},
+		{"invalid - non-numeric factor", common.ValueTypeFloat32, map[string]any{DisplayUnitKey: "degF", ConversionFactorKey: "1.8"}, true},
+		{"invalid - zero factor", common.ValueTypeFloat32, map[string]any{DisplayUnitKey: "degF", ConversionFactorKey: float64(0)}, true},
+		{"invalid - non-numeric offset", common.ValueTypeFloat32, map[string]any{DisplayUnitKey: "degF", ConversionOffsetKey: true}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			resource := models.DeviceResource{
+				Name:       "temperature",
+				Properties: models.ResourceProperties{ValueType: testCase.valueType, ReadWrite: common.ReadWrite_R, Units: "degC", Optional: testCase.optional},
+			}
+			err := deviceResourceOptionalPropertiesValidation(resource)
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				assert.Contains(t, err.Error(), resource.Name)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeviceResourceUoMValidation_DisplayUnit(t *testing.T) {
+	uomMock := &mocks.UnitsOfMeasure{}
+	uomMock.On("Validate", "degC").Return(true)
+	uomMock.On("Validate", "degF").Return(true)
+	uomMock.On("Validate", "unknown").Return(false)
+	configuration := &config.ConfigurationStruct{}
+	configuration.Writable.UoM.Validation = true
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return configuration
+		},
+		container.UnitsOfMeasureInterfaceName: func(get di.Get) interface{} {
+			return uomMock
+		},
+	})
+
+	resource := models.DeviceResource{
+		Name:       "temperature",
+		Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, Units: "degC", Optional: map[string]any{DisplayUnitKey: "degF"}},
+	}
+	require.NoError(t, deviceResourceUoMValidation(resource, dic))
+
+	resource.Properties.Optional[DisplayUnitKey] = "unknown"
+	err := deviceResourceUoMValidation(resource, dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestDeviceProfileUoMValidation_Mode(t *testing.T) {
+	uomMock := &mocks.UnitsOfMeasure{}
+	uomMock.On("Validate", "degC").Return(true)
+	uomMock.On("Validate", "legacy").Return(false)
+	configuration := &config.ConfigurationStruct{}
+	configuration.Writable.UoM.Validation = true
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return configuration
+		},
+		container.UnitsOfMeasureInterfaceName: func(get di.Get) interface{} {
+			return uomMock
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+	profile := models.DeviceProfile{Name: "legacy", DeviceResources: []models.DeviceResource{
+		{Name: "temperature", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, Units: "degC"}},
+		{Name: "level", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, Units: "legacy"}},
+	}}
+
+	tests := []struct {
+		name          string
+		mode          string
+		errorExpected bool
+	}{
+		{"default to reject", "", true},
+		{"reject", config.UoMModeReject, true},
+		{"warn", config.UoMModeWarn, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			configuration.Writable.UoM.Mode = testCase.mode
+			err := deviceProfileUoMValidation(profile, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				assert.Contains(t, err.Error(), "level")
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDeviceResourcePhysicalLimitsValidation(t *testing.T) {
+	absoluteZero := -273.15
+	uomMock := &mocks.UnitsOfMeasure{}
+	uomMock.On("PhysicalLimits", "C").Return(&absoluteZero, nil, true)
+	uomMock.On("PhysicalLimits", "lbs").Return(nil, nil, false)
+	configuration := &config.ConfigurationStruct{}
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return configuration
+		},
+		container.UnitsOfMeasureInterfaceName: func(get di.Get) interface{} {
+			return uomMock
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+	bound := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		name          string
+		mode          string
+		units         string
+		minimum       *float64
+		maximum       *float64
+		errorExpected bool
+	}{
+		{"disabled", "", "C", bound(-300), nil, false},
+		{"within the limits", config.UoMModeReject, "C", bound(-40), bound(125), false},
+		{"minimum below the absolute zero", config.UoMModeReject, "C", bound(-300), bound(125), true},
+		{"maximum below the absolute zero", config.UoMModeReject, "C", nil, bound(-280), true},
+		{"warn only", config.UoMModeWarn, "C", bound(-300), nil, false},
+		{"units without limits", config.UoMModeReject, "lbs", bound(-10), nil, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			configuration.Writable.UoM.PhysicalLimitsMode = testCase.mode
+			resource := models.DeviceResource{Name: "temperature", Properties: models.ResourceProperties{
+				ValueType: common.ValueTypeFloat32, Units: testCase.units, Minimum: testCase.minimum, Maximum: testCase.maximum,
+			}}
+			err := deviceResourceUoMValidation(resource, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
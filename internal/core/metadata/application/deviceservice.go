@@ -40,7 +40,9 @@ func AddDeviceService(d models.DeviceService, ctx context.Context, dic *di.Conta
 		correlationId,
 	)
 	DeviceServiceDTO := dtos.FromDeviceServiceModelToDTO(d)
-	go publishSystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionAdd, d.Name, DeviceServiceDTO, ctx, dic)
+	if err := notifySystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionAdd, d.Name, DeviceServiceDTO, ctx, dic); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
 	return addedDeviceService.Id, nil
 }
 
@@ -80,7 +82,9 @@ func PatchDeviceService(dto dtos.UpdateDeviceService, ctx context.Context, dic *
 		correlation.FromContext(ctx),
 	)
 	DeviceServiceDTO := dtos.FromDeviceServiceModelToDTO(deviceService)
-	go publishSystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionUpdate, deviceService.Name, DeviceServiceDTO, ctx, dic)
+	if err := notifySystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionUpdate, deviceService.Name, DeviceServiceDTO, ctx, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 	return nil
 }
 
@@ -134,7 +138,9 @@ func DeleteDeviceServiceByName(name string, ctx context.Context, dic *di.Contain
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 	DeviceServiceDTO := dtos.FromDeviceServiceModelToDTO(deviceService)
-	go publishSystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionDelete, deviceService.Name, DeviceServiceDTO, ctx, dic)
+	if err := notifySystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionDelete, deviceService.Name, DeviceServiceDTO, ctx, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 	return nil
 }
 
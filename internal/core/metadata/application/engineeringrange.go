@@ -0,0 +1,97 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// engineeringRangePageSize is the number of the device profiles queried per page to build the engineering range report
+const engineeringRangePageSize = 100
+
+// MissingEngineeringRange is a numeric device resource which doesn't declare both the Minimum and the Maximum
+type MissingEngineeringRange struct {
+	ProfileName    string `json:"profileName"`
+	ResourceName   string `json:"resourceName"`
+	ValueType      string `json:"valueType"`
+	MissingMinimum bool   `json:"missingMinimum"`
+	MissingMaximum bool   `json:"missingMaximum"`
+}
+
+// DeviceResourcesMissingEngineeringRange returns the numeric device resources without both the Minimum and the
+// Maximum across the device profiles, ordered as the device profiles are queried. The device profiles are queried page
+// by page, and only the report entries in the offset and limit range are kept, so the memory is bounded by the limit.
+func DeviceResourcesMissingEngineeringRange(offset, limit int, dic *di.Container) (missing []MissingEngineeringRange, totalCount uint32, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	missing = []MissingEngineeringRange{}
+	for profileOffset := 0; ; profileOffset += engineeringRangePageSize {
+		profiles, err := dbClient.AllDeviceProfiles(profileOffset, engineeringRangePageSize, nil)
+		if err != nil {
+			return missing, totalCount, errors.NewCommonEdgeXWrapper(err)
+		}
+		for _, p := range profiles {
+			for _, r := range p.DeviceResources {
+				entry, ok := missingEngineeringRange(p.Name, r)
+				if !ok {
+					continue
+				}
+				if int(totalCount) >= offset && (limit < 0 || len(missing) < limit) {
+					missing = append(missing, entry)
+				}
+				totalCount++
+			}
+		}
+		if len(profiles) < engineeringRangePageSize {
+			break
+		}
+	}
+	if _, err = utils.CheckCountRange(totalCount, offset, limit); err != nil {
+		return []MissingEngineeringRange{}, totalCount, err
+	}
+	return missing, totalCount, nil
+}
+
+// missingEngineeringRange returns the report entry of the device resource if it is numeric and misses any bound
+func missingEngineeringRange(profileName string, r models.DeviceResource) (MissingEngineeringRange, bool) {
+	if !isNumericValueType(r.Properties.ValueType) || (r.Properties.Minimum != nil && r.Properties.Maximum != nil) {
+		return MissingEngineeringRange{}, false
+	}
+	return MissingEngineeringRange{
+		ProfileName:    profileName,
+		ResourceName:   r.Name,
+		ValueType:      r.Properties.ValueType,
+		MissingMinimum: r.Properties.Minimum == nil,
+		MissingMaximum: r.Properties.Maximum == nil,
+	}, true
+}
+
+// deviceResourceEngineeringRangeValidation rejects the numeric device resource without both the Minimum and the
+// Maximum when Writable.RequireEngineeringRange is enabled
+func deviceResourceEngineeringRangeValidation(r models.DeviceResource, dic *di.Container) errors.EdgeX {
+	if !container.ConfigurationFrom(dic.Get).Writable.RequireEngineeringRange {
+		return nil
+	}
+	if _, ok := missingEngineeringRange("", r); ok {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s of the numeric valueType %s must declare both minimum and maximum when RequireEngineeringRange is enabled", r.Name, r.Properties.ValueType), nil)
+	}
+	return nil
+}
+
+func deviceProfileEngineeringRangeValidation(p models.DeviceProfile, dic *di.Container) errors.EdgeX {
+	for _, dr := range p.DeviceResources {
+		if err := deviceResourceEngineeringRangeValidation(dr, dic); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
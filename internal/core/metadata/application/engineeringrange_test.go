@@ -0,0 +1,87 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func engineeringRangeResource(name string, valueType string, minimum, maximum *float64) models.DeviceResource {
+	return models.DeviceResource{Name: name, Properties: models.ResourceProperties{ValueType: valueType, Minimum: minimum, Maximum: maximum}}
+}
+
+func TestDeviceResourcesMissingEngineeringRange(t *testing.T) {
+	bound := 10.0
+	// the first page is full, so the second page is queried
+	firstPage := make([]models.DeviceProfile, engineeringRangePageSize)
+	for i := range firstPage {
+		firstPage[i] = models.DeviceProfile{Name: fmt.Sprintf("profile%d", i), DeviceResources: []models.DeviceResource{
+			engineeringRangeResource("bounded", common.ValueTypeFloat32, &bound, &bound),
+			engineeringRangeResource("text", common.ValueTypeString, nil, nil),
+		}}
+	}
+	firstPage[0].DeviceResources = append(firstPage[0].DeviceResources, engineeringRangeResource("noMax", common.ValueTypeInt16, &bound, nil))
+	secondPage := []models.DeviceProfile{{Name: "last", DeviceResources: []models.DeviceResource{
+		engineeringRangeResource("noMin", common.ValueTypeUint8, nil, &bound),
+		engineeringRangeResource("noBounds", common.ValueTypeFloat64, nil, nil),
+	}}}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AllDeviceProfiles", 0, engineeringRangePageSize, []string(nil)).Return(firstPage, nil)
+	dbClientMock.On("AllDeviceProfiles", engineeringRangePageSize, engineeringRangePageSize, []string(nil)).Return(secondPage, nil)
+	dic := labelsTestDic(false, dbClientMock)
+
+	missing, totalCount, err := DeviceResourcesMissingEngineeringRange(0, -1, dic)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), totalCount)
+	assert.Equal(t, []MissingEngineeringRange{
+		{ProfileName: "profile0", ResourceName: "noMax", ValueType: common.ValueTypeInt16, MissingMaximum: true},
+		{ProfileName: "last", ResourceName: "noMin", ValueType: common.ValueTypeUint8, MissingMinimum: true},
+		{ProfileName: "last", ResourceName: "noBounds", ValueType: common.ValueTypeFloat64, MissingMinimum: true, MissingMaximum: true},
+	}, missing)
+
+	missing, totalCount, err = DeviceResourcesMissingEngineeringRange(1, 1, dic)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), totalCount)
+	require.Len(t, missing, 1)
+	assert.Equal(t, "noMin", missing[0].ResourceName)
+
+	_, _, err = DeviceResourcesMissingEngineeringRange(4, 1, dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindRangeNotSatisfiable, errors.Kind(err))
+}
+
+func TestDeviceProfileEngineeringRangeValidation(t *testing.T) {
+	bound := 10.0
+	profile := models.DeviceProfile{Name: "thermostat", DeviceResources: []models.DeviceResource{
+		engineeringRangeResource("bounded", common.ValueTypeFloat32, &bound, &bound),
+		engineeringRangeResource("text", common.ValueTypeString, nil, nil),
+		engineeringRangeResource("noMax", common.ValueTypeInt16, &bound, nil),
+	}}
+
+	dic := labelsTestDic(false, nil)
+	assert.NoError(t, deviceProfileEngineeringRangeValidation(profile, dic), "the engineering range is not required by default")
+
+	container.ConfigurationFrom(dic.Get).Writable.RequireEngineeringRange = true
+	err := deviceProfileEngineeringRangeValidation(profile, dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	assert.Contains(t, err.Error(), "noMax")
+
+	profile.DeviceResources = profile.DeviceResources[:2]
+	assert.NoError(t, deviceProfileEngineeringRangeValidation(profile, dic))
+}
@@ -0,0 +1,85 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// thresholdInference fills the missing alarm threshold from the declared one of the same side, or from the bound of
+// that side, i.e. the Maximum of the high thresholds and the Minimum of the low ones, if the bound doesn't cross the
+// declared threshold
+type thresholdInference struct {
+	missing   string
+	declared  string
+	fromBound bool
+	high      bool
+}
+
+var thresholdInferences = []thresholdInference{
+	{missing: CritHighKey, declared: WarnHighKey, fromBound: true, high: true},
+	{missing: WarnHighKey, declared: CritHighKey, high: true},
+	{missing: CritLowKey, declared: WarnLowKey, fromBound: true},
+	{missing: WarnLowKey, declared: CritLowKey},
+}
+
+// inferDeviceResourceDefaults fills the numeric fields the numeric device resource omits from the dependent ones it
+// declares, when Writable.InferResourceDefaults is enabled. The rules are:
+//   - the Scale without the Offset infers the Offset 0, and the Offset without the Scale infers the Scale 1
+//   - the warnHigh without the critHigh infers the critHigh as the Maximum if it is not below the warnHigh, otherwise as
+//     the warnHigh, and the warnLow without the critLow infers the critLow as the Minimum or the warnLow likewise
+//   - the critHigh without the warnHigh infers the warnHigh as the critHigh, and the critLow without the warnLow infers
+//     the warnLow as the critLow
+//
+// The explicit values always win, and the inconsistent explicit values are left for the validation to reject.
+func inferDeviceResourceDefaults(r *models.DeviceResource, dic *di.Container) {
+	if !container.ConfigurationFrom(dic.Get).Writable.InferResourceDefaults || !isNumericValueType(r.Properties.ValueType) {
+		return
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if r.Properties.Scale != nil && r.Properties.Offset == nil {
+		offset := 0.0
+		r.Properties.Offset = &offset
+		lc.Infof("DeviceResource %s offset is inferred as %v from the scale %v", r.Name, offset, *r.Properties.Scale)
+	} else if r.Properties.Offset != nil && r.Properties.Scale == nil {
+		scale := 1.0
+		r.Properties.Scale = &scale
+		lc.Infof("DeviceResource %s scale is inferred as %v from the offset %v", r.Name, scale, *r.Properties.Offset)
+	}
+
+	for _, inference := range thresholdInferences {
+		if value, ok := r.Properties.Optional[inference.missing]; ok && value != nil {
+			continue
+		}
+		declared, ok := optionalNumber(r.Properties.Optional[inference.declared])
+		if !ok {
+			continue
+		}
+		inferred, source := declared, inference.declared
+		if inference.fromBound {
+			if inference.high && r.Properties.Maximum != nil && *r.Properties.Maximum >= declared {
+				inferred, source = *r.Properties.Maximum, "maximum"
+			} else if !inference.high && r.Properties.Minimum != nil && *r.Properties.Minimum <= declared {
+				inferred, source = *r.Properties.Minimum, "minimum"
+			}
+		}
+		r.Properties.Optional[inference.missing] = inferred
+		lc.Infof("DeviceResource %s %s is inferred as %v from the %s", r.Name, inference.missing, inferred, source)
+	}
+}
+
+// inferDeviceProfileResourceDefaults fills the numeric fields of the device resources of the profile, see
+// inferDeviceResourceDefaults
+func inferDeviceProfileResourceDefaults(p *models.DeviceProfile, dic *di.Container) {
+	for i := range p.DeviceResources {
+		inferDeviceResourceDefaults(&p.DeviceResources[i], dic)
+	}
+}
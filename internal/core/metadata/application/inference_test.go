@@ -0,0 +1,85 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInferDeviceResourceDefaults(t *testing.T) {
+	float := func(v float64) *float64 { return &v }
+	tests := []struct {
+		name             string
+		properties       models.ResourceProperties
+		expectedScale    *float64
+		expectedOffset   *float64
+		expectedOptional map[string]any
+	}{
+		{"scale infers offset",
+			models.ResourceProperties{ValueType: common.ValueTypeFloat32, Scale: float(0.1)},
+			float(0.1), float(0), nil},
+		{"offset infers scale",
+			models.ResourceProperties{ValueType: common.ValueTypeInt16, Offset: float(-40)},
+			float(1), float(-40), nil},
+		{"explicit scale and offset win",
+			models.ResourceProperties{ValueType: common.ValueTypeFloat32, Scale: float(2), Offset: float(3)},
+			float(2), float(3), nil},
+		{"warnHigh infers critHigh from maximum",
+			models.ResourceProperties{ValueType: common.ValueTypeFloat32, Maximum: float(100), Optional: map[string]any{WarnHighKey: 80.0}},
+			nil, nil, map[string]any{WarnHighKey: 80.0, CritHighKey: 100.0}},
+		{"warnHigh infers critHigh from itself below maximum",
+			models.ResourceProperties{ValueType: common.ValueTypeFloat32, Maximum: float(50), Optional: map[string]any{WarnHighKey: 80.0}},
+			nil, nil, map[string]any{WarnHighKey: 80.0, CritHighKey: 80.0}},
+		{"critLow infers warnLow",
+			models.ResourceProperties{ValueType: common.ValueTypeInt32, Optional: map[string]any{CritLowKey: -10}},
+			nil, nil, map[string]any{CritLowKey: -10, WarnLowKey: -10.0}},
+		{"warnLow infers critLow from minimum",
+			models.ResourceProperties{ValueType: common.ValueTypeInt32, Minimum: float(-20), Optional: map[string]any{WarnLowKey: 5.0}},
+			nil, nil, map[string]any{WarnLowKey: 5.0, CritLowKey: -20.0}},
+		{"explicit thresholds win",
+			models.ResourceProperties{ValueType: common.ValueTypeFloat64, Optional: map[string]any{WarnHighKey: 90.0, CritHighKey: 80.0}},
+			nil, nil, map[string]any{WarnHighKey: 90.0, CritHighKey: 80.0}},
+		{"non-numeric value type",
+			models.ResourceProperties{ValueType: common.ValueTypeString, Scale: float(2), Optional: map[string]any{WarnHighKey: 80.0}},
+			float(2), nil, map[string]any{WarnHighKey: 80.0}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := labelsTestDic(false, nil)
+			container.ConfigurationFrom(dic.Get).Writable.InferResourceDefaults = true
+			r := models.DeviceResource{Name: "temperature", Properties: testCase.properties}
+
+			inferDeviceResourceDefaults(&r, dic)
+			assert.Equal(t, testCase.expectedScale, r.Properties.Scale)
+			assert.Equal(t, testCase.expectedOffset, r.Properties.Offset)
+			assert.Equal(t, testCase.expectedOptional, r.Properties.Optional)
+		})
+	}
+}
+
+func TestInferDeviceProfileResourceDefaults(t *testing.T) {
+	scale := 0.1
+	profile := models.DeviceProfile{Name: "thermostat", DeviceResources: []models.DeviceResource{
+		{Name: "temperature", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, Scale: &scale}},
+	}}
+	dic := labelsTestDic(false, nil)
+
+	inferDeviceProfileResourceDefaults(&profile, dic)
+	assert.Nil(t, profile.DeviceResources[0].Properties.Offset, "nothing should be inferred when the inference is disabled")
+
+	container.ConfigurationFrom(dic.Get).Writable.InferResourceDefaults = true
+	inferDeviceProfileResourceDefaults(&profile, dic)
+	require.NotNil(t, profile.DeviceResources[0].Properties.Offset)
+	assert.Zero(t, *profile.DeviceResources[0].Properties.Offset)
+}
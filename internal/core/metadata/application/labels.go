@@ -0,0 +1,179 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// normalizeLabels trims and lowercases the labels and removes the empty and duplicated ones, keeping the order of their
+// first occurrence
+func normalizeLabels(labels []string) []string {
+	if labels == nil {
+		return nil
+	}
+	normalized := make([]string, 0, len(labels))
+	for _, label := range labels {
+		label = strings.ToLower(strings.TrimSpace(label))
+		if label == "" || slices.Contains(normalized, label) {
+			continue
+		}
+		normalized = append(normalized, label)
+	}
+	return normalized
+}
+
+// normalizeDeviceProfileLabels normalizes the labels of the device profile when Writable.NormalizeLabels is enabled
+func normalizeDeviceProfileLabels(p *models.DeviceProfile, dic *di.Container) {
+	if !container.ConfigurationFrom(dic.Get).Writable.NormalizeLabels {
+		return
+	}
+	normalized := normalizeLabels(p.Labels)
+	if !slices.Equal(normalized, p.Labels) {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Infof("DeviceProfile %s labels %v are normalized to %v", p.Name, p.Labels, normalized)
+		p.Labels = normalized
+	}
+}
+
+// normalizeQueryLabels normalizes the label query parameters when Writable.NormalizeLabels is enabled, so the searches
+// still match the normalized labels
+func normalizeQueryLabels(labels []string, dic *di.Container) []string {
+	if !container.ConfigurationFrom(dic.Get).Writable.NormalizeLabels {
+		return labels
+	}
+	return normalizeLabels(labels)
+}
+
+// applyDefaultLabels merges Writable.DefaultLabels into the labels of the device profile. The default labels are only
+// merged on update when Writable.StrictDefaultLabels is enabled, so the removed default labels are not added back.
+func applyDefaultLabels(p *models.DeviceProfile, isUpdate bool, dic *di.Container) {
+	writable := container.ConfigurationFrom(dic.Get).Writable
+	if len(writable.DefaultLabels) == 0 || (isUpdate && !writable.StrictDefaultLabels) {
+		return
+	}
+	var added []string
+	for _, label := range writable.DefaultLabels {
+		if label == "" || slices.Contains(p.Labels, label) || slices.Contains(added, label) {
+			continue
+		}
+		added = append(added, label)
+	}
+	if len(added) == 0 {
+		return
+	}
+	p.Labels = append(p.Labels, added...)
+	bootstrapContainer.LoggingClientFrom(dic.Get).Infof("DeviceProfile %s default labels %v are added", p.Name, added)
+}
+
+// ProfileSelector selects the device profiles. A device profile matches if it has the Manufacturer and the Model of the
+// selector and its labels contain all the Labels of the selector, where the empty fields match any device profile.
+type ProfileSelector struct {
+	Manufacturer string
+	Model        string
+	Labels       []string
+}
+
+func (s ProfileSelector) match(p models.DeviceProfile) bool {
+	if (s.Manufacturer != "" && p.Manufacturer != s.Manufacturer) || (s.Model != "" && p.Model != s.Model) {
+		return false
+	}
+	for _, label := range s.Labels {
+		if !slices.Contains(p.Labels, label) {
+			return false
+		}
+	}
+	return true
+}
+
+// selectDeviceProfiles returns the device profiles matched by the selector, which are queried by the manufacturer and
+// the model when they are selected
+func selectDeviceProfiles(selector ProfileSelector, dic *di.Container) ([]models.DeviceProfile, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	var profiles []models.DeviceProfile
+	var err errors.EdgeX
+	switch {
+	case selector.Manufacturer != "" && selector.Model != "":
+		profiles, err = dbClient.DeviceProfilesByManufacturerAndModel(0, -1, selector.Manufacturer, selector.Model)
+	case selector.Manufacturer != "":
+		profiles, err = dbClient.DeviceProfilesByManufacturer(0, -1, selector.Manufacturer)
+	case selector.Model != "":
+		profiles, err = dbClient.DeviceProfilesByModel(0, -1, selector.Model)
+	default:
+		profiles, err = dbClient.AllDeviceProfiles(0, -1, nil)
+	}
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	var matched []models.DeviceProfile
+	for _, profile := range profiles {
+		if selector.match(profile) {
+			matched = append(matched, profile)
+		}
+	}
+	return matched, nil
+}
+
+// BulkAddLabelToProfiles adds the label to all the device profiles matched by the selector, and returns the names of
+// the device profiles the label is added to. The device profiles already having the label are left unchanged. An
+// update system event is published for each updated profile. With dryRun, the names are returned without persisting
+// any change.
+func BulkAddLabelToProfiles(selector ProfileSelector, label string, dryRun bool, ctx context.Context, dic *di.Container) ([]string, errors.EdgeX) {
+	if selector.Manufacturer == "" && selector.Model == "" && len(selector.Labels) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "profile selector is empty", nil)
+	}
+	if container.ConfigurationFrom(dic.Get).Writable.NormalizeLabels {
+		label = strings.ToLower(strings.TrimSpace(label))
+		selector.Labels = normalizeLabels(selector.Labels)
+	}
+	if strings.TrimSpace(label) == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "label is empty", nil)
+	}
+
+	profiles, err := selectDeviceProfiles(selector, dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	names := []string{}
+	var labeledProfiles []models.DeviceProfile
+	for _, profile := range profiles {
+		if slices.Contains(profile.Labels, label) {
+			continue
+		}
+		names = append(names, profile.Name)
+		labeledProfiles = append(labeledProfiles, profile)
+	}
+	if dryRun {
+		return names, nil
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	for _, original := range labeledProfiles {
+		profile := cloneDeviceProfile(original)
+		profile.Labels = append(slices.Clone(profile.Labels), label)
+		if err := dbClient.UpdateDeviceProfile(profile); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to update device profile %s", profile.Name), err)
+		}
+		lc.Debugf("DeviceProfile %s label %s added on DB successfully. Correlation-id: %s ", profile.Name, label, correlation.FromContext(ctx))
+		if err := notifyUpdateDeviceProfileSystemEvent(original, dtos.FromDeviceProfileModelToDTO(profile), ctx, dic); err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return names, nil
+}
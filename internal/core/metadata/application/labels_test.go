@@ -0,0 +1,171 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/utils"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeLabels(t *testing.T) {
+	assert.Equal(t, []string{"hvac", "floor1"}, normalizeLabels([]string{"HVAC", " hvac ", "Floor1", "", "  "}))
+	assert.Equal(t, []string{}, normalizeLabels([]string{" "}))
+	assert.Nil(t, normalizeLabels(nil))
+}
+
+func labelsTestDic(normalize bool, dbClient *mocks.DBClient) *di.Container {
+	configuration := &config.ConfigurationStruct{}
+	configuration.Writable.NormalizeLabels = normalize
+	return di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return configuration
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClient
+		},
+		container.DeviceProfileCacheName: func(get di.Get) interface{} {
+			return utils.NewDeviceProfileCache()
+		},
+		container.DeviceResourceHistoryName: func(get di.Get) interface{} {
+			return utils.NewDeviceResourceHistory()
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+}
+
+func TestNormalizeDeviceProfileLabels(t *testing.T) {
+	profile := models.DeviceProfile{Name: "thermostat", Labels: []string{"HVAC", " hvac "}}
+
+	normalizeDeviceProfileLabels(&profile, labelsTestDic(false, nil))
+	assert.Equal(t, []string{"HVAC", " hvac "}, profile.Labels, "the labels should be kept when the normalization is disabled")
+
+	normalizeDeviceProfileLabels(&profile, labelsTestDic(true, nil))
+	assert.Equal(t, []string{"hvac"}, profile.Labels)
+}
+
+func TestApplyDefaultLabels(t *testing.T) {
+	dic := labelsTestDic(false, nil)
+	configuration := container.ConfigurationFrom(dic.Get)
+	configuration.Writable.DefaultLabels = []string{"env-production", "hvac", "env-production"}
+
+	profile := models.DeviceProfile{Name: "thermostat", Labels: []string{"hvac"}}
+	applyDefaultLabels(&profile, false, dic)
+	assert.Equal(t, []string{"hvac", "env-production"}, profile.Labels, "the default labels should be merged without duplicates")
+
+	profile.Labels = []string{"hvac"}
+	applyDefaultLabels(&profile, true, dic)
+	assert.Equal(t, []string{"hvac"}, profile.Labels, "the removed default labels should not be added back on update")
+
+	configuration.Writable.StrictDefaultLabels = true
+	applyDefaultLabels(&profile, true, dic)
+	assert.Equal(t, []string{"hvac", "env-production"}, profile.Labels, "the default labels should be added back on update when strict")
+}
+
+func TestAllDeviceProfiles_NormalizeQueryLabels(t *testing.T) {
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileCountByLabels", []string{"hvac"}).Return(uint32(1), nil)
+	dbClientMock.On("AllDeviceProfiles", 0, 10, []string{"hvac"}).Return([]models.DeviceProfile{{Name: "thermostat", Labels: []string{"hvac"}}}, nil)
+
+	profiles, totalCount, err := AllDeviceProfiles(0, 10, []string{" HVAC"}, "", labelsTestDic(true, dbClientMock))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), totalCount)
+	assert.Len(t, profiles, 1)
+}
+
+func TestBulkAddLabelToProfiles(t *testing.T) {
+	profiles := []models.DeviceProfile{
+		{Name: "thermostat", Manufacturer: "acme", Model: "t1", Labels: []string{"hvac"}},
+		{Name: "humidistat", Manufacturer: "acme", Model: "h1", Labels: []string{"hvac", "floor-1"}},
+		{Name: "camera", Manufacturer: "acme", Model: "c1"},
+	}
+	tests := []struct {
+		name          string
+		selector      ProfileSelector
+		label         string
+		dryRun        bool
+		expectedNames []string
+		errorKind     errors.ErrKind
+	}{
+		{"by manufacturer", ProfileSelector{Manufacturer: "acme"}, "floor-1", false, []string{"thermostat", "camera"}, ""},
+		{"by manufacturer and existing label", ProfileSelector{Manufacturer: "acme", Labels: []string{"hvac"}}, "building-a", false, []string{"thermostat", "humidistat"}, ""},
+		{"by model", ProfileSelector{Model: "c1"}, "video", false, []string{"camera"}, ""},
+		{"dry run", ProfileSelector{Labels: []string{"hvac"}}, "building-a", true, []string{"thermostat", "humidistat"}, ""},
+		{"label already added", ProfileSelector{Labels: []string{"floor-1"}}, "hvac", false, []string{}, ""},
+		{"empty selector", ProfileSelector{}, "hvac", false, nil, errors.KindContractInvalid},
+		{"empty label", ProfileSelector{Manufacturer: "acme"}, " ", false, nil, errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &mocks.DBClient{}
+			dbClientMock.On("AllDeviceProfiles", 0, -1, []string(nil)).Return(profiles, nil)
+			dbClientMock.On("DeviceProfilesByManufacturer", 0, -1, "acme").Return(profiles, nil)
+			dbClientMock.On("DeviceProfilesByModel", 0, -1, "c1").Return(profiles[2:], nil)
+			dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+			dbClientMock.On("DevicesByProfileName", 0, -1, mock.Anything).Return([]models.Device{}, nil)
+			dbClientMock.On("DeviceCountByProfileName", mock.Anything).Return(uint32(0), nil)
+			dic := labelsTestDic(false, dbClientMock)
+			// the system events are published before returning, so the mock calls are not appended while asserting
+			syncSystemEventPublish(dic)
+
+			names, err := BulkAddLabelToProfiles(testCase.selector, testCase.label, testCase.dryRun, context.Background(), dic)
+			if testCase.errorKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.errorKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedNames, names)
+			if testCase.dryRun {
+				dbClientMock.AssertNotCalled(t, "UpdateDeviceProfile", mock.Anything)
+				return
+			}
+			dbClientMock.AssertNumberOfCalls(t, "UpdateDeviceProfile", len(testCase.expectedNames))
+			for _, call := range dbClientMock.Calls {
+				if call.Method == "UpdateDeviceProfile" {
+					updated := call.Arguments.Get(0).(models.DeviceProfile)
+					assert.Equal(t, 1, countLabel(updated.Labels, testCase.label), "the label should be added once")
+				}
+			}
+		})
+	}
+	assert.Equal(t, []string{"hvac"}, profiles[0].Labels, "the queried profiles should not be modified")
+}
+
+func TestBulkAddLabelToProfiles_NormalizeLabels(t *testing.T) {
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AllDeviceProfiles", 0, -1, []string(nil)).Return([]models.DeviceProfile{{Name: "thermostat", Labels: []string{"hvac"}}}, nil)
+
+	names, err := BulkAddLabelToProfiles(ProfileSelector{Labels: []string{" HVAC"}}, "HVAC ", true, context.Background(), labelsTestDic(true, dbClientMock))
+	require.NoError(t, err)
+	assert.Empty(t, names, "the normalized label should be recognized as already added")
+}
+
+func countLabel(labels []string, label string) int {
+	count := 0
+	for _, l := range labels {
+		if l == label {
+			count++
+		}
+	}
+	return count
+}
@@ -7,7 +7,11 @@ package application
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"slices"
 	"time"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
@@ -16,9 +20,11 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 )
 
 // validateDeviceCallback invoke device service's validation function for validating new or updated device
@@ -58,16 +64,60 @@ func validateDeviceCallback(device dtos.Device, dic *di.Container) errors.EdgeX
 	return nil
 }
 
-func publishUpdateDeviceProfileSystemEvent(profileDTO dtos.DeviceProfile, ctx context.Context, dic *di.Container) {
+// notifySystemEvent publishes the system event asynchronously in best-effort by default. If
+// Writable.SystemEvent.FailOperationOnPublishError is enabled, the system event is published synchronously and the
+// publish error is returned, so the caller can fail the operation.
+func notifySystemEvent(eventType, action, owner string, dto any, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if !container.ConfigurationFrom(dic.Get).Writable.SystemEvent.FailOperationOnPublishError {
+		go publishSystemEvent(eventType, action, owner, dto, ctx, dic)
+		return nil
+	}
+	return publishSystemEvent(eventType, action, owner, dto, ctx, dic)
+}
+
+// notifyUpdateDeviceProfileSystemEvent publishes the device profile update system events in the same manner as notifySystemEvent.
+// If Writable.SystemEvent.IncludeProfileChanges is enabled, the event details include the summary of the changes from
+// the original device profile. The changes of the modified device resources are also recorded in the resource history.
+// If Writable.ProfileChangeNotifications is enabled, the notifications of the matching changes are sent asynchronously.
+// The updated device profile is evicted from the profile cache before the system events are published.
+func notifyUpdateDeviceProfileSystemEvent(original models.DeviceProfile, profileDTO dtos.DeviceProfile, ctx context.Context, dic *di.Container) errors.EdgeX {
+	container.DeviceProfileCacheFrom(dic.Get).Invalidate(profileDTO.Name)
+	originalDTO := dtos.FromDeviceProfileModelToDTO(original)
+	recordDeviceResourceHistory(originalDTO, profileDTO, ctx, dic)
+
+	writable := container.ConfigurationFrom(dic.Get).Writable
+	systemEventConfig := writable.SystemEvent
+	var details any = profileDTO
+	if systemEventConfig.IncludeProfileChanges || writable.ProfileChangeNotifications.Enabled {
+		changes := diffDeviceProfiles(originalDTO, profileDTO)
+		if systemEventConfig.IncludeProfileChanges {
+			details = DeviceProfileUpdateDetails{DeviceProfile: profileDTO, Changes: changes}
+		}
+		if writable.ProfileChangeNotifications.Enabled {
+			go sendProfileChangeNotifications(profileDTO.Name, changes, context.WithoutCancel(ctx), dic)
+		}
+	}
+
+	if !systemEventConfig.FailOperationOnPublishError {
+		go publishUpdateDeviceProfileSystemEvent(profileDTO, details, ctx, dic)
+		return nil
+	}
+	return publishUpdateDeviceProfileSystemEvent(profileDTO, details, ctx, dic)
+}
+
+func publishUpdateDeviceProfileSystemEvent(profileDTO dtos.DeviceProfile, details any, ctx context.Context, dic *di.Container) errors.EdgeX {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	devices, _, err := DevicesByProfileName(0, -1, profileDTO.Name, dic)
 	if err != nil {
 		lc.Errorf("fail to query associated devices by deviceProfile name %s, err: %v", profileDTO.Name, err)
-		return
+		return errors.NewCommonEdgeXWrapper(err)
 	}
 
 	//Publish general system event regardless of associated devices
-	publishSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, common.CoreMetaDataServiceKey, profileDTO, ctx, dic)
+	err = publishSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, common.CoreMetaDataServiceKey, details, ctx, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 	// Publish system event for each device service
 	dsMap := make(map[string]bool)
 	for _, d := range devices {
@@ -77,55 +127,80 @@ func publishUpdateDeviceProfileSystemEvent(profileDTO dtos.DeviceProfile, ctx co
 		}
 		dsMap[d.ServiceName] = true
 
-		publishSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, d.ServiceName, profileDTO, ctx, dic)
+		err = publishSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, d.ServiceName, details, ctx, dic)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
 	}
+	return nil
 }
 
-func publishSystemEvent(eventType, action, owner string, dto any, ctx context.Context, dic *di.Container) {
+func publishSystemEvent(eventType, action, owner string, dto any, ctx context.Context, dic *di.Container) errors.EdgeX {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	config := container.ConfigurationFrom(dic.Get)
 	systemEvent := dtos.NewSystemEvent(eventType, action, common.CoreMetaDataServiceKey, owner, nil, dto)
 	messagingClient := bootstrapContainer.MessagingClientFrom(dic.Get)
 	if messagingClient == nil {
 		lc.Errorf("unable to publish '%s' System Event: %v", eventType, noMessagingClientError)
-		return
+		return errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("unable to publish '%s' System Event", eventType), noMessagingClientError)
 	}
 
-	var profileName, detailName string
+	// entity is the DTO of the changed entity, which excludes the additional details like the device profile changes
+	var entity any = dto
+	var profileName, detailName, detailId string
 	switch eventType {
 	case common.DeviceSystemEventType:
 		if device, ok := dto.(dtos.Device); ok {
 			profileName = device.ProfileName
 			detailName = device.Name
+			detailId = device.Id
 		} else {
 			lc.Errorf("can not convert to device DTO")
-			return
+			return errors.NewCommonEdgeX(errors.KindServerError, "can not convert to device DTO", nil)
 		}
 	case common.DeviceProfileSystemEventType:
-		if profile, ok := dto.(dtos.DeviceProfile); ok {
+		switch profile := dto.(type) {
+		case dtos.DeviceProfile:
 			profileName = profile.Name
 			detailName = profile.Name
-		} else {
+			detailId = profile.Id
+		case DeviceProfileUpdateDetails:
+			profileName = profile.Name
+			detailName = profile.Name
+			detailId = profile.Id
+			entity = profile.DeviceProfile
+		default:
 			lc.Errorf("can not convert to device profile DTO")
-			return
+			return errors.NewCommonEdgeX(errors.KindServerError, "can not convert to device profile DTO", nil)
 		}
 	case common.ProvisionWatcherSystemEventType:
 		if pw, ok := dto.(dtos.ProvisionWatcher); ok {
 			profileName = pw.DiscoveredDevice.ProfileName
 			detailName = pw.Name
+			detailId = pw.Id
 		} else {
 			lc.Errorf("can not convert to provision watcher DTO")
 		}
 	case common.DeviceServiceSystemEventType:
 		if service, ok := dto.(dtos.DeviceService); ok {
 			detailName = service.Name
+			detailId = service.Id
 		} else {
 			lc.Errorf("can not convert to device service DTO")
-			return
+			return errors.NewCommonEdgeX(errors.KindServerError, "can not convert to device service DTO", nil)
 		}
 	default:
 		lc.Errorf("unrecognized system event details")
-		return
+		return errors.NewCommonEdgeX(errors.KindServerError, "unrecognized system event details", nil)
+	}
+
+	if slices.Contains(config.Writable.SystemEvent.MinimizedPayloadEventTypes, eventType) {
+		details, err := minimizeSystemEventDetails(detailName, detailId, action, entity, ctx)
+		if err != nil {
+			lc.Errorf("unable to minimize the '%s' System Event details for %s '%s': %v", action, eventType, detailName, err)
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		systemEvent.Details = details
 	}
 
 	topicPathBuilder := common.NewPathBuilder().EnableNameFieldEscape(config.Service.EnableNameFieldEscape)
@@ -142,8 +217,37 @@ func publishSystemEvent(eventType, action, owner string, dto any, ctx context.Co
 
 	if err := messagingClient.Publish(envelope, publishTopic); err != nil {
 		lc.Errorf("unable to publish '%s' System Event for %s '%s' to topic '%s': %v", action, eventType, detailName, publishTopic, err)
-		return
+		return errors.NewCommonEdgeX(errors.KindServiceUnavailable,
+			fmt.Sprintf("unable to publish '%s' System Event for %s '%s' to topic '%s'", action, eventType, detailName, publishTopic), err)
 	}
 
 	lc.Debugf("Published the '%s' System Event for %s '%s' to topic '%s'", action, eventType, detailName, publishTopic)
+	return nil
+}
+
+// MinimizedSystemEventDetails is the details of the system events of the MinimizedPayloadEventTypes, which identify
+// the changed entity instead of carrying the full DTO, the consumers fetch the entity by the name if needed
+type MinimizedSystemEventDetails struct {
+	Name          string `json:"name"`
+	Id            string `json:"id"`
+	Action        string `json:"action"`
+	CorrelationId string `json:"correlationId"`
+	// ContentHash is the hex encoded SHA-256 hash of the JSON encoded entity DTO, so the consumers can tell whether
+	// their copy of the entity is stale without fetching it
+	ContentHash string `json:"contentHash"`
+}
+
+func minimizeSystemEventDetails(name, id, action string, entity any, ctx context.Context) (MinimizedSystemEventDetails, errors.EdgeX) {
+	content, err := json.Marshal(entity)
+	if err != nil {
+		return MinimizedSystemEventDetails{}, errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the system event details", err)
+	}
+	hash := sha256.Sum256(content)
+	return MinimizedSystemEventDetails{
+		Name:          name,
+		Id:            id,
+		Action:        action,
+		CorrelationId: correlation.FromContext(ctx),
+		ContentHash:   hex.EncodeToString(hash[:]),
+	}, nil
 }
@@ -8,6 +8,9 @@ package application
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	goErrors "errors"
 	"fmt"
 	"testing"
@@ -15,6 +18,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v4/config"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
 	mocks2 "github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger/mocks"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
@@ -30,6 +34,19 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 )
 
+// syncSystemEventPublish makes the operations publish their system events synchronously to a mock messaging client, so
+// no publish goroutine is left querying the DB mock after the test returns
+func syncSystemEventPublish(dic *di.Container) {
+	container.ConfigurationFrom(dic.Get).Writable.SystemEvent.FailOperationOnPublishError = true
+	messagingClient := &mocks.MessageClient{}
+	messagingClient.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return messagingClient
+		},
+	})
+}
+
 func TestPublishSystemEvent(t *testing.T) {
 	TestDeviceProfileName := "onvif-camera"
 	TestDeviceServiceName := "Device-onvif-camera"
@@ -158,9 +175,10 @@ func TestPublishSystemEvent(t *testing.T) {
 				expectedDetails = expectedDeviceProfile
 			}
 
-			publishSystemEvent(test.Type, test.Action, test.Owner, expectedDetails, ctx, dic)
+			err := publishSystemEvent(test.Type, test.Action, test.Owner, expectedDetails, ctx, dic)
 
 			if test.ClientMissing {
+				require.Error(t, err)
 				mockLogger.AssertCalled(t, "Errorf", mock.Anything, mock.Anything, noMessagingClientError)
 				return
 			}
@@ -175,8 +193,113 @@ func TestPublishSystemEvent(t *testing.T) {
 			mockClient.AssertCalled(t, "Publish", mock.Anything, expectedTopic)
 
 			if test.PubError {
+				require.Error(t, err)
 				mockLogger.AssertCalled(t, "Errorf", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, pubErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNotifySystemEvent_FailOperationOnPublishError(t *testing.T) {
+	device := dtos.Device{Name: "Camera-Device", ServiceName: "Device-onvif-camera", ProfileName: "onvif-camera"}
+	pubErr := goErrors.New("publish failed")
+
+	tests := []struct {
+		name                        string
+		failOperationOnPublishError bool
+		errorExpected               bool
+	}{
+		{"async publish ignores the publish error", false, false},
+		{"sync publish returns the publish error", true, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			published := make(chan struct{}, 1)
+			mockClient := &mocks.MessageClient{}
+			mockClient.On("Publish", mock.Anything, mock.Anything).Return(pubErr).Run(func(args mock.Arguments) {
+				published <- struct{}{}
+			})
+			configuration := &config.ConfigurationStruct{}
+			configuration.Writable.SystemEvent.FailOperationOnPublishError = testCase.failOperationOnPublishError
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.ConfigurationName: func(get di.Get) interface{} {
+					return configuration
+				},
+				bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+					return logger.NewMockClient()
+				},
+				bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+					return mockClient
+				},
+			})
+
+			err := notifySystemEvent(common.DeviceSystemEventType, common.SystemEventActionAdd, device.ServiceName, device, context.Background(), dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+			} else {
+				require.NoError(t, err)
 			}
+			<-published
 		})
 	}
 }
+
+func TestPublishSystemEvent_MinimizedPayload(t *testing.T) {
+	device := dtos.Device{Id: uuid.NewString(), Name: "Camera-Device", ServiceName: "Device-onvif-camera", ProfileName: "onvif-camera"}
+	profile := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Id: uuid.NewString(), Name: "onvif-camera"},
+		DeviceResources:        []dtos.DeviceResource{{Name: "temperature"}},
+	}
+	details := DeviceProfileUpdateDetails{DeviceProfile: profile, Changes: DeviceProfileChanges{}}
+	content, err := json.Marshal(profile)
+	require.NoError(t, err)
+	hash := sha256.Sum256(content)
+	correlationId := uuid.NewString()
+
+	var published []dtos.SystemEvent
+	mockClient := &mocks.MessageClient{}
+	mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		systemEvent, err := types.GetMsgPayload[dtos.SystemEvent](args.Get(0).(types.MessageEnvelope))
+		require.NoError(t, err)
+		published = append(published, systemEvent)
+	})
+	configuration := &config.ConfigurationStruct{}
+	configuration.Writable.SystemEvent.MinimizedPayloadEventTypes = []string{common.DeviceProfileSystemEventType}
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return configuration
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return mockClient
+		},
+	})
+	// lint:ignore SA1029 legacy
+	// nolint:staticcheck // See golangci-lint #741
+	ctx := context.WithValue(context.Background(), common.CorrelationHeader, correlationId)
+
+	err = publishSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, common.CoreMetaDataServiceKey, details, ctx, dic)
+	require.NoError(t, err)
+	err = publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionAdd, device.ServiceName, device, ctx, dic)
+	require.NoError(t, err)
+	require.Len(t, published, 2)
+
+	minimized := MinimizedSystemEventDetails{}
+	require.NoError(t, published[0].DecodeDetails(&minimized))
+	assert.Equal(t, MinimizedSystemEventDetails{
+		Name:          profile.Name,
+		Id:            profile.Id,
+		Action:        common.SystemEventActionUpdate,
+		CorrelationId: correlationId,
+		ContentHash:   hex.EncodeToString(hash[:]),
+	}, minimized)
+
+	actualDevice := dtos.Device{}
+	require.NoError(t, published[1].DecodeDetails(&actualDevice))
+	assert.Equal(t, device, actualDevice, "the event types not configured keep the full payload")
+}
@@ -0,0 +1,78 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	gometrics "github.com/rcrowley/go-metrics"
+)
+
+const (
+	deviceProfileCacheHitsMetricName   = "DeviceProfileCacheHits"
+	deviceProfileCacheMissesMetricName = "DeviceProfileCacheMisses"
+)
+
+// cachedDeviceProfileByName returns the device profile from the cache, or reads it from the database and caches it if
+// Writable.ProfileCache is enabled
+func cachedDeviceProfileByName(name string, dic *di.Container) (dtos.DeviceProfile, errors.EdgeX) {
+	settings := container.ConfigurationFrom(dic.Get).Writable.ProfileCache
+	if settings.MaxSize == 0 {
+		// the invalidations still apply while the cache is disabled, so the cached device profiles are not stale when
+		// the cache is enabled again
+		dp, err := container.DBClientFrom(dic.Get).DeviceProfileByName(name)
+		if err != nil {
+			return dtos.DeviceProfile{}, errors.NewCommonEdgeXWrapper(err)
+		}
+		return dtos.FromDeviceProfileModelToDTO(dp), nil
+	}
+
+	profileCache := container.DeviceProfileCacheFrom(dic.Get)
+	profile, ok, generation := profileCache.Get(name)
+	if ok {
+		return profile, nil
+	}
+	dp, err := container.DBClientFrom(dic.Get).DeviceProfileByName(name)
+	if err != nil {
+		return dtos.DeviceProfile{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	profile = dtos.FromDeviceProfileModelToDTO(dp)
+	profileCache.Add(profile, generation, settings)
+	return profile, nil
+}
+
+// InvalidateCachedDeviceProfile evicts the device profile from the profile cache, which is invoked on the device
+// profile update and delete system events of the other instances
+func InvalidateCachedDeviceProfile(name string, dic *di.Container) {
+	container.DeviceProfileCacheFrom(dic.Get).Invalidate(name)
+}
+
+// RegisterMetrics registers the metadata metrics with the metrics manager
+func RegisterMetrics(dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
+	if metricsManager == nil {
+		lc.Error("Metric Manager not available. Metadata metrics will not be collected.")
+		return
+	}
+
+	profileCache := container.DeviceProfileCacheFrom(dic.Get)
+	for name, counter := range map[string]gometrics.Counter{
+		deviceProfileCacheHitsMetricName:   profileCache.Hits(),
+		deviceProfileCacheMissesMetricName: profileCache.Misses(),
+	} {
+		if err := metricsManager.Register(name, counter, nil); err != nil {
+			lc.Errorf("%s metrics will not be collected: %s", name, err.Error())
+			continue
+		}
+		lc.Infof("Registered metrics counter %s", name)
+	}
+}
@@ -0,0 +1,83 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+)
+
+func TestCachedDeviceProfileByName(t *testing.T) {
+	profile := models.DeviceProfile{Name: "cachedProfile", Labels: []string{"hvac"}, DeviceResources: []models.DeviceResource{{Name: "temperature"}}}
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", profile.Name).Return(profile, nil)
+	dic := labelsTestDic(false, dbClientMock)
+
+	for range 2 {
+		_, err := cachedDeviceProfileByName(profile.Name, dic)
+		require.NoError(t, err)
+	}
+	dbClientMock.AssertNumberOfCalls(t, "DeviceProfileByName", 2)
+
+	container.ConfigurationFrom(dic.Get).Writable.ProfileCache = config.ProfileCache{MaxSize: 10, TTL: "1m"}
+	for range 2 {
+		result, err := cachedDeviceProfileByName(profile.Name, dic)
+		require.NoError(t, err)
+		assert.Equal(t, profile.Name, result.Name)
+	}
+	dbClientMock.AssertNumberOfCalls(t, "DeviceProfileByName", 3)
+
+	// the served profile is a copy, so modifying it does not modify the cached profile
+	served, err := cachedDeviceProfileByName(profile.Name, dic)
+	require.NoError(t, err)
+	served.Labels[0] = "modified"
+	served.DeviceResources[0].Name = "modified"
+	result, err := cachedDeviceProfileByName(profile.Name, dic)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hvac"}, result.Labels)
+	assert.Equal(t, "temperature", result.DeviceResources[0].Name)
+	dbClientMock.AssertNumberOfCalls(t, "DeviceProfileByName", 3)
+
+	InvalidateCachedDeviceProfile(profile.Name, dic)
+	_, err = cachedDeviceProfileByName(profile.Name, dic)
+	require.NoError(t, err)
+	dbClientMock.AssertNumberOfCalls(t, "DeviceProfileByName", 4)
+}
+
+func TestCachedDeviceProfileByName_MissResultIsCopied(t *testing.T) {
+	scale := 0.1
+	profile := models.DeviceProfile{Name: "missCopiedProfile", DeviceResources: []models.DeviceResource{{
+		Name:       "temperature",
+		Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, Units: "degC", Scale: &scale, Optional: map[string]any{"precision": 2}},
+		Attributes: map[string]any{"register": map[string]any{"address": 1}},
+	}}}
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", profile.Name).Return(profile, nil)
+	dic := labelsTestDic(false, dbClientMock)
+	container.ConfigurationFrom(dic.Get).Writable.ProfileCache = config.ProfileCache{MaxSize: 10, TTL: "1m"}
+
+	// the profile served on the miss is modified like the unit meta tagging does
+	served, err := cachedDeviceProfileByName(profile.Name, dic)
+	require.NoError(t, err)
+	served.DeviceResources[0].Properties.Optional[UnitMetaKey] = nil
+	*served.DeviceResources[0].Properties.Scale = 10
+	served.DeviceResources[0].Attributes["register"].(map[string]any)["address"] = 2
+
+	result, err := cachedDeviceProfileByName(profile.Name, dic)
+	require.NoError(t, err)
+	dbClientMock.AssertNumberOfCalls(t, "DeviceProfileByName", 1)
+	assert.Equal(t, map[string]any{"precision": 2}, result.DeviceResources[0].Properties.Optional)
+	assert.Equal(t, 0.1, *result.DeviceResources[0].Properties.Scale)
+	assert.Equal(t, map[string]any{"register": map[string]any{"address": 1}}, result.DeviceResources[0].Attributes)
+}
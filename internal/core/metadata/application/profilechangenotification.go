@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+)
+
+// profileChangeNotificationRequests returns the notification requests of the rules matching the device profile and
+// the changed device resources, one request for each matching rule
+func profileChangeNotificationRequests(profileName string, changes DeviceProfileChanges, notifications config.ProfileChangeNotifications) []requests.AddNotificationRequest {
+	severity := notifications.Severity
+	if severity == "" {
+		severity = models.Normal
+	}
+	var reqs []requests.AddNotificationRequest
+	for _, rule := range notifications.Rules {
+		if !patternMatch(rule.ProfilePattern, profileName) {
+			continue
+		}
+		added := matchingNames(rule.ResourcePattern, changes.AddedResources)
+		removed := matchingNames(rule.ResourcePattern, changes.RemovedResources)
+		modified := matchingNames(rule.ResourcePattern, changes.ModifiedResources)
+		if len(added) == 0 && len(removed) == 0 && len(modified) == 0 {
+			continue
+		}
+		content := profileChangeNotificationContent(profileName, added, removed, modified)
+		notification := dtos.NewNotification(rule.Labels, rule.Category, content, common.CoreMetaDataServiceKey, severity)
+		reqs = append(reqs, requests.NewAddNotificationRequest(notification))
+	}
+	return reqs
+}
+
+// patternMatch returns whether the name matches the path.Match pattern, the empty pattern matches all the names
+func patternMatch(pattern, name string) bool {
+	if pattern == "" {
+		return true
+	}
+	matched, err := path.Match(pattern, name)
+	return err == nil && matched
+}
+
+func matchingNames(pattern string, names []string) []string {
+	var matched []string
+	for _, name := range names {
+		if patternMatch(pattern, name) {
+			matched = append(matched, name)
+		}
+	}
+	return matched
+}
+
+func profileChangeNotificationContent(profileName string, added, removed, modified []string) string {
+	var changes []string
+	for _, c := range []struct {
+		action string
+		names  []string
+	}{{"added", added}, {"removed", removed}, {"modified", modified}} {
+		if len(c.names) > 0 {
+			changes = append(changes, fmt.Sprintf("%s device resources: %s", c.action, strings.Join(c.names, ", ")))
+		}
+	}
+	return fmt.Sprintf("Device profile %s is updated, %s", profileName, strings.Join(changes, "; "))
+}
+
+// sendProfileChangeNotifications sends the notifications of the device resource changes matching the
+// Writable.ProfileChangeNotifications rules through the notification client in best-effort
+func sendProfileChangeNotifications(profileName string, changes DeviceProfileChanges, ctx context.Context, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	reqs := profileChangeNotificationRequests(profileName, changes, container.ConfigurationFrom(dic.Get).Writable.ProfileChangeNotifications)
+	if len(reqs) == 0 {
+		return
+	}
+	client := bootstrapContainer.NotificationClientFrom(dic.Get)
+	if client == nil {
+		lc.Warnf("unable to send the change notifications of device profile %s: the support-notifications client is not configured", profileName)
+		return
+	}
+	responses, err := client.SendNotification(ctx, reqs)
+	if err != nil {
+		lc.Errorf("fail to send the change notifications of device profile %s, err: %v", profileName, err)
+		return
+	}
+	for _, res := range responses {
+		if res.StatusCode >= 300 {
+			lc.Errorf("fail to send the change notification of device profile %s, err: %s", profileName, res.Message)
+		}
+	}
+}
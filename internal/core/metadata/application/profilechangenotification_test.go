@@ -0,0 +1,84 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	clientMocks "github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+)
+
+func TestProfileChangeNotificationRequests(t *testing.T) {
+	changes := DeviceProfileChanges{
+		AddedResources:    []string{"TemperatureSetpoint"},
+		RemovedResources:  []string{"Humidity"},
+		ModifiedResources: []string{"Temperature"},
+	}
+	notifications := config.ProfileChangeNotifications{
+		Enabled: true,
+		Rules: []config.ProfileChangeNotificationRule{
+			{ProfilePattern: "hvac-*", ResourcePattern: "Temperature*", Category: "hvac", Labels: []string{"temperature"}},
+			{ProfilePattern: "hvac-*", ResourcePattern: "Pressure", Category: "pressure"},
+			{ProfilePattern: "camera-*", Category: "camera"},
+			{Category: "all"},
+		},
+	}
+
+	reqs := profileChangeNotificationRequests("hvac-01", changes, notifications)
+	require.Len(t, reqs, 2)
+	assert.Equal(t, "hvac", reqs[0].Notification.Category)
+	assert.Equal(t, []string{"temperature"}, reqs[0].Notification.Labels)
+	assert.Equal(t, models.Normal, reqs[0].Notification.Severity)
+	assert.Equal(t, common.CoreMetaDataServiceKey, reqs[0].Notification.Sender)
+	assert.Equal(t, "Device profile hvac-01 is updated, added device resources: TemperatureSetpoint; modified device resources: Temperature", reqs[0].Notification.Content)
+	assert.Equal(t, "all", reqs[1].Notification.Category)
+	assert.Contains(t, reqs[1].Notification.Content, "removed device resources: Humidity")
+
+	assert.Empty(t, profileChangeNotificationRequests("hvac-01", DeviceProfileChanges{AddedCommands: []string{"Temperature"}}, notifications), "the device command changes should not be notified")
+
+	notifications.Severity = models.Critical
+	reqs = profileChangeNotificationRequests("camera-01", changes, notifications)
+	require.Len(t, reqs, 2)
+	assert.Equal(t, "camera", reqs[0].Notification.Category)
+	assert.Equal(t, models.Critical, reqs[0].Notification.Severity)
+}
+
+func TestSendProfileChangeNotifications(t *testing.T) {
+	changes := DeviceProfileChanges{ModifiedResources: []string{"Temperature"}}
+	dic := labelsTestDic(false, nil)
+	container.ConfigurationFrom(dic.Get).Writable.ProfileChangeNotifications = config.ProfileChangeNotifications{
+		Enabled: true,
+		Rules:   []config.ProfileChangeNotificationRule{{ResourcePattern: "Temperature", Category: "hvac"}},
+	}
+
+	// the notifications are skipped without the notification client
+	sendProfileChangeNotifications("hvac-01", changes, context.Background(), dic)
+
+	client := &clientMocks.NotificationClient{}
+	client.On("SendNotification", mock.Anything, mock.MatchedBy(func(reqs []requests.AddNotificationRequest) bool {
+		return len(reqs) == 1 && reqs[0].Notification.Category == "hvac"
+	})).Return(nil, nil)
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.NotificationClientName: func(get di.Get) interface{} {
+			return client
+		},
+	})
+	sendProfileChangeNotifications("hvac-01", changes, context.Background(), dic)
+	sendProfileChangeNotifications("hvac-01", DeviceProfileChanges{ModifiedResources: []string{"Humidity"}}, context.Background(), dic)
+	client.AssertNumberOfCalls(t, "SendNotification", 1)
+}
@@ -0,0 +1,76 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// DeviceProfileChanges summarizes the device resources and device commands added, removed and modified by a device
+// profile update
+type DeviceProfileChanges struct {
+	AddedResources    []string `json:"addedResources,omitempty"`
+	RemovedResources  []string `json:"removedResources,omitempty"`
+	ModifiedResources []string `json:"modifiedResources,omitempty"`
+	AddedCommands     []string `json:"addedCommands,omitempty"`
+	RemovedCommands   []string `json:"removedCommands,omitempty"`
+	ModifiedCommands  []string `json:"modifiedCommands,omitempty"`
+}
+
+// DeviceProfileUpdateDetails is the details of the device profile update system event when
+// Writable.SystemEvent.IncludeProfileChanges is enabled. The device profile fields are inlined, so the details can
+// still be decoded as a device profile DTO by the consumers unaware of the changes.
+type DeviceProfileUpdateDetails struct {
+	dtos.DeviceProfile
+	Changes DeviceProfileChanges `json:"changes"`
+}
+
+// diffDeviceProfiles compares the device resources and device commands of the device profile before and after the update
+func diffDeviceProfiles(original, updated dtos.DeviceProfile) DeviceProfileChanges {
+	var changes DeviceProfileChanges
+	changes.AddedResources, changes.RemovedResources, changes.ModifiedResources = diffByName(
+		original.DeviceResources, updated.DeviceResources, func(r dtos.DeviceResource) string { return r.Name })
+	changes.AddedCommands, changes.RemovedCommands, changes.ModifiedCommands = diffByName(
+		original.DeviceCommands, updated.DeviceCommands, func(c dtos.DeviceCommand) string { return c.Name })
+	return changes
+}
+
+func diffByName[T any](original, updated []T, name func(T) string) (added, removed, modified []string) {
+	originalByName := make(map[string]T, len(original))
+	for _, o := range original {
+		originalByName[name(o)] = o
+	}
+	updatedNames := make(map[string]bool, len(updated))
+	for _, u := range updated {
+		n := name(u)
+		updatedNames[n] = true
+		o, ok := originalByName[n]
+		switch {
+		case !ok:
+			added = append(added, n)
+		case !reflect.DeepEqual(o, u):
+			modified = append(modified, n)
+		}
+	}
+	for _, o := range original {
+		if n := name(o); !updatedNames[n] {
+			removed = append(removed, n)
+		}
+	}
+	return added, removed, modified
+}
+
+// cloneDeviceProfile copies the device resources and device commands of the device profile, so the original profile
+// is kept for diffing when the resources or commands are modified in place
+func cloneDeviceProfile(p models.DeviceProfile) models.DeviceProfile {
+	p.DeviceResources = slices.Clone(p.DeviceResources)
+	p.DeviceCommands = slices.Clone(p.DeviceCommands)
+	return p
+}
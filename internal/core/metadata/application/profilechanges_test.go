@@ -0,0 +1,134 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	dbMocks "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/utils"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/edgexfoundry/go-mod-messaging/v4/messaging/mocks"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func profileChangesTestProfile() models.DeviceProfile {
+	properties := models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW}
+	return models.DeviceProfile{
+		Name: "thermostat",
+		DeviceResources: []models.DeviceResource{
+			{Name: "temperature", Properties: properties},
+			{Name: "humidity", Properties: properties},
+			{Name: "setPoint", Properties: properties},
+		},
+		DeviceCommands: []models.DeviceCommand{
+			{Name: "climate", ReadWrite: common.ReadWrite_R, ResourceOperations: []models.ResourceOperation{{DeviceResource: "temperature"}, {DeviceResource: "humidity"}}},
+		},
+	}
+}
+
+func TestDiffDeviceProfiles(t *testing.T) {
+	original := profileChangesTestProfile()
+
+	updated := cloneDeviceProfile(original)
+	updated.Description = "basic info is not summarized"
+	updated.DeviceResources[0].Properties.Units = "C"
+	updated.DeviceResources = append(updated.DeviceResources[:1], updated.DeviceResources[2:]...)
+	updated.DeviceResources = append(updated.DeviceResources, models.DeviceResource{Name: "pressure", Properties: original.DeviceResources[0].Properties})
+	updated.DeviceCommands = append(updated.DeviceCommands, models.DeviceCommand{Name: "setPointCommand", ReadWrite: common.ReadWrite_W})
+
+	changes := diffDeviceProfiles(dtos.FromDeviceProfileModelToDTO(original), dtos.FromDeviceProfileModelToDTO(updated))
+	assert.Equal(t, []string{"pressure"}, changes.AddedResources)
+	assert.Equal(t, []string{"humidity"}, changes.RemovedResources)
+	assert.Equal(t, []string{"temperature"}, changes.ModifiedResources)
+	assert.Equal(t, []string{"setPointCommand"}, changes.AddedCommands)
+	assert.Empty(t, changes.RemovedCommands)
+	assert.Empty(t, changes.ModifiedCommands)
+	assert.Len(t, original.DeviceResources, 3, "the original profile should not be modified")
+	assert.Empty(t, original.DeviceResources[0].Properties.Units, "the original profile should not be modified")
+
+	unchanged := diffDeviceProfiles(dtos.FromDeviceProfileModelToDTO(original), dtos.FromDeviceProfileModelToDTO(original))
+	assert.Equal(t, DeviceProfileChanges{}, unchanged)
+}
+
+func TestNotifyUpdateDeviceProfileSystemEvent_IncludeProfileChanges(t *testing.T) {
+	original := profileChangesTestProfile()
+	updated := cloneDeviceProfile(original)
+	updated.DeviceCommands[0].ReadWrite = common.ReadWrite_RW
+
+	tests := []struct {
+		name                  string
+		includeProfileChanges bool
+	}{
+		{"changes included", true},
+		{"changes not included", false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var systemEvent dtos.SystemEvent
+			mockClient := &mocks.MessageClient{}
+			mockClient.On("Publish", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				var err error
+				systemEvent, err = types.GetMsgPayload[dtos.SystemEvent](args.Get(0).(types.MessageEnvelope))
+				require.NoError(t, err)
+			})
+			dbClientMock := &dbMocks.DBClient{}
+			dbClientMock.On("DeviceCountByProfileName", original.Name).Return(uint32(0), nil)
+			configuration := &config.ConfigurationStruct{}
+			configuration.Writable.SystemEvent.FailOperationOnPublishError = true
+			configuration.Writable.SystemEvent.IncludeProfileChanges = testCase.includeProfileChanges
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.DeviceProfileCacheName: func(get di.Get) interface{} {
+					return utils.NewDeviceProfileCache()
+				},
+				container.DeviceResourceHistoryName: func(get di.Get) interface{} {
+					return utils.NewDeviceResourceHistory()
+				},
+				container.ConfigurationName: func(get di.Get) interface{} {
+					return configuration
+				},
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+				bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+					return logger.NewMockClient()
+				},
+				bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+					return mockClient
+				},
+			})
+
+			err := notifyUpdateDeviceProfileSystemEvent(original, dtos.FromDeviceProfileModelToDTO(updated), context.Background(), dic)
+			require.NoError(t, err)
+
+			var profile dtos.DeviceProfile
+			require.NoError(t, systemEvent.DecodeDetails(&profile))
+			assert.Equal(t, updated.Name, profile.Name)
+			assert.Len(t, profile.DeviceResources, len(updated.DeviceResources))
+
+			var details DeviceProfileUpdateDetails
+			require.NoError(t, systemEvent.DecodeDetails(&details))
+			if testCase.includeProfileChanges {
+				assert.Equal(t, DeviceProfileChanges{ModifiedCommands: []string{"climate"}}, details.Changes)
+			} else {
+				assert.Equal(t, DeviceProfileChanges{}, details.Changes)
+			}
+		})
+	}
+}
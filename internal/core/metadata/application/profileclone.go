@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// CloneDeviceProfile adds a copy of the source device profile under the target name, e.g. to derive a near-identical
+// profile which is then changed by the device resource and device command APIs. The units of the copy are validated
+// against the current units of measure, and the device profile add system event is published for the copy.
+func CloneDeviceProfile(sourceName string, targetName string, ctx context.Context, dic *di.Container) (id string, err errors.EdgeX) {
+	if sourceName == "" {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "source profile name is empty", nil)
+	}
+	if targetName == "" {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "target profile name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	exists, err := dbClient.DeviceProfileNameExists(targetName)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	if exists {
+		return "", errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device profile name %s already exists", targetName), nil)
+	}
+	source, err := dbClient.DeviceProfileByName(sourceName)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	clone, err := deepCopyDeviceProfile(source)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	clone.Id = ""
+	clone.Name = targetName
+	clone.DBTimestamp = models.DBTimestamp{}
+	if err = deviceProfileUoMValidation(clone, dic); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	addedDeviceProfile, err := dbClient.AddDeviceProfile(clone)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Debugf("DeviceProfile %s cloned to %s on DB successfully. DeviceProfile-id: %s, Correlation-id: %s ",
+		sourceName, targetName, addedDeviceProfile.Id, correlation.FromContext(ctx))
+
+	profileDTO := dtos.FromDeviceProfileModelToDTO(addedDeviceProfile)
+	if err := notifySystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionAdd, common.CoreMetaDataServiceKey, profileDTO, ctx, dic); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	return addedDeviceProfile.Id, nil
+}
+
+// deepCopyDeviceProfile copies the device profile through its JSON encoding, so the copy shares none of the slices, maps
+// and pointers of the nested device resources and device commands with the original
+func deepCopyDeviceProfile(p models.DeviceProfile) (models.DeviceProfile, errors.EdgeX) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the device profile to copy", err)
+	}
+	var copied models.DeviceProfile
+	if err = json.Unmarshal(data, &copied); err != nil {
+		return models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindServerError, "failed to decode the copied device profile", err)
+	}
+	return copied, nil
+}
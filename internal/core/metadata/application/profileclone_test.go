@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneDeviceProfile(t *testing.T) {
+	minimum := -40.0
+	source := models.DeviceProfile{
+		DBTimestamp:  models.DBTimestamp{Created: 1000, Modified: 2000},
+		Id:           "source-id",
+		Name:         "thermostat",
+		Manufacturer: "IOTech",
+		Labels:       []string{"hvac"},
+		DeviceResources: []models.DeviceResource{
+			{Name: "temperature", Attributes: map[string]any{"register": 40001.0},
+				Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R, Minimum: &minimum,
+					Optional: map[string]any{WarnHighKey: 80.5}}},
+		},
+		DeviceCommands: []models.DeviceCommand{
+			{Name: "climate", ReadWrite: common.ReadWrite_R, ResourceOperations: []models.ResourceOperation{{DeviceResource: "temperature"}}},
+		},
+	}
+
+	var added models.DeviceProfile
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileNameExists", "thermostat-v2").Return(false, nil)
+	dbClientMock.On("DeviceProfileNameExists", "existing").Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", "orphan").Return(false, nil)
+	dbClientMock.On("DeviceProfileByName", source.Name).Return(source, nil)
+	dbClientMock.On("DeviceProfileByName", "unknown").Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("AddDeviceProfile", mock.Anything).Return(func(p models.DeviceProfile) (models.DeviceProfile, errors.EdgeX) {
+		added = p
+		p.Id = "clone-id"
+		return p, nil
+	})
+	dic := labelsTestDic(false, dbClientMock)
+
+	id, err := CloneDeviceProfile(source.Name, "thermostat-v2", context.Background(), dic)
+	require.NoError(t, err)
+	assert.Equal(t, "clone-id", id)
+	assert.Equal(t, "thermostat-v2", added.Name)
+	assert.Empty(t, added.Id, "the id of the source should not be copied")
+	assert.Equal(t, models.DBTimestamp{}, added.DBTimestamp)
+	assert.Equal(t, source.Manufacturer, added.Manufacturer)
+	assert.Equal(t, source.DeviceResources, added.DeviceResources)
+	assert.Equal(t, source.DeviceCommands, added.DeviceCommands)
+
+	// the clone shares no slice, map or pointer with the source
+	added.Labels[0] = "modified"
+	added.DeviceResources[0].Name = "modified"
+	added.DeviceResources[0].Attributes["register"] = 0.0
+	added.DeviceResources[0].Properties.Optional[WarnHighKey] = 0.0
+	*added.DeviceResources[0].Properties.Minimum = 0
+	added.DeviceCommands[0].ResourceOperations[0].DeviceResource = "modified"
+	assert.Equal(t, "hvac", source.Labels[0])
+	assert.Equal(t, "temperature", source.DeviceResources[0].Name)
+	assert.Equal(t, 40001.0, source.DeviceResources[0].Attributes["register"])
+	assert.Equal(t, 80.5, source.DeviceResources[0].Properties.Optional[WarnHighKey])
+	assert.Equal(t, -40.0, minimum)
+	assert.Equal(t, "temperature", source.DeviceCommands[0].ResourceOperations[0].DeviceResource)
+
+	tests := []struct {
+		name         string
+		sourceName   string
+		targetName   string
+		expectedKind errors.ErrKind
+	}{
+		{"target name exists", source.Name, "existing", errors.KindDuplicateName},
+		{"source not found", "unknown", "orphan", errors.KindEntityDoesNotExist},
+		{"empty source name", "", "thermostat-v2", errors.KindContractInvalid},
+		{"empty target name", source.Name, "", errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := CloneDeviceProfile(testCase.sourceName, testCase.targetName, context.Background(), dic)
+			require.Error(t, err)
+			assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "AddDeviceProfile", 1)
+}
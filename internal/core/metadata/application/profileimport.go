@@ -0,0 +1,134 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// The strategies to resolve the conflict of an imported device profile with an existing device profile of the same name
+const (
+	// ConflictStrategyFail fails the import of the conflicting device profile, which is the default
+	ConflictStrategyFail = "fail"
+	// ConflictStrategySkip keeps the existing device profile and skips the imported one
+	ConflictStrategySkip = "skip"
+	// ConflictStrategyOverwrite updates the existing device profile with the imported one
+	ConflictStrategyOverwrite = "overwrite"
+	// ConflictStrategyRename adds the imported device profile with a unique name generated by a numeric suffix
+	ConflictStrategyRename = "rename"
+)
+
+// maxRenameAttempts is the maximum number of the suffixes tried to generate a unique device profile name
+const maxRenameAttempts = 100
+
+// DeviceProfileImportResult reports how an imported device profile is stored
+type DeviceProfileImportResult struct {
+	// Id is the id of the added device profile, which is empty if the device profile is skipped or overwritten
+	Id string `json:"id,omitempty"`
+	// Name is the name the device profile is stored with, which differs from the imported name if it is renamed
+	Name string `json:"name"`
+	// Created is whether the device profile is added
+	Created bool `json:"created"`
+	// AppliedStrategy is the conflict strategy applied to the device profile, which is empty if there is no conflict
+	AppliedStrategy string `json:"appliedStrategy,omitempty"`
+}
+
+// ValidateConflictStrategy validates the conflict strategy of the device profile import
+func ValidateConflictStrategy(onConflict string) errors.EdgeX {
+	switch onConflict {
+	case ConflictStrategyFail, ConflictStrategySkip, ConflictStrategyOverwrite, ConflictStrategyRename:
+		return nil
+	}
+	return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid onConflict strategy %s, must be one of %s, %s, %s or %s",
+		onConflict, ConflictStrategyFail, ConflictStrategySkip, ConflictStrategyOverwrite, ConflictStrategyRename), nil)
+}
+
+// ImportDeviceProfile adds the imported device profile, and resolves the conflict with the existing device profile of
+// the same name by the onConflict strategy. The device profile is validated and notified like AddDeviceProfile and
+// UpdateDeviceProfile.
+func ImportDeviceProfile(d models.DeviceProfile, onConflict string, ctx context.Context, dic *di.Container) (DeviceProfileImportResult, errors.EdgeX) {
+	if err := ValidateConflictStrategy(onConflict); err != nil {
+		return DeviceProfileImportResult{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	result := DeviceProfileImportResult{Name: d.Name}
+
+	exists, err := dbClient.DeviceProfileNameExists(d.Name)
+	if err != nil {
+		return result, errors.NewCommonEdgeXWrapper(err)
+	}
+	if !exists {
+		result.Id, err = AddDeviceProfile(d, ctx, dic)
+		if err == nil {
+			result.Created = true
+			return result, nil
+		}
+		if errors.Kind(err) != errors.KindDuplicateName {
+			return result, errors.NewCommonEdgeXWrapper(err)
+		}
+		// the profile is created concurrently after the existence check, which is a conflict as well
+	}
+
+	result.AppliedStrategy = onConflict
+	switch onConflict {
+	case ConflictStrategySkip:
+		lc.Debugf("DeviceProfile %s exists, the imported profile is skipped. Correlation-id: %s ", d.Name, correlation.FromContext(ctx))
+		return result, nil
+	case ConflictStrategyOverwrite:
+		if container.ConfigurationFrom(dic.Get).Writable.ProfileChange.StrictDeviceProfileChanges {
+			return result, errors.NewCommonEdgeX(errors.KindServiceLocked, "profile change is not allowed when StrictDeviceProfileChanges config is enabled", nil)
+		}
+		if err = UpdateDeviceProfile(d, ctx, dic); err != nil {
+			return result, errors.NewCommonEdgeXWrapper(err)
+		}
+		return result, nil
+	case ConflictStrategyRename:
+		return renameAndAddDeviceProfile(d, result, ctx, dic)
+	}
+	return result, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device profile name %s exists", d.Name), nil)
+}
+
+// renameAndAddDeviceProfile adds the device profile with the first free name of the name followed by a numeric suffix,
+// e.g. thermostat-1
+func renameAndAddDeviceProfile(d models.DeviceProfile, result DeviceProfileImportResult, ctx context.Context, dic *di.Container) (DeviceProfileImportResult, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	name := d.Name
+	// the id of the existing device profile may be imported as well, so the renamed profile gets a new id
+	d.Id = ""
+	for i := 1; i <= maxRenameAttempts; i++ {
+		d.Name = fmt.Sprintf("%s-%d", name, i)
+		exists, err := dbClient.DeviceProfileNameExists(d.Name)
+		if err != nil {
+			return result, errors.NewCommonEdgeXWrapper(err)
+		}
+		if exists {
+			continue
+		}
+		id, err := AddDeviceProfile(d, ctx, dic)
+		if errors.Kind(err) == errors.KindDuplicateName {
+			// the name is taken concurrently, try the next suffix
+			continue
+		}
+		if err != nil {
+			return result, errors.NewCommonEdgeXWrapper(err)
+		}
+		result.Id = id
+		result.Name = d.Name
+		result.Created = true
+		return result, nil
+	}
+	return result, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("fail to generate a unique name for the device profile %s after %d attempts", name, maxRenameAttempts), nil)
+}
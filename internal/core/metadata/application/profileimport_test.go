@@ -0,0 +1,68 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportDeviceProfile(t *testing.T) {
+	existing := models.DeviceProfile{Id: "existing-id", Name: "thermostat"}
+	added := models.DeviceProfile{Id: "added-id", Name: "sensor"}
+	renamed := models.DeviceProfile{Id: "renamed-id", Name: "thermostat-2"}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileNameExists", existing.Name).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", "thermostat-1").Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", renamed.Name).Return(false, nil)
+	dbClientMock.On("DeviceProfileNameExists", added.Name).Return(false, nil)
+	dbClientMock.On("AddDeviceProfile", models.DeviceProfile{Name: added.Name}).Return(added, nil)
+	dbClientMock.On("AddDeviceProfile", models.DeviceProfile{Name: renamed.Name}).Return(renamed, nil)
+	dbClientMock.On("UpdateDeviceProfile", existing).Return(nil)
+	dbClientMock.On("DeviceProfileByName", existing.Name).Return(existing, nil)
+	dbClientMock.On("DeviceCountByProfileName", existing.Name).Return(uint32(0), nil)
+	dic := labelsTestDic(false, dbClientMock)
+	syncSystemEventPublish(dic)
+
+	tests := []struct {
+		name              string
+		profile           models.DeviceProfile
+		onConflict        string
+		expectedResult    DeviceProfileImportResult
+		expectedErrorKind errors.ErrKind
+	}{
+		{"no conflict", models.DeviceProfile{Name: added.Name}, ConflictStrategySkip, DeviceProfileImportResult{Id: added.Id, Name: added.Name, Created: true}, ""},
+		{"skip", existing, ConflictStrategySkip, DeviceProfileImportResult{Name: existing.Name, AppliedStrategy: ConflictStrategySkip}, ""},
+		{"overwrite", existing, ConflictStrategyOverwrite, DeviceProfileImportResult{Name: existing.Name, AppliedStrategy: ConflictStrategyOverwrite}, ""},
+		{"rename", existing, ConflictStrategyRename, DeviceProfileImportResult{Id: renamed.Id, Name: renamed.Name, Created: true, AppliedStrategy: ConflictStrategyRename}, ""},
+		{"fail", existing, ConflictStrategyFail, DeviceProfileImportResult{}, errors.KindDuplicateName},
+		{"invalid strategy", existing, "merge", DeviceProfileImportResult{}, errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := ImportDeviceProfile(testCase.profile, testCase.onConflict, context.Background(), dic)
+			if testCase.expectedErrorKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrorKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedResult, result)
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "UpdateDeviceProfile", 1)
+	dbClientMock.AssertNotCalled(t, "AddDeviceProfile", mock.MatchedBy(func(p models.DeviceProfile) bool { return p.Name == existing.Name }))
+}
@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DeviceProfileInYAMLByName exports the device profile in the YAML accepted by the device profile upload, so the
+// profile edited through the API can be written back to the YAML source
+func DeviceProfileInYAMLByName(name string, dic *di.Container) ([]byte, errors.EdgeX) {
+	if name == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "profile name is empty", nil)
+	}
+	profile, err := container.DBClientFrom(dic.Get).DeviceProfileByName(name)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return deviceProfileToYAML(profile)
+}
+
+// deviceProfileToYAML encodes the device profile in the canonical YAML. The id and the timestamps assigned by the
+// service are left out, the fields are in the order of the DTO and the map keys are sorted, so exporting the same
+// profile gives the same document, and the empty optional fields are omitted rather than encoded as null.
+func deviceProfileToYAML(profile models.DeviceProfile) ([]byte, errors.EdgeX) {
+	dto := dtos.FromDeviceProfileModelToDTO(profile)
+	dto.Id = ""
+	dto.DBTimestamp = dtos.DBTimestamp{}
+
+	var node yaml.Node
+	if err := node.Encode(dto); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the device profile to YAML", err)
+	}
+	pruneEmptyYAMLValues(&node)
+	data, err := yaml.Marshal(&node)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the device profile to YAML", err)
+	}
+	return data, nil
+}
+
+// pruneEmptyYAMLValues removes the mapping entries whose value is null or an empty mapping, e.g. the optional properties
+// of the device resource, which the DTO encodes even when they are empty
+func pruneEmptyYAMLValues(node *yaml.Node) {
+	for _, child := range node.Content {
+		pruneEmptyYAMLValues(child)
+	}
+	if node.Kind != yaml.MappingNode {
+		return
+	}
+	content := node.Content[:0]
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		value := node.Content[i+1]
+		if value.Tag == "!!null" || (value.Kind == yaml.MappingNode && len(value.Content) == 0) {
+			continue
+		}
+		content = append(content, node.Content[i], value)
+	}
+	node.Content = content
+}
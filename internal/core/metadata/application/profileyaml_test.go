@@ -0,0 +1,77 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestDeviceProfileInYAMLByName(t *testing.T) {
+	maximum := 100.0
+	profile := models.DeviceProfile{
+		DBTimestamp:  models.DBTimestamp{Created: 1000, Modified: 2000},
+		Id:           "7a1707f0-166f-4c4b-bc9d-1d54c74e0137",
+		Name:         "thermostat",
+		Manufacturer: "IOTech",
+		Labels:       []string{"hvac"},
+		DeviceResources: []models.DeviceResource{
+			{Name: "temperature", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R,
+				Maximum: &maximum, Optional: map[string]any{WarnHighKey: 80.5, CritHighKey: 90.5}},
+				Attributes: map[string]any{"register": 40001, "function": "holding"}},
+			{Name: "setpoint", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW}},
+		},
+		DeviceCommands: []models.DeviceCommand{
+			{Name: "climate", ReadWrite: common.ReadWrite_R, ResourceOperations: []models.ResourceOperation{{DeviceResource: "temperature"}}},
+		},
+	}
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", profile.Name).Return(profile, nil)
+	dbClientMock.On("DeviceProfileByName", "unknown").Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dic := labelsTestDic(false, dbClientMock)
+
+	data, err := DeviceProfileInYAMLByName(profile.Name, dic)
+	require.NoError(t, err)
+	document := string(data)
+	assert.NotContains(t, document, "null")
+	assert.NotContains(t, document, "optional: {}", "the empty optional properties should be omitted")
+	assert.NotContains(t, document, profile.Id, "the id assigned by the service should be omitted")
+	assert.NotContains(t, document, "dbTimestamp")
+	assert.Less(t, strings.Index(document, "critHigh"), strings.Index(document, "warnHigh"), "the map keys should be sorted")
+	assert.Less(t, strings.Index(document, "function"), strings.Index(document, "register"))
+
+	again, err := DeviceProfileInYAMLByName(profile.Name, dic)
+	require.NoError(t, err)
+	assert.Equal(t, data, again, "the export should be stable")
+
+	// the exported YAML is accepted by the device profile upload
+	var uploaded dtos.DeviceProfile
+	require.NoError(t, yaml.Unmarshal(data, &uploaded))
+	expected := profile
+	expected.Id = ""
+	expected.DBTimestamp = models.DBTimestamp{}
+	assert.Equal(t, dtos.FromDeviceProfileModelToDTO(expected), uploaded)
+
+	_, err = DeviceProfileInYAMLByName("unknown", dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+
+	_, err = DeviceProfileInYAMLByName("", dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
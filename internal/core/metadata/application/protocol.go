@@ -0,0 +1,75 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// ProtocolLabelPrefix is the prefix of the device profile label naming the protocol of the device service, e.g.
+// "protocol:modbus", whose attribute validator validates the Attributes of the device resources of the profile
+const ProtocolLabelPrefix = "protocol:"
+
+// ProtocolKey is the key of the protocol in the ResourceProperties.Optional of the device resource, which overrides the
+// protocol of the profile for the resource
+const ProtocolKey = "protocol"
+
+// resourceProtocol returns the protocol of the device resource, or empty if neither the resource nor the profile
+// names one
+func resourceProtocol(p models.DeviceProfile, r models.DeviceResource) (string, errors.EdgeX) {
+	if value, ok := r.Properties.Optional[ProtocolKey]; ok && value != nil {
+		protocol, ok := value.(string)
+		if !ok || strings.TrimSpace(protocol) == "" {
+			return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s protocol %v is not a non-empty string", r.Name, value), nil)
+		}
+		return protocol, nil
+	}
+	for _, label := range p.Labels {
+		if protocol, ok := strings.CutPrefix(label, ProtocolLabelPrefix); ok && protocol != "" {
+			return protocol, nil
+		}
+	}
+	return "", nil
+}
+
+// deviceResourceProtocolAttributesValidation validates the Attributes of the device resource with the attribute
+// validator of its protocol. The protocol without a registered validator passes with a warning.
+func deviceResourceProtocolAttributesValidation(p models.DeviceProfile, r models.DeviceResource, dic *di.Container) errors.EdgeX {
+	protocol, err := resourceProtocol(p, r)
+	if err != nil || protocol == "" {
+		return err
+	}
+	validators := container.ProtocolAttributesFrom(dic.Get)
+	if validators == nil {
+		return nil
+	}
+	known, validateErr := validators.Validate(protocol, r.Attributes)
+	if !known {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Warnf("No attribute validator is registered for the protocol %s of the DeviceResource %s, skip the attribute validation", protocol, r.Name)
+		return nil
+	}
+	if validateErr != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s attributes are invalid for the protocol %s", r.Name, protocol), validateErr)
+	}
+	return nil
+}
+
+func deviceProfileProtocolAttributesValidation(p models.DeviceProfile, dic *di.Container) errors.EdgeX {
+	for _, r := range p.DeviceResources {
+		if err := deviceResourceProtocolAttributesValidation(p, r, dic); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/protocol"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceProfileProtocolAttributesValidation(t *testing.T) {
+	registry := protocol.NewRegistry()
+	registry.Register("modbus", protocol.BuiltinSchemas["modbus"])
+	registry.Register("opcua", protocol.BuiltinSchemas["opcua"])
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ProtocolAttributesInterfaceName: func(get di.Get) interface{} {
+			return registry
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+	resource := func(attributes map[string]any, optional map[string]any) models.DeviceResource {
+		return models.DeviceResource{
+			Name:       "temperature",
+			Attributes: attributes,
+			Properties: models.ResourceProperties{ValueType: common.ValueTypeInt16, ReadWrite: common.ReadWrite_R, Optional: optional},
+		}
+	}
+	modbusAttributes := map[string]any{"primaryTable": "HOLDING_REGISTERS", "startingAddress": 1}
+
+	tests := []struct {
+		name          string
+		labels        []string
+		resource      models.DeviceResource
+		expectedError bool
+	}{
+		{"valid - no protocol", nil, resource(map[string]any{"any": "thing"}, nil), false},
+		{"valid - profile protocol", []string{ProtocolLabelPrefix + "modbus"}, resource(modbusAttributes, nil), false},
+		{"valid - unknown protocol passes", []string{ProtocolLabelPrefix + "bacnet-ip"}, resource(nil, nil), false},
+		{"valid - resource protocol overrides the profile", []string{ProtocolLabelPrefix + "modbus"}, resource(map[string]any{"nodeId": "ns=2;s=Temp"}, map[string]any{ProtocolKey: "opcua"}), false},
+		{"invalid - profile protocol", []string{ProtocolLabelPrefix + "modbus"}, resource(map[string]any{"startingAddress": 1}, nil), true},
+		{"invalid - resource protocol", nil, resource(modbusAttributes, map[string]any{ProtocolKey: "opcua"}), true},
+		{"invalid - resource protocol not a string", nil, resource(modbusAttributes, map[string]any{ProtocolKey: 1}), true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			profile := models.DeviceProfile{Name: "sensor", Labels: testCase.labels, DeviceResources: []models.DeviceResource{testCase.resource}}
+			err := deviceProfileProtocolAttributesValidation(profile, dic)
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				assert.Contains(t, err.Error(), testCase.resource.Name)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	propertiesField = "properties"
+	valueTypeField  = "valueType"
+	readWriteField  = "readWrite"
+	nameField       = "name"
+)
+
+var readWriteValues = []string{common.ReadWrite_R, common.ReadWrite_W, common.ReadWrite_RW, common.ReadWrite_WR}
+
+// ApplyDefaultReadWriteToJSON sets the ReadWrite of the device resources in the JSON document which omit the ReadWrite to
+// the default of their value type configured in Writable.DefaultReadWrite. The document is processed before decoding
+// into the DTOs, since the DTO validation requires the ReadWrite.
+func ApplyDefaultReadWriteToJSON(data []byte, dic *di.Container) ([]byte, errors.EdgeX) {
+	defaults := defaultReadWriteByValueType(dic)
+	if len(defaults) == 0 {
+		return data, nil
+	}
+
+	var document any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// keep the numbers as they are, e.g. the large integers of the minimum and maximum
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the JSON document", err)
+	}
+	if !applyDefaultReadWriteToJSONValue(document, defaults, dic) {
+		return data, nil
+	}
+	result, err := json.Marshal(document)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the JSON document", err)
+	}
+	return result, nil
+}
+
+func applyDefaultReadWriteToJSONValue(value any, defaults map[string]string, dic *di.Container) (applied bool) {
+	switch v := value.(type) {
+	case []any:
+		for _, item := range v {
+			applied = applyDefaultReadWriteToJSONValue(item, defaults, dic) || applied
+		}
+	case map[string]any:
+		if properties, ok := v[propertiesField].(map[string]any); ok {
+			valueType, _ := properties[valueTypeField].(string)
+			readWrite, _ := properties[readWriteField].(string)
+			if readWrite == "" {
+				name, _ := v[nameField].(string)
+				if readWrite, ok = defaultReadWrite(name, valueType, defaults, dic); ok {
+					properties[readWriteField] = readWrite
+					applied = true
+				}
+			}
+		}
+		for _, item := range v {
+			applied = applyDefaultReadWriteToJSONValue(item, defaults, dic) || applied
+		}
+	}
+	return applied
+}
+
+// ApplyDefaultReadWriteToYAML sets the ReadWrite of the device resources in the YAML document in the same manner as
+// ApplyDefaultReadWriteToJSON. The document is edited as the YAML nodes, so the rest of the document is kept as it is.
+func ApplyDefaultReadWriteToYAML(data []byte, dic *di.Container) ([]byte, errors.EdgeX) {
+	defaults := defaultReadWriteByValueType(dic)
+	if len(defaults) == 0 {
+		return data, nil
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the YAML document", err)
+	}
+	if !applyDefaultReadWriteToYAMLNode(&document, defaults, dic) {
+		return data, nil
+	}
+	result, err := yaml.Marshal(&document)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the YAML document", err)
+	}
+	return result, nil
+}
+
+func applyDefaultReadWriteToYAMLNode(node *yaml.Node, defaults map[string]string, dic *di.Container) (applied bool) {
+	if node.Kind == yaml.MappingNode {
+		if properties := yamlMappingValue(node, propertiesField); properties != nil && properties.Kind == yaml.MappingNode {
+			readWriteNode := yamlMappingValue(properties, readWriteField)
+			if readWriteNode == nil || readWriteNode.Value == "" {
+				var name, valueType string
+				if n := yamlMappingValue(node, nameField); n != nil {
+					name = n.Value
+				}
+				if n := yamlMappingValue(properties, valueTypeField); n != nil {
+					valueType = n.Value
+				}
+				if readWrite, ok := defaultReadWrite(name, valueType, defaults, dic); ok {
+					if readWriteNode == nil {
+						properties.Content = append(properties.Content,
+							&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: readWriteField},
+							&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: readWrite})
+					} else {
+						readWriteNode.Kind, readWriteNode.Tag, readWriteNode.Value = yaml.ScalarNode, "!!str", readWrite
+					}
+					applied = true
+				}
+			}
+		}
+	}
+	for _, child := range node.Content {
+		applied = applyDefaultReadWriteToYAMLNode(child, defaults, dic) || applied
+	}
+	return applied
+}
+
+// yamlMappingValue returns the value node of the key in the YAML mapping node, or nil if the key doesn't exist
+func yamlMappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// defaultReadWriteByValueType returns the valid entries of Writable.DefaultReadWrite keyed by the normalized value types.
+// The invalid entries, which may come from a runtime configuration change, are skipped.
+func defaultReadWriteByValueType(dic *di.Container) map[string]string {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	defaults := make(map[string]string)
+	for valueType, readWrite := range container.ConfigurationFrom(dic.Get).Writable.DefaultReadWrite {
+		normalized, err := common.NormalizeValueType(valueType)
+		if err != nil || !slices.Contains(readWriteValues, readWrite) {
+			lc.Errorf("skip the invalid DefaultReadWrite entry %s: %s", valueType, readWrite)
+			continue
+		}
+		defaults[normalized] = readWrite
+	}
+	return defaults
+}
+
+func defaultReadWrite(resourceName, valueType string, defaults map[string]string, dic *di.Container) (string, bool) {
+	normalized, err := common.NormalizeValueType(valueType)
+	if err != nil {
+		// leave the invalid value type to the DTO validation
+		return "", false
+	}
+	readWrite, ok := defaults[normalized]
+	if !ok {
+		return "", false
+	}
+	bootstrapContainer.LoggingClientFrom(dic.Get).Infof("Applied the default ReadWrite %s of the value type %s to the DeviceResource %s", readWrite, normalized, resourceName)
+	return readWrite, true
+}
@@ -0,0 +1,95 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func defaultReadWriteTestDic(defaults map[string]string) *di.Container {
+	configuration := &config.ConfigurationStruct{}
+	configuration.Writable.DefaultReadWrite = defaults
+	return di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return configuration
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+}
+
+const defaultReadWriteTestYaml = `name: sensor
+deviceResources:
+  - name: status
+    properties:
+      valueType: Object
+  - name: temperature
+    properties:
+      valueType: Float32
+      minimum: 10000000000000000
+  - name: setPoint
+    properties:
+      valueType: Object
+      readWrite: RW
+`
+
+func TestApplyDefaultReadWriteToYAML(t *testing.T) {
+	dic := defaultReadWriteTestDic(map[string]string{"object": common.ReadWrite_R, "Bool": common.ReadWrite_RW})
+
+	data, err := ApplyDefaultReadWriteToYAML([]byte(defaultReadWriteTestYaml), dic)
+	require.NoError(t, err)
+
+	var profile struct {
+		DeviceResources []struct {
+			Properties map[string]any
+		} `yaml:"deviceResources"`
+	}
+	require.NoError(t, yaml.Unmarshal(data, &profile))
+	require.Len(t, profile.DeviceResources, 3)
+	assert.Equal(t, common.ReadWrite_R, profile.DeviceResources[0].Properties["readWrite"])
+	assert.NotContains(t, profile.DeviceResources[1].Properties, "readWrite", "no default configured for the value type")
+	assert.Equal(t, 10000000000000000, profile.DeviceResources[1].Properties["minimum"])
+	assert.Equal(t, common.ReadWrite_RW, profile.DeviceResources[2].Properties["readWrite"], "the explicit ReadWrite should win")
+}
+
+func TestApplyDefaultReadWriteToJSON(t *testing.T) {
+	dic := defaultReadWriteTestDic(map[string]string{common.ValueTypeObject: common.ReadWrite_R, common.ValueTypeFloat32: common.ReadWrite_W})
+	body := `[{"apiVersion":"v3","profileName":"sensor","resource":{"name":"temperature","properties":{"valueType":"Float32","minimum":10000000000000001}}},` +
+		`{"apiVersion":"v3","profileName":"sensor","resource":{"name":"status","properties":{"valueType":"Object","readWrite":"RW"}}}]`
+
+	data, err := ApplyDefaultReadWriteToJSON([]byte(body), dic)
+	require.NoError(t, err)
+
+	var reqs []requests.AddDeviceResourceRequest
+	require.NoError(t, json.Unmarshal(data, &reqs))
+	require.Len(t, reqs, 2)
+	assert.Equal(t, common.ReadWrite_W, reqs[0].Resource.Properties.ReadWrite)
+	assert.Contains(t, string(data), "10000000000000001", "the numbers should be kept as they are")
+	assert.Equal(t, common.ReadWrite_RW, reqs[1].Resource.Properties.ReadWrite, "the explicit ReadWrite should win")
+}
+
+func TestApplyDefaultReadWrite_NoDefaults(t *testing.T) {
+	dic := defaultReadWriteTestDic(map[string]string{"unknown": common.ReadWrite_R, common.ValueTypeObject: "X"})
+
+	data, err := ApplyDefaultReadWriteToYAML([]byte(defaultReadWriteTestYaml), dic)
+	require.NoError(t, err)
+	assert.Equal(t, defaultReadWriteTestYaml, string(data), "the document should be kept as it is without valid defaults")
+}
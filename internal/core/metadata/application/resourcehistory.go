@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/utils"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// DeviceResourceHistory returns the recorded changes of the device resource, oldest first. The device resource may
+// have been removed from the device profile since.
+func DeviceResourceHistory(profileName, resourceName string, dic *di.Container) ([]utils.DeviceResourceChange, errors.EdgeX) {
+	if profileName == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "profile name is empty", nil)
+	}
+	if resourceName == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "resource name is empty", nil)
+	}
+	if _, err := container.DBClientFrom(dic.Get).DeviceProfileByName(profileName); err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return container.DeviceResourceHistoryFrom(dic.Get).Get(profileName, resourceName), nil
+}
+
+// recordDeviceResourceHistory appends the changed fields of each modified device resource of the updated device
+// profile to the history, if Writable.ResourceHistoryLimit is set
+func recordDeviceResourceHistory(original, updated dtos.DeviceProfile, ctx context.Context, dic *di.Container) {
+	limit := int(container.ConfigurationFrom(dic.Get).Writable.ResourceHistoryLimit)
+	if limit <= 0 {
+		return
+	}
+	originalByName := make(map[string]dtos.DeviceResource, len(original.DeviceResources))
+	for _, r := range original.DeviceResources {
+		originalByName[r.Name] = r
+	}
+	resourceHistory := container.DeviceResourceHistoryFrom(dic.Get)
+	timestamp := time.Now().UnixMilli()
+	correlationId := correlation.FromContext(ctx)
+	for _, r := range updated.DeviceResources {
+		o, ok := originalByName[r.Name]
+		if !ok {
+			continue
+		}
+		changes := deviceResourceFieldChanges(o, r)
+		if len(changes) == 0 {
+			continue
+		}
+		resourceHistory.Append(updated.Name, r.Name, utils.DeviceResourceChange{Timestamp: timestamp, CorrelationId: correlationId, Changes: changes}, limit)
+	}
+}
+
+// deviceResourceFieldChanges compares the JSON fields of the device resource and its properties
+func deviceResourceFieldChanges(original, updated dtos.DeviceResource) map[string]utils.FieldChange {
+	originalFields := deviceResourceFields(original)
+	updatedFields := deviceResourceFields(updated)
+	changes := make(map[string]utils.FieldChange)
+	for name, o := range originalFields {
+		if u, ok := updatedFields[name]; !ok || !reflect.DeepEqual(o, u) {
+			changes[name] = utils.FieldChange{Old: o, New: u}
+		}
+	}
+	for name, u := range updatedFields {
+		if _, ok := originalFields[name]; !ok {
+			changes[name] = utils.FieldChange{New: u}
+		}
+	}
+	return changes
+}
+
+// deviceResourceFields flattens the JSON fields of the device resource, with the properties prefixed with "properties."
+func deviceResourceFields(r dtos.DeviceResource) map[string]any {
+	fields := make(map[string]any)
+	data, err := json.Marshal(r)
+	if err != nil {
+		return fields
+	}
+	_ = json.Unmarshal(data, &fields)
+	if properties, ok := fields["properties"].(map[string]any); ok {
+		delete(fields, "properties")
+		for name, value := range properties {
+			fields["properties."+name] = value
+		}
+	}
+	return fields
+}
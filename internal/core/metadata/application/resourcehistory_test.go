@@ -0,0 +1,100 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/utils"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceResourceHistory(t *testing.T) {
+	profileName := "historyProfile"
+	notFoundName := "notFoundProfile"
+	scale, newScale := 0.1, 0.01
+	original := dtos.DeviceProfile{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: profileName}, DeviceResources: []dtos.DeviceResource{
+		{Name: "temperature", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R, Scale: &scale}},
+		{Name: "humidity", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R}},
+	}}
+	updated := dtos.DeviceProfile{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: profileName}, DeviceResources: []dtos.DeviceResource{
+		{Name: "temperature", Description: "room temperature", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R, Scale: &newScale}},
+		{Name: "humidity", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R}},
+	}}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", profileName).Return(models.DeviceProfile{Name: profileName}, nil)
+	dbClientMock.On("DeviceProfileByName", notFoundName).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dic := labelsTestDic(false, dbClientMock)
+
+	recordDeviceResourceHistory(original, updated, context.Background(), dic)
+	changes, err := DeviceResourceHistory(profileName, "temperature", dic)
+	require.NoError(t, err)
+	assert.Empty(t, changes, "the history is disabled by default")
+
+	container.ConfigurationFrom(dic.Get).Writable.ResourceHistoryLimit = 2
+	recordDeviceResourceHistory(original, updated, context.Background(), dic)
+	changes, err = DeviceResourceHistory(profileName, "temperature", dic)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, map[string]utils.FieldChange{
+		"description":      {New: "room temperature"},
+		"properties.scale": {Old: 0.1, New: 0.01},
+	}, changes[0].Changes, "only the changed fields should be recorded")
+	assert.NotZero(t, changes[0].Timestamp)
+
+	changes, err = DeviceResourceHistory(profileName, "humidity", dic)
+	require.NoError(t, err)
+	assert.Empty(t, changes, "the unchanged resource should not be recorded")
+
+	recordDeviceResourceHistory(updated, original, context.Background(), dic)
+	recordDeviceResourceHistory(original, updated, context.Background(), dic)
+	changes, err = DeviceResourceHistory(profileName, "temperature", dic)
+	require.NoError(t, err)
+	require.Len(t, changes, 2, "the oldest changes beyond the limit should be dropped")
+	assert.Equal(t, utils.FieldChange{Old: 0.01, New: 0.1}, changes[0].Changes["properties.scale"])
+
+	_, err = DeviceResourceHistory(notFoundName, "temperature", dic)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+	_, err = DeviceResourceHistory(profileName, "", dic)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestUpdateDeviceProfile_ResourceHistory(t *testing.T) {
+	scale, newScale := 0.1, 0.01
+	original := models.DeviceProfile{Name: "historyUpdateProfile", DeviceResources: []models.DeviceResource{
+		{Name: "temperature", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R, Scale: &scale}},
+	}}
+	updated := cloneDeviceProfile(original)
+	updated.DeviceResources[0].Properties.Scale = &newScale
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", original.Name).Return(original, nil).Once()
+	dbClientMock.On("DeviceProfileByName", original.Name).Return(updated, nil)
+	dbClientMock.On("UpdateDeviceProfile", updated).Return(nil)
+	dbClientMock.On("DeviceCountByProfileName", original.Name).Return(uint32(0), nil)
+	dic := labelsTestDic(false, dbClientMock)
+	syncSystemEventPublish(dic)
+	// only the history is enabled, so the original profile must still be loaded to record the changes
+	container.ConfigurationFrom(dic.Get).Writable.ResourceHistoryLimit = 1
+
+	err := UpdateDeviceProfile(updated, context.Background(), dic)
+	require.NoError(t, err)
+	changes, err := DeviceResourceHistory(original.Name, "temperature", dic)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, utils.FieldChange{Old: 0.1, New: 0.01}, changes[0].Changes["properties.scale"])
+}
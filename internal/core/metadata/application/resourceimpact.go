@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// resourceImpactPageSize is the number of the device profiles queried per page to assess the resource impact
+const resourceImpactPageSize = 100
+
+// ResourceImpactProfile is a device profile affected by removing the device resource, along with the devices on the
+// profile and the device commands referencing the resource
+type ResourceImpactProfile struct {
+	ProfileName    string   `json:"profileName"`
+	DeviceNames    []string `json:"deviceNames"`
+	DeviceCommands []string `json:"deviceCommands"`
+}
+
+// DeprecatedResourceImpactReport is the impact of removing the device resource from every device profile containing it
+type DeprecatedResourceImpactReport struct {
+	ResourceName string                  `json:"resourceName"`
+	DeviceCount  int                     `json:"deviceCount"`
+	Profiles     []ResourceImpactProfile `json:"profiles"`
+}
+
+// deviceCommandsReferencingResource returns the names of the device commands of the profile whose resource operations
+// reference the device resource
+func deviceCommandsReferencingResource(profile models.DeviceProfile, resourceName string) []string {
+	commands := []string{}
+	for _, command := range profile.DeviceCommands {
+		for _, ro := range command.ResourceOperations {
+			if ro.DeviceResource == resourceName {
+				commands = append(commands, command.Name)
+				break
+			}
+		}
+	}
+	return commands
+}
+
+// profileHasDeviceResource returns whether the device profile contains the device resource
+func profileHasDeviceResource(profile models.DeviceProfile, resourceName string) bool {
+	for _, r := range profile.DeviceResources {
+		if r.Name == resourceName {
+			return true
+		}
+	}
+	return false
+}
+
+// DeprecatedResourceImpact reports the device profiles containing the device resource or having the device commands
+// referencing it, the devices on those profiles and the referencing device commands, so the impact of deprecating the
+// resource can be assessed before it is removed. Nothing is modified. The resource in no device profile has an empty
+// report.
+func DeprecatedResourceImpact(resourceName string, dic *di.Container) (DeprecatedResourceImpactReport, errors.EdgeX) {
+	if resourceName == "" {
+		return DeprecatedResourceImpactReport{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "resource name is empty", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	report := DeprecatedResourceImpactReport{ResourceName: resourceName, Profiles: []ResourceImpactProfile{}}
+	for offset := 0; ; offset += resourceImpactPageSize {
+		profiles, err := dbClient.AllDeviceProfiles(offset, resourceImpactPageSize, nil)
+		if err != nil {
+			return DeprecatedResourceImpactReport{}, errors.NewCommonEdgeXWrapper(err)
+		}
+		for _, p := range profiles {
+			commands := deviceCommandsReferencingResource(p, resourceName)
+			if !profileHasDeviceResource(p, resourceName) && len(commands) == 0 {
+				continue
+			}
+			devices, err := dbClient.DevicesByProfileName(0, -1, p.Name)
+			if err != nil {
+				return DeprecatedResourceImpactReport{}, errors.NewCommonEdgeXWrapper(err)
+			}
+			impact := ResourceImpactProfile{ProfileName: p.Name, DeviceNames: make([]string, len(devices)), DeviceCommands: commands}
+			for i, d := range devices {
+				impact.DeviceNames[i] = d.Name
+			}
+			report.DeviceCount += len(devices)
+			report.Profiles = append(report.Profiles, impact)
+		}
+		if len(profiles) < resourceImpactPageSize {
+			break
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,56 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeprecatedResourceImpact(t *testing.T) {
+	thermostat := models.DeviceProfile{
+		Name:            "thermostat",
+		DeviceResources: []models.DeviceResource{{Name: "temperature"}, {Name: "humidity"}},
+		DeviceCommands: []models.DeviceCommand{
+			{Name: "climate", ResourceOperations: []models.ResourceOperation{{DeviceResource: "temperature"}, {DeviceResource: "humidity"}}},
+			{Name: "moisture", ResourceOperations: []models.ResourceOperation{{DeviceResource: "humidity"}}},
+		},
+	}
+	hvac := models.DeviceProfile{Name: "hvac", DeviceResources: []models.DeviceResource{{Name: "temperature"}}}
+	meter := models.DeviceProfile{Name: "meter", DeviceResources: []models.DeviceResource{{Name: "power"}}}
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AllDeviceProfiles", 0, resourceImpactPageSize, []string(nil)).Return([]models.DeviceProfile{thermostat, hvac, meter}, nil)
+	dbClientMock.On("DevicesByProfileName", 0, -1, thermostat.Name).Return([]models.Device{{Name: "t1"}, {Name: "t2"}}, nil)
+	dbClientMock.On("DevicesByProfileName", 0, -1, hvac.Name).Return([]models.Device{}, nil)
+	dic := labelsTestDic(false, dbClientMock)
+
+	report, err := DeprecatedResourceImpact("temperature", dic)
+	require.NoError(t, err)
+	assert.Equal(t, DeprecatedResourceImpactReport{
+		ResourceName: "temperature",
+		DeviceCount:  2,
+		Profiles: []ResourceImpactProfile{
+			{ProfileName: thermostat.Name, DeviceNames: []string{"t1", "t2"}, DeviceCommands: []string{"climate"}},
+			{ProfileName: hvac.Name, DeviceNames: []string{}, DeviceCommands: []string{}},
+		},
+	}, report)
+	dbClientMock.AssertNotCalled(t, "DevicesByProfileName", 0, -1, meter.Name)
+
+	report, err = DeprecatedResourceImpact("pressure", dic)
+	require.NoError(t, err)
+	assert.Empty(t, report.Profiles)
+
+	_, err = DeprecatedResourceImpact("", dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
@@ -0,0 +1,83 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// ReorderResources sets the order of the device resources of the device profile to the order of the orderedNames,
+// which must list each device resource of the profile exactly once. The device resources are stored and returned in
+// the order of the slice, which is the upload order unless reordered.
+func ReorderResources(profileName string, orderedNames []string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if profileName == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "profile name is empty", nil)
+	}
+	if container.ConfigurationFrom(dic.Get).Writable.ProfileChange.StrictDeviceProfileChanges {
+		return errors.NewCommonEdgeX(errors.KindServiceLocked, "profile change is not allowed when StrictDeviceProfileChanges config is enabled", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	profile, err := dbClient.DeviceProfileByName(profileName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	reordered, err := reorderDeviceResources(profile.DeviceResources, orderedNames)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if slices.EqualFunc(reordered, profile.DeviceResources, func(a, b models.DeviceResource) bool { return a.Name == b.Name }) {
+		return nil
+	}
+	original := cloneDeviceProfile(profile)
+	profile.DeviceResources = reordered
+
+	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
+	if err = dbClient.UpdateDeviceProfile(profile); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("DeviceProfile %s resources reordered on DB successfully. Correlation-id: %s ", profileName, correlation.FromContext(ctx))
+	if err := notifyUpdateDeviceProfileSystemEvent(original, profileDTO, ctx, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// reorderDeviceResources returns the device resources in the order of the orderedNames, which must cover exactly the
+// device resources
+func reorderDeviceResources(resources []models.DeviceResource, orderedNames []string) ([]models.DeviceResource, errors.EdgeX) {
+	if len(orderedNames) != len(resources) {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the resource names list %d resources, but the profile has %d resources", len(orderedNames), len(resources)), nil)
+	}
+	indexes := make(map[string]int, len(resources))
+	for i, r := range resources {
+		indexes[r.Name] = i
+	}
+	reordered := make([]models.DeviceResource, 0, len(resources))
+	for _, name := range orderedNames {
+		i, ok := indexes[name]
+		if !ok {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("resource %s is not in the profile or is listed more than once", name), nil)
+		}
+		delete(indexes, name)
+		reordered = append(reordered, resources[i])
+	}
+	return reordered, nil
+}
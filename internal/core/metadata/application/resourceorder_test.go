@@ -0,0 +1,67 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReorderResources(t *testing.T) {
+	resource := func(name string) models.DeviceResource {
+		return models.DeviceResource{Name: name, Properties: models.ResourceProperties{ValueType: common.ValueTypeInt16, ReadWrite: common.ReadWrite_R}}
+	}
+	profile := models.DeviceProfile{Name: "thermostat", DeviceResources: []models.DeviceResource{resource("a"), resource("b"), resource("c")}}
+	reordered := profile
+	reordered.DeviceResources = []models.DeviceResource{resource("c"), resource("a"), resource("b")}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", profile.Name).Return(profile, nil)
+	dbClientMock.On("UpdateDeviceProfile", reordered).Return(nil)
+	dbClientMock.On("DeviceCountByProfileName", profile.Name).Return(uint32(0), nil)
+	dic := labelsTestDic(false, dbClientMock)
+	syncSystemEventPublish(dic)
+
+	err := ReorderResources(profile.Name, []string{"c", "a", "b"}, context.Background(), dic)
+	require.NoError(t, err)
+	dbClientMock.AssertCalled(t, "UpdateDeviceProfile", reordered)
+
+	err = ReorderResources(profile.Name, []string{"a", "b", "c"}, context.Background(), dic)
+	require.NoError(t, err, "the unchanged order is not persisted")
+
+	tests := []struct {
+		name  string
+		names []string
+	}{
+		{"missing resource", []string{"c", "a"}},
+		{"unknown resource", []string{"c", "a", "d"}},
+		{"duplicate resource", []string{"c", "a", "a"}},
+		{"extra resource", []string{"c", "a", "b", "d"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ReorderResources(profile.Name, testCase.names, context.Background(), dic)
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "UpdateDeviceProfile", 1)
+
+	container.ConfigurationFrom(dic.Get).Writable.ProfileChange.StrictDeviceProfileChanges = true
+	err = ReorderResources(profile.Name, []string{"c", "a", "b"}, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceLocked, errors.Kind(err))
+}
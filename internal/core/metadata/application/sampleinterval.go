@@ -0,0 +1,53 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// deviceResourceSampleIntervalBoundsValidation validates the sampleInterval optional property of the device resource
+// is within the MinSampleInterval and MaxSampleInterval, so a typo like "1ns" doesn't overwhelm the schedulers. The
+// empty bound is not checked, and the format of the sampleInterval is validated by deviceResourceSampleIntervalValidation.
+func deviceResourceSampleIntervalBoundsValidation(r models.DeviceResource, dic *di.Container) errors.EdgeX {
+	writable := container.ConfigurationFrom(dic.Get).Writable
+	if writable.MinSampleInterval == "" && writable.MaxSampleInterval == "" {
+		return nil
+	}
+	interval, ok := r.Properties.Optional[SampleIntervalKey].(string)
+	if !ok {
+		return nil
+	}
+	duration, err := time.ParseDuration(interval)
+	if err != nil {
+		return nil
+	}
+	if minInterval, err := time.ParseDuration(writable.MinSampleInterval); err == nil && duration < minInterval {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("DeviceResource %s sampleInterval %s is shorter than the minimum allowed %s", r.Name, interval, writable.MinSampleInterval), nil)
+	}
+	if maxInterval, err := time.ParseDuration(writable.MaxSampleInterval); err == nil && duration > maxInterval {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("DeviceResource %s sampleInterval %s is longer than the maximum allowed %s", r.Name, interval, writable.MaxSampleInterval), nil)
+	}
+	return nil
+}
+
+func deviceProfileSampleIntervalBoundsValidation(p models.DeviceProfile, dic *di.Container) errors.EdgeX {
+	for _, r := range p.DeviceResources {
+		if err := deviceResourceSampleIntervalBoundsValidation(r, dic); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceResourceSampleIntervalBoundsValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		minInterval   string
+		maxInterval   string
+		optional      map[string]any
+		errorExpected bool
+	}{
+		{"valid - no bounds", "", "", map[string]any{SampleIntervalKey: "1ns"}, false},
+		{"valid - no sampleInterval", "10ms", "24h", nil, false},
+		{"valid - within the bounds", "10ms", "24h", map[string]any{SampleIntervalKey: "500ms"}, false},
+		{"valid - at the minimum", "10ms", "24h", map[string]any{SampleIntervalKey: "10ms"}, false},
+		{"valid - only the maximum", "", "1h", map[string]any{SampleIntervalKey: "1ns"}, false},
+		{"invalid - below the minimum", "10ms", "24h", map[string]any{SampleIntervalKey: "1ns"}, true},
+		{"invalid - above the maximum", "10ms", "24h", map[string]any{SampleIntervalKey: "48h"}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := labelsTestDic(false, &mocks.DBClient{})
+			writable := &container.ConfigurationFrom(dic.Get).Writable
+			writable.MinSampleInterval = testCase.minInterval
+			writable.MaxSampleInterval = testCase.maxInterval
+			resource := models.DeviceResource{Name: "temperature", Properties: models.ResourceProperties{Optional: testCase.optional}}
+
+			err := deviceProfileSampleIntervalBoundsValidation(models.DeviceProfile{DeviceResources: []models.DeviceResource{resource}}, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSampleIntervalBounds(t *testing.T) {
+	tests := []struct {
+		name          string
+		minInterval   string
+		maxInterval   string
+		errorExpected bool
+	}{
+		{"valid - no bounds", "", "", false},
+		{"valid - both bounds", "10ms", "24h", false},
+		{"invalid - malformed", "fast", "", true},
+		{"invalid - not positive", "0s", "", true},
+		{"invalid - min greater than max", "1h", "1m", true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := config.WritableInfo{MinSampleInterval: testCase.minInterval, MaxSampleInterval: testCase.maxInterval}.ValidateSampleIntervalBounds()
+			if testCase.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// deviceResourceScalingValidation validates the Minimum, the Maximum and the DefaultValue of the numeric device resource
+// declaring the Scale or the Offset still fit the ValueType after scaled by value*scale + offset, e.g. the Maximum 200
+// of a Uint8 resource scaled by 2 overflows. The DefaultValue of the array value types is not validated.
+func deviceResourceScalingValidation(r models.DeviceResource) errors.EdgeX {
+	properties := r.Properties
+	if properties.Scale == nil && properties.Offset == nil {
+		return nil
+	}
+	element, isArray := elementValueType(properties.ValueType)
+	numeric, ok := numericValueTypes[element]
+	if !ok {
+		return nil
+	}
+	scale, offset := 1.0, 0.0
+	if properties.Scale != nil {
+		scale = *properties.Scale
+	}
+	if properties.Offset != nil {
+		offset = *properties.Offset
+	}
+
+	values := []struct {
+		name  string
+		value *float64
+	}{
+		{"minimum", properties.Minimum},
+		{"maximum", properties.Maximum},
+	}
+	if properties.DefaultValue != "" && !isArray {
+		// the invalid DefaultValue is rejected by the value type validation
+		if defaultValue, err := strconv.ParseFloat(properties.DefaultValue, 64); err == nil {
+			values = append(values, struct {
+				name  string
+				value *float64
+			}{"defaultValue", &defaultValue})
+		}
+	}
+	for _, v := range values {
+		if v.value == nil {
+			continue
+		}
+		scaled := *v.value*scale + offset
+		if !numeric.inRange(scaled) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf(
+				"DeviceResource %s %s %v scaled by scale %v and offset %v is %v, which overflows the range [%v, %v] of the valueType %s",
+				r.Name, v.name, *v.value, scale, offset, scaled, numeric.min, numeric.max, properties.ValueType), nil)
+		}
+	}
+	return nil
+}
+
+func deviceProfileScalingValidation(p models.DeviceProfile) errors.EdgeX {
+	for _, dr := range p.DeviceResources {
+		if err := deviceResourceScalingValidation(dr); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
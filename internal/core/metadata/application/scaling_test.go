@@ -0,0 +1,59 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"math"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceResourceScalingValidation(t *testing.T) {
+	ptr := func(v float64) *float64 { return &v }
+	resource := func(valueType string, scale, offset, minimum, maximum *float64, defaultValue string) models.DeviceResource {
+		return models.DeviceResource{Name: "temperature", Properties: models.ResourceProperties{
+			ValueType: valueType, Scale: scale, Offset: offset, Minimum: minimum, Maximum: maximum, DefaultValue: defaultValue,
+		}}
+	}
+
+	tests := []struct {
+		name          string
+		resource      models.DeviceResource
+		errorExpected bool
+	}{
+		{"valid, no scaling", resource(common.ValueTypeUint8, nil, nil, nil, ptr(255), "255"), false},
+		{"valid, scaled in range", resource(common.ValueTypeUint8, ptr(2), ptr(1), ptr(0), ptr(127), "100"), false},
+		{"valid, not numeric", resource(common.ValueTypeString, ptr(1000), nil, nil, nil, "text"), false},
+		{"valid, Int64 minimum", resource(common.ValueTypeInt64, ptr(-1), nil, nil, ptr(math.Ldexp(1, 63)), ""), false},
+		{"valid, Float32 fraction", resource(common.ValueTypeFloat32, ptr(0.5), nil, nil, nil, "1.5"), false},
+		{"invalid, Uint8 maximum overflows by scale", resource(common.ValueTypeUint8, ptr(2), nil, nil, ptr(200), ""), true},
+		{"invalid, Uint16 minimum underflows by offset", resource(common.ValueTypeUint16, nil, ptr(-10), ptr(0), nil, ""), true},
+		{"invalid, Int8 default value overflows by offset", resource(common.ValueTypeInt8, nil, ptr(100), nil, nil, "100"), true},
+		{"invalid, Int64 maximum reaches 2^63", resource(common.ValueTypeInt64, ptr(2), nil, nil, ptr(math.Ldexp(1, 62)), ""), true},
+		{"invalid, Uint64 maximum reaches 2^64", resource(common.ValueTypeUint64, ptr(2), nil, nil, ptr(math.Ldexp(1, 63)), ""), true},
+		{"invalid, Float32 maximum overflows by scale", resource(common.ValueTypeFloat32, ptr(1e10), nil, nil, ptr(1e30), ""), true},
+		{"invalid, Float64 maximum overflows to infinity", resource(common.ValueTypeFloat64, ptr(10), nil, nil, ptr(math.MaxFloat64), ""), true},
+		{"invalid, Int16Array maximum overflows by scale", resource(common.ValueTypeInt16Array, ptr(1000), nil, nil, ptr(100), ""), true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := deviceResourceScalingValidation(testCase.resource)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				assert.Contains(t, err.Error(), testCase.resource.Name)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"gopkg.in/yaml.v3"
+)
+
+// GroupTagKey is the key of the group in the Tags of the device resource and the device command. The value is a group
+// name or a list of group names, which can be used to export a subset of the device profile.
+const GroupTagKey = "group"
+
+// DeviceProfileSubsetYaml exports the device profile in YAML with only the device resources and the device commands of
+// the group, plus the device resources referenced by the exported device commands, so the subset is self-consistent.
+func DeviceProfileSubsetYaml(profileName string, group string, dic *di.Container) ([]byte, errors.EdgeX) {
+	if profileName == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "profile name is empty", nil)
+	}
+	if group == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "group is empty", nil)
+	}
+	profile, err := container.DBClientFrom(dic.Get).DeviceProfileByName(profileName)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	subset, err := deviceProfileSubset(profile, group)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	data, e := yaml.Marshal(dtos.FromDeviceProfileModelToDTO(subset))
+	if e != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the device profile subset to YAML", e)
+	}
+	return data, nil
+}
+
+// deviceProfileSubset returns the device profile with only the device resources and the device commands of the group,
+// plus the device resources referenced by the device commands, in the original order
+func deviceProfileSubset(profile models.DeviceProfile, group string) (models.DeviceProfile, errors.EdgeX) {
+	included := make(map[string]bool)
+	var commands []models.DeviceCommand
+	for _, command := range profile.DeviceCommands {
+		if !inGroup(command.Tags, group) {
+			continue
+		}
+		for _, ro := range command.ResourceOperations {
+			r, err := resourceByName(profile.DeviceResources, ro.DeviceResource)
+			if err != nil {
+				return models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceCommand %s references the missing DeviceResource %s", command.Name, ro.DeviceResource), err)
+			}
+			included[r.Name] = true
+		}
+		commands = append(commands, command)
+	}
+	var resources []models.DeviceResource
+	for _, r := range profile.DeviceResources {
+		if included[r.Name] || inGroup(r.Tags, group) {
+			resources = append(resources, r)
+		}
+	}
+	if len(resources) == 0 {
+		return models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceProfile %s has no device resources or device commands in the group %s", profile.Name, group), nil)
+	}
+
+	profile.DeviceResources = resources
+	profile.DeviceCommands = commands
+	return profile, nil
+}
+
+// inGroup returns whether the group tag is the group or a list containing the group
+func inGroup(tags map[string]any, group string) bool {
+	switch v := tags[GroupTagKey].(type) {
+	case string:
+		return v == group
+	case []string:
+		return slices.Contains(v, group)
+	case []any:
+		return slices.Contains(v, any(group))
+	}
+	return false
+}
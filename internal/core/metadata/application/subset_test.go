@@ -0,0 +1,54 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func subsetTestProfile() models.DeviceProfile {
+	return models.DeviceProfile{
+		Name: "thermostat",
+		DeviceResources: []models.DeviceResource{
+			{Name: "temperature", Tags: map[string]any{GroupTagKey: "partner"}},
+			{Name: "humidity"},
+			{Name: "setpoint", Tags: map[string]any{GroupTagKey: []any{"internal"}}},
+			{Name: "mode"},
+		},
+		DeviceCommands: []models.DeviceCommand{
+			{Name: "climate", Tags: map[string]any{GroupTagKey: []any{"partner", "internal"}}, ResourceOperations: []models.ResourceOperation{{DeviceResource: "humidity"}}},
+			{Name: "control", Tags: map[string]any{GroupTagKey: "internal"}, ResourceOperations: []models.ResourceOperation{{DeviceResource: "mode"}}},
+		},
+	}
+}
+
+func TestDeviceProfileSubset(t *testing.T) {
+	subset, err := deviceProfileSubset(subsetTestProfile(), "partner")
+	require.NoError(t, err)
+	var resourceNames []string
+	for _, r := range subset.DeviceResources {
+		resourceNames = append(resourceNames, r.Name)
+	}
+	assert.Equal(t, []string{"temperature", "humidity"}, resourceNames, "the resources of the group and of the group commands should be exported")
+	require.Len(t, subset.DeviceCommands, 1)
+	assert.Equal(t, "climate", subset.DeviceCommands[0].Name)
+
+	_, err = deviceProfileSubset(subsetTestProfile(), "unknown")
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	dangling := subsetTestProfile()
+	dangling.DeviceCommands[0].ResourceOperations[0].DeviceResource = "missing"
+	_, err = deviceProfileSubset(dangling, "partner")
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
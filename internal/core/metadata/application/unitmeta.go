@@ -0,0 +1,44 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"maps"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+)
+
+// UnitMetaKey is the key of the derived unit metadata in the ResourceProperties.Optional of the device resource
+const UnitMetaKey = "unitMeta"
+
+// UnitMeta describes the dimension and the unit system, e.g. metric or imperial, of the device resource unit
+type UnitMeta struct {
+	Dimension string `json:"dimension"`
+	System    string `json:"system,omitempty"`
+}
+
+// TagDeviceProfileUnitMeta tags the unit of each device resource with the UnitMeta looked up from the units of measure.
+// Units not in the units of measure are tagged with nil. The tag is computed for the response only and never stored.
+func TagDeviceProfileUnitMeta(deviceProfile *dtos.DeviceProfile, dic *di.Container) {
+	uom := container.UnitsOfMeasureFrom(dic.Get)
+	for i, resource := range deviceProfile.DeviceResources {
+		// copy the optional map to avoid modifying the map shared with the model
+		optional := make(map[string]any, len(resource.Properties.Optional)+1)
+		maps.Copy(optional, resource.Properties.Optional)
+
+		dimension, system, found := uom.Lookup(resource.Properties.Units)
+		if found {
+			optional[UnitMetaKey] = UnitMeta{Dimension: dimension, System: system}
+		} else {
+			optional[UnitMetaKey] = nil
+		}
+		deviceProfile.DeviceResources[i].Properties.Optional = optional
+	}
+}
@@ -0,0 +1,109 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// fixUnitsPageSize is the number of the device profiles queried per page when fixing the units
+const fixUnitsPageSize = 100
+
+// ResourceUnits identifies the units of a device resource in the units fix report
+type ResourceUnits struct {
+	ProfileName  string `json:"profileName"`
+	ResourceName string `json:"resourceName"`
+	Units        string `json:"units"`
+	// FixedUnits is the unit value the units are corrected to, which is empty for the units remaining invalid
+	FixedUnits string `json:"fixedUnits,omitempty"`
+}
+
+// UnitsFixReport reports the device resource units corrected by the aliases of the units of measure, and the invalid
+// units without a known alias
+type UnitsFixReport struct {
+	Fixed   []ResourceUnits `json:"fixed"`
+	Invalid []ResourceUnits `json:"invalid"`
+}
+
+// FixProfileUnits validates the units of the device resources of all the stored device profiles against the units of
+// measure, and corrects the invalid units with the unit aliases, e.g. celsius to C. The corrected device profiles are
+// persisted with an update system event each, unless dryRun only reports the corrections. The units without a known
+// alias are reported as invalid and left unchanged.
+func FixProfileUnits(dryRun bool, ctx context.Context, dic *di.Container) (UnitsFixReport, errors.EdgeX) {
+	report := UnitsFixReport{Fixed: []ResourceUnits{}, Invalid: []ResourceUnits{}}
+	if !dryRun && container.ConfigurationFrom(dic.Get).Writable.ProfileChange.StrictDeviceProfileChanges {
+		return report, errors.NewCommonEdgeX(errors.KindServiceLocked, "profile change is not allowed when StrictDeviceProfileChanges config is enabled", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	// the fixed profiles are persisted after paging through all the profiles, so the updates don't shift the pages
+	var fixedProfiles, originalProfiles []models.DeviceProfile
+	for offset := 0; ; offset += fixUnitsPageSize {
+		profiles, err := dbClient.AllDeviceProfiles(offset, fixUnitsPageSize, nil)
+		if err != nil {
+			return report, errors.NewCommonEdgeXWrapper(err)
+		}
+		for _, profile := range profiles {
+			fixedProfile := cloneDeviceProfile(profile)
+			if fixDeviceProfileUnits(&fixedProfile, &report, dic) {
+				fixedProfiles = append(fixedProfiles, fixedProfile)
+				originalProfiles = append(originalProfiles, profile)
+			}
+		}
+		if len(profiles) < fixUnitsPageSize {
+			break
+		}
+	}
+
+	if dryRun {
+		return report, nil
+	}
+	for i, profile := range fixedProfiles {
+		if err := dbClient.UpdateDeviceProfile(profile); err != nil {
+			return report, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to update device profile %s", profile.Name), err)
+		}
+		lc.Debugf("DeviceProfile %s units fixed on DB successfully. Correlation-id: %s ", profile.Name, correlation.FromContext(ctx))
+		if err := notifyUpdateDeviceProfileSystemEvent(originalProfiles[i], dtos.FromDeviceProfileModelToDTO(profile), ctx, dic); err != nil {
+			return report, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return report, nil
+}
+
+// fixDeviceProfileUnits corrects the invalid units of the device resources with the unit aliases, adds the corrected
+// and the remaining invalid units to the report, and returns whether any units are corrected
+func fixDeviceProfileUnits(profile *models.DeviceProfile, report *UnitsFixReport, dic *di.Container) bool {
+	uom := container.UnitsOfMeasureFrom(dic.Get)
+	fixed := false
+	for i, r := range profile.DeviceResources {
+		units := r.Properties.Units
+		if uom.Validate(units) {
+			continue
+		}
+		entry := ResourceUnits{ProfileName: profile.Name, ResourceName: r.Name, Units: units}
+		canonical, ok := uom.Canonical(units)
+		if !ok || !uom.Validate(canonical) {
+			report.Invalid = append(report.Invalid, entry)
+			continue
+		}
+		profile.DeviceResources[i].Properties.Units = canonical
+		entry.FixedUnits = canonical
+		report.Fixed = append(report.Fixed, entry)
+		fixed = true
+	}
+	return fixed
+}
@@ -0,0 +1,72 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/uom"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func unitsTestResource(name string, units string) models.DeviceResource {
+	return models.DeviceResource{Name: name, Properties: models.ResourceProperties{Units: units}}
+}
+
+func TestFixProfileUnits(t *testing.T) {
+	thermostat := models.DeviceProfile{Name: "thermostat", DeviceResources: []models.DeviceResource{
+		unitsTestResource("temperature", "celsius"),
+		unitsTestResource("setpoint", "C"),
+		unitsTestResource("pressure", "psi"),
+	}}
+	scale := models.DeviceProfile{Name: "scale", DeviceResources: []models.DeviceResource{unitsTestResource("weight", "kilos")}}
+	fixed := cloneDeviceProfile(thermostat)
+	fixed.DeviceResources[0].Properties.Units = "C"
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AllDeviceProfiles", 0, fixUnitsPageSize, []string(nil)).Return([]models.DeviceProfile{thermostat, scale}, nil)
+	dbClientMock.On("UpdateDeviceProfile", fixed).Return(nil)
+	dbClientMock.On("DeviceCountByProfileName", thermostat.Name).Return(uint32(0), nil)
+	dic := labelsTestDic(false, dbClientMock)
+	syncSystemEventPublish(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.UnitsOfMeasureInterfaceName: func(get di.Get) interface{} {
+			return &uom.UnitsOfMeasureImpl{Units: map[string]uom.Unit{
+				"temperature": {Values: []string{"C", "F"}, Aliases: map[string]string{"celsius": "C"}},
+				"weights":     {Values: []string{"kilos"}},
+			}}
+		},
+	})
+
+	expected := UnitsFixReport{
+		Fixed:   []ResourceUnits{{ProfileName: "thermostat", ResourceName: "temperature", Units: "celsius", FixedUnits: "C"}},
+		Invalid: []ResourceUnits{{ProfileName: "thermostat", ResourceName: "pressure", Units: "psi"}},
+	}
+
+	report, err := FixProfileUnits(true, context.Background(), dic)
+	require.NoError(t, err)
+	assert.Equal(t, expected, report)
+	dbClientMock.AssertNotCalled(t, "UpdateDeviceProfile", fixed)
+
+	report, err = FixProfileUnits(false, context.Background(), dic)
+	require.NoError(t, err)
+	assert.Equal(t, expected, report)
+	dbClientMock.AssertNumberOfCalls(t, "UpdateDeviceProfile", 1)
+
+	container.ConfigurationFrom(dic.Get).Writable.ProfileChange.StrictDeviceProfileChanges = true
+	_, err = FixProfileUnits(false, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceLocked, errors.Kind(err))
+}
@@ -0,0 +1,290 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// numericValueType describes the values of a numeric value type
+type numericValueType struct {
+	float  bool
+	signed bool
+	bits   int
+	min    float64
+	max    float64
+}
+
+var numericValueTypes = map[string]numericValueType{
+	common.ValueTypeUint8:   {bits: 8, min: 0, max: math.MaxUint8},
+	common.ValueTypeUint16:  {bits: 16, min: 0, max: math.MaxUint16},
+	common.ValueTypeUint32:  {bits: 32, min: 0, max: math.MaxUint32},
+	common.ValueTypeUint64:  {bits: 64, min: 0, max: math.MaxUint64},
+	common.ValueTypeInt8:    {signed: true, bits: 8, min: math.MinInt8, max: math.MaxInt8},
+	common.ValueTypeInt16:   {signed: true, bits: 16, min: math.MinInt16, max: math.MaxInt16},
+	common.ValueTypeInt32:   {signed: true, bits: 32, min: math.MinInt32, max: math.MaxInt32},
+	common.ValueTypeInt64:   {signed: true, bits: 64, min: math.MinInt64, max: math.MaxInt64},
+	common.ValueTypeFloat32: {float: true, signed: true, bits: 32, min: -math.MaxFloat32, max: math.MaxFloat32},
+	common.ValueTypeFloat64: {float: true, signed: true, bits: 64, min: -math.MaxFloat64, max: math.MaxFloat64},
+}
+
+// elementValueType returns the value type of the elements of the array value type, or the value type itself
+func elementValueType(valueType string) (string, bool) {
+	if element, ok := strings.CutSuffix(valueType, "Array"); ok {
+		return element, true
+	}
+	return valueType, false
+}
+
+// precision returns the number of the significant bits of the value type
+func (t numericValueType) precision() int {
+	switch {
+	case t.float && t.bits == 32:
+		return 24
+	case t.float:
+		return 53
+	case t.signed:
+		// the sign bit of the signed integer is not significant
+		return t.bits - 1
+	default:
+		return t.bits
+	}
+}
+
+// widens returns whether every value of the value type is exactly represented by the to value type
+func (t numericValueType) widens(to numericValueType) bool {
+	switch {
+	case t.float:
+		return to.float && to.bits > t.bits
+	case to.float:
+		return t.precision() <= to.precision()
+	case t.signed:
+		return to.signed && to.bits > t.bits
+	default:
+		return to.bits > t.bits
+	}
+}
+
+// valueTypeMigrationValidation validates the device resource value type can be migrated to the new value type. The
+// numeric value types can be widened, e.g. Int16 to Int32 or Int32Array to Int64Array, while the narrowing, e.g. Int32
+// to Int16 or Float32 to Int32, is only allowed when Writable.ProfileChange.AllowValueTypeNarrowing is enabled. The
+// other conversions are incompatible.
+func valueTypeMigrationValidation(resourceName string, from string, to string, dic *di.Container) errors.EdgeX {
+	if from == to {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s valueType is already %s", resourceName, to), nil)
+	}
+	fromElement, fromArray := elementValueType(from)
+	toElement, toArray := elementValueType(to)
+	fromType, fromNumeric := numericValueTypes[fromElement]
+	toType, toNumeric := numericValueTypes[toElement]
+	if !fromNumeric || !toNumeric || fromArray != toArray {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s valueType %s is incompatible with %s", resourceName, from, to), nil)
+	}
+	if !fromType.widens(toType) && !container.ConfigurationFrom(dic.Get).Writable.ProfileChange.AllowValueTypeNarrowing {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s valueType %s can not be narrowed to %s when AllowValueTypeNarrowing is disabled", resourceName, from, to), nil)
+	}
+	return nil
+}
+
+// inRange returns whether the value is in the range of the value type. The maximums of the 64-bit integer value types
+// are not exactly represented by float64, whose nearest values 2^63 and 2^64 are out of the range, so they are
+// compared exclusively.
+func (t numericValueType) inRange(value float64) bool {
+	if math.IsNaN(value) || math.IsInf(value, 0) || value < t.min {
+		return false
+	}
+	if !t.float && t.bits == 64 {
+		return value < math.Ldexp(1, t.precision())
+	}
+	return value <= t.max
+}
+
+// valueFitsValueType returns whether the value is in the range of the numeric value type, and is an integer for the
+// integer value types
+func valueFitsValueType(value float64, valueType numericValueType) bool {
+	if !valueType.inRange(value) {
+		return false
+	}
+	return valueType.float || value == math.Trunc(value)
+}
+
+// deviceResourceValuesValidation validates the Minimum, the Maximum and the DefaultValue of the device resource against
+// its numeric value type. The DefaultValue of the array value types is not validated.
+func deviceResourceValuesValidation(resourceName string, valueType string, minimum, maximum *float64, defaultValue string) errors.EdgeX {
+	element, isArray := elementValueType(valueType)
+	numeric, ok := numericValueTypes[element]
+	if !ok {
+		return nil
+	}
+	if minimum != nil && !valueFitsValueType(*minimum, numeric) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s minimum %v is out of the range of the valueType %s", resourceName, *minimum, valueType), nil)
+	}
+	if maximum != nil && !valueFitsValueType(*maximum, numeric) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s maximum %v is out of the range of the valueType %s", resourceName, *maximum, valueType), nil)
+	}
+	if defaultValue == "" || isArray {
+		return nil
+	}
+	var err error
+	switch {
+	case numeric.float:
+		_, err = strconv.ParseFloat(defaultValue, numeric.bits)
+	case numeric.signed:
+		_, err = strconv.ParseInt(defaultValue, 10, numeric.bits)
+	default:
+		_, err = strconv.ParseUint(defaultValue, 10, numeric.bits)
+	}
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s defaultValue %s is invalid for the valueType %s", resourceName, defaultValue, valueType), err)
+	}
+	return nil
+}
+
+// normalizeDefaultValue returns the canonical form of the DefaultValue of the value type, e.g. "true" of the Bool
+// "True" and "1" of the Float32 "1.0", so the drivers parse the same value regardless of how it is written in the
+// profile. The DefaultValue which doesn't parse as the value type is rejected. The DefaultValue of the String, Binary,
+// Object and array value types is kept as is.
+func normalizeDefaultValue(valueType string, defaultValue string) (string, error) {
+	if defaultValue == "" {
+		return defaultValue, nil
+	}
+	if valueType == common.ValueTypeBool {
+		value, err := strconv.ParseBool(strings.TrimSpace(defaultValue))
+		if err != nil {
+			return defaultValue, err
+		}
+		return strconv.FormatBool(value), nil
+	}
+	numeric, ok := numericValueTypes[valueType]
+	if !ok {
+		return defaultValue, nil
+	}
+	trimmed := strings.TrimSpace(defaultValue)
+	switch {
+	case numeric.float:
+		value, err := strconv.ParseFloat(trimmed, numeric.bits)
+		if err != nil {
+			return defaultValue, err
+		}
+		return strconv.FormatFloat(value, 'f', -1, numeric.bits), nil
+	case numeric.signed:
+		value, err := strconv.ParseInt(trimmed, 10, numeric.bits)
+		if err != nil {
+			return defaultValue, err
+		}
+		return strconv.FormatInt(value, 10), nil
+	default:
+		value, err := strconv.ParseUint(trimmed, 10, numeric.bits)
+		if err != nil {
+			return defaultValue, err
+		}
+		return strconv.FormatUint(value, 10), nil
+	}
+}
+
+// normalizeDeviceResourceDefaultValue replaces the DefaultValue of the device resource with its canonical form
+func normalizeDeviceResourceDefaultValue(r *models.DeviceResource) errors.EdgeX {
+	normalized, err := normalizeDefaultValue(r.Properties.ValueType, r.Properties.DefaultValue)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s defaultValue %s is invalid for the valueType %s", r.Name, r.Properties.DefaultValue, r.Properties.ValueType), err)
+	}
+	r.Properties.DefaultValue = normalized
+	return nil
+}
+
+// normalizeDeviceProfileDefaultValues replaces the DefaultValue of the device resources of the profile with their
+// canonical forms
+func normalizeDeviceProfileDefaultValues(p *models.DeviceProfile) errors.EdgeX {
+	for i := range p.DeviceResources {
+		if err := normalizeDeviceResourceDefaultValue(&p.DeviceResources[i]); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
+
+// MigrateResourceValueType migrates the ValueType of the device resource to the newType, e.g. from Int16 to Int32 after
+// upgrading the sensor. Only the widening of the numeric value types is allowed unless AllowValueTypeNarrowing is
+// enabled, and the Minimum, the Maximum and the DefaultValue are revalidated against the newType before persisting.
+func MigrateResourceValueType(profileName string, resourceName string, newType string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if profileName == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "profile name is empty", nil)
+	}
+	if resourceName == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "resource name is empty", nil)
+	}
+	valueType, e := common.NormalizeValueType(newType)
+	if e != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid valueType %s", newType), e)
+	}
+	if container.ConfigurationFrom(dic.Get).Writable.ProfileChange.StrictDeviceProfileChanges {
+		return errors.NewCommonEdgeX(errors.KindServiceLocked, "profile change is not allowed when StrictDeviceProfileChanges config is enabled", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	profile, err := dbClient.DeviceProfileByName(profileName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	original := cloneDeviceProfile(profile)
+
+	index := -1
+	for i := range profile.DeviceResources {
+		if profile.DeviceResources[i].Name == resourceName {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("resource %s not exists", resourceName), nil)
+	}
+
+	resource := &profile.DeviceResources[index]
+	if err = valueTypeMigrationValidation(resource.Name, resource.Properties.ValueType, valueType, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	resource.Properties.ValueType = valueType
+	properties := resource.Properties
+	if err = deviceResourceValuesValidation(resource.Name, valueType, properties.Minimum, properties.Maximum, properties.DefaultValue); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err = normalizeDeviceResourceDefaultValue(resource); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err = deviceResourceScalingValidation(*resource); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
+	if validateErr := profileDTO.Validate(); validateErr != nil {
+		return errors.NewCommonEdgeXWrapper(validateErr)
+	}
+	if err = dbClient.UpdateDeviceProfile(profile); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("DeviceProfile %s resource %s valueType migrated from %s to %s on DB successfully. Correlation-id: %s ",
+		profileName, resourceName, original.DeviceResources[index].Properties.ValueType, valueType, correlation.FromContext(ctx))
+	if err := notifyUpdateDeviceProfileSystemEvent(original, profileDTO, ctx, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValueTypeMigrationValidation(t *testing.T) {
+	dic := labelsTestDic(false, nil)
+
+	tests := []struct {
+		name      string
+		from      string
+		to        string
+		narrowing bool
+	}{
+		{"signed integer widening", common.ValueTypeInt16, common.ValueTypeInt32, false},
+		{"unsigned to wider signed integer", common.ValueTypeUint16, common.ValueTypeInt32, false},
+		{"integer to float", common.ValueTypeInt16, common.ValueTypeFloat32, false},
+		{"float widening", common.ValueTypeFloat32, common.ValueTypeFloat64, false},
+		{"array widening", common.ValueTypeInt16Array, common.ValueTypeInt64Array, false},
+		{"signed integer narrowing", common.ValueTypeInt32, common.ValueTypeInt16, true},
+		{"signed to unsigned integer", common.ValueTypeInt16, common.ValueTypeUint32, true},
+		{"unsigned to same size signed integer", common.ValueTypeUint16, common.ValueTypeInt16, true},
+		{"integer to float losing precision", common.ValueTypeInt32, common.ValueTypeFloat32, true},
+		{"float to integer", common.ValueTypeFloat32, common.ValueTypeInt64, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			container.ConfigurationFrom(dic.Get).Writable.ProfileChange.AllowValueTypeNarrowing = false
+			err := valueTypeMigrationValidation("resource", testCase.from, testCase.to, dic)
+			if !testCase.narrowing {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+			container.ConfigurationFrom(dic.Get).Writable.ProfileChange.AllowValueTypeNarrowing = true
+			assert.NoError(t, valueTypeMigrationValidation("resource", testCase.from, testCase.to, dic))
+		})
+	}
+
+	container.ConfigurationFrom(dic.Get).Writable.ProfileChange.AllowValueTypeNarrowing = true
+	for _, incompatible := range [][2]string{
+		{common.ValueTypeInt16, common.ValueTypeInt16},
+		{common.ValueTypeInt16, common.ValueTypeString},
+		{common.ValueTypeBool, common.ValueTypeInt8},
+		{common.ValueTypeInt16, common.ValueTypeInt32Array},
+	} {
+		err := valueTypeMigrationValidation("resource", incompatible[0], incompatible[1], dic)
+		require.Error(t, err, "%s to %s should be rejected", incompatible[0], incompatible[1])
+		assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	}
+}
+
+func TestDeviceResourceValuesValidation(t *testing.T) {
+	inRange := 100.0
+	outOfRange := 300.0
+	fraction := 1.5
+
+	assert.NoError(t, deviceResourceValuesValidation("resource", common.ValueTypeUint8, &inRange, &inRange, "255"))
+	assert.NoError(t, deviceResourceValuesValidation("resource", common.ValueTypeFloat32, &fraction, nil, "1.5"))
+	assert.NoError(t, deviceResourceValuesValidation("resource", common.ValueTypeString, &outOfRange, nil, "text"))
+	assert.Error(t, deviceResourceValuesValidation("resource", common.ValueTypeInt8, nil, &outOfRange, ""))
+	assert.Error(t, deviceResourceValuesValidation("resource", common.ValueTypeUint8Array, &outOfRange, nil, ""))
+	assert.Error(t, deviceResourceValuesValidation("resource", common.ValueTypeInt16, &fraction, nil, ""))
+	assert.Error(t, deviceResourceValuesValidation("resource", common.ValueTypeInt8, nil, nil, "300"))
+	assert.Error(t, deviceResourceValuesValidation("resource", common.ValueTypeUint16, nil, nil, "-1"))
+}
+
+func TestNormalizeDefaultValue(t *testing.T) {
+	tests := []struct {
+		name          string
+		valueType     string
+		defaultValue  string
+		expected      string
+		errorExpected bool
+	}{
+		{"empty", common.ValueTypeInt16, "", "", false},
+		{"bool capitalized", common.ValueTypeBool, "True", "true", false},
+		{"bool numeric", common.ValueTypeBool, "0", "false", false},
+		{"bool invalid", common.ValueTypeBool, "yes", "", true},
+		{"int with sign", common.ValueTypeInt16, "+5", "5", false},
+		{"int with spaces", common.ValueTypeInt32, " -12 ", "-12", false},
+		{"int with fraction", common.ValueTypeInt16, "1.0", "", true},
+		{"int out of range", common.ValueTypeInt8, "300", "", true},
+		{"uint negative", common.ValueTypeUint16, "-1", "", true},
+		{"uint with leading zeros", common.ValueTypeUint64, "007", "7", false},
+		{"float trailing zero", common.ValueTypeFloat32, "1.0", "1", false},
+		{"float exponent", common.ValueTypeFloat64, "2.5e3", "2500", false},
+		{"float32 precision", common.ValueTypeFloat32, "0.1", "0.1", false},
+		{"float invalid", common.ValueTypeFloat64, "abc", "", true},
+		{"string kept", common.ValueTypeString, " True ", " True ", false},
+		{"array kept", common.ValueTypeFloat32Array, "[1.0, 2.0]", "[1.0, 2.0]", false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			normalized, err := normalizeDefaultValue(testCase.valueType, testCase.defaultValue)
+			if testCase.errorExpected {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, normalized)
+		})
+	}
+}
+
+func TestNormalizeDeviceProfileDefaultValues(t *testing.T) {
+	profile := models.DeviceProfile{Name: "thermostat", DeviceResources: []models.DeviceResource{
+		{Name: "enabled", Properties: models.ResourceProperties{ValueType: common.ValueTypeBool, DefaultValue: "TRUE"}},
+		{Name: "temperature", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat64, DefaultValue: "21.50"}},
+	}}
+	require.NoError(t, normalizeDeviceProfileDefaultValues(&profile))
+	assert.Equal(t, "true", profile.DeviceResources[0].Properties.DefaultValue)
+	assert.Equal(t, "21.5", profile.DeviceResources[1].Properties.DefaultValue)
+
+	profile.DeviceResources[1].Properties.DefaultValue = "warm"
+	err := normalizeDeviceProfileDefaultValues(&profile)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	assert.Equal(t, "warm", profile.DeviceResources[1].Properties.DefaultValue, "the invalid defaultValue should be kept")
+}
+
+func TestMigrateResourceValueType(t *testing.T) {
+	maximum := 1000.0
+	profile := models.DeviceProfile{Name: "thermostat", DeviceResources: []models.DeviceResource{{
+		Name:       "temperature",
+		Properties: models.ResourceProperties{ValueType: common.ValueTypeInt16, ReadWrite: common.ReadWrite_R, Maximum: &maximum, DefaultValue: "1000"},
+	}}}
+	migrated := cloneDeviceProfile(profile)
+	migrated.DeviceResources[0].Properties.ValueType = common.ValueTypeInt32
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", profile.Name).Return(profile, nil)
+	dbClientMock.On("UpdateDeviceProfile", migrated).Return(nil)
+	dbClientMock.On("DeviceCountByProfileName", profile.Name).Return(uint32(0), nil)
+	dic := labelsTestDic(false, dbClientMock)
+	syncSystemEventPublish(dic)
+
+	err := MigrateResourceValueType(profile.Name, "temperature", "int32", context.Background(), dic)
+	require.NoError(t, err)
+	dbClientMock.AssertCalled(t, "UpdateDeviceProfile", migrated)
+
+	container.ConfigurationFrom(dic.Get).Writable.ProfileChange.AllowValueTypeNarrowing = true
+	err = MigrateResourceValueType(profile.Name, "temperature", common.ValueTypeInt8, context.Background(), dic)
+	require.Error(t, err, "the maximum and the default value are out of the Int8 range")
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	err = MigrateResourceValueType(profile.Name, "humidity", common.ValueTypeInt32, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+
+	err = MigrateResourceValueType(profile.Name, "temperature", "Int128", context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	dbClientMock.AssertNumberOfCalls(t, "UpdateDeviceProfile", 1)
+}
@@ -17,7 +17,14 @@
 package config
 
 import (
+	"fmt"
+	"path"
+	"slices"
+	"time"
+
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v4/config"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
 // Struct used to parse the JSON configuration file
@@ -29,6 +36,8 @@ type ConfigurationStruct struct {
 	Service    bootstrapConfig.ServiceInfo
 	MessageBus bootstrapConfig.MessageBusInfo
 	UoM        UoM
+	// ProtocolAttributes configures the validation of the device resource Attributes by the protocol
+	ProtocolAttributes ProtocolAttributes
 }
 
 type WritableInfo struct {
@@ -39,21 +48,237 @@ type WritableInfo struct {
 	Telemetry       bootstrapConfig.TelemetryInfo
 	MaxDevices      uint32
 	MaxResources    uint32
+	MaxCommands     uint32
+	SystemEvent     SystemEventInfo
+	// DefaultReadWrite maps the value types to the ReadWrite applied to the device resources which omit the ReadWrite,
+	// e.g. Object: R. The explicit ReadWrite of the device resources always wins.
+	DefaultReadWrite map[string]string
+	// NormalizeLabels trims, lowercases and de-duplicates the device profile labels on add and update, and the label
+	// query parameters of the device profile queries
+	NormalizeLabels bool
+	// InferResourceDefaults fills the numeric fields the numeric device resources omit from the dependent ones they
+	// declare on add and update, e.g. the critHigh from the warnHigh, and logs each inference. The explicit values
+	// always win.
+	InferResourceDefaults bool
+	// LogProfileUpdateDiff logs the difference between the stored and the updated device profile at the Debug level
+	// on each device profile update, keyed by the correlation id, to audit what the update actually changed
+	LogProfileUpdateDiff bool
+	// DefaultLabels are merged into the labels of the new device profiles, so every profile carries the fleet-wide
+	// labels, e.g. the environment label
+	DefaultLabels []string
+	// StrictDefaultLabels merges the DefaultLabels into the labels of the updated device profiles as well, so the
+	// removed default labels are added back on update
+	StrictDefaultLabels bool
+	// RequireEngineeringRange rejects the numeric device resources without both the Minimum and the Maximum on add and
+	// update
+	RequireEngineeringRange bool
+	// ResourceHistoryLimit is the maximum number of the recorded changes kept in memory for each device resource, the
+	// oldest changes are dropped beyond the limit. The history is not persisted and is lost on restart. 0 disables the
+	// device resource history.
+	ResourceHistoryLimit uint32
+	// MaxAttributeDepth is the maximum nesting depth of the Attributes of a device resource, where the top-level
+	// Attributes map is 1 level deep and every nested map or array adds a level. 0 disables the limitation.
+	MaxAttributeDepth uint32
+	// MaxAttributeKeys is the maximum number of the keys at all the levels of the Attributes of a device resource. 0
+	// disables the limitation.
+	MaxAttributeKeys uint32
+	// MinSampleInterval and MaxSampleInterval bound the sampleInterval optional property of the device resources, e.g.
+	// 10ms and 24h, so an implausible interval like 1ns is rejected. Empty disables the bound.
+	MinSampleInterval string
+	MaxSampleInterval string
+	// CalibrationMaxAge is the age of the calibration block of the device resources, e.g. 8760h, beyond which the
+	// calibration is reported as stale. Empty requires the age to be specified by the query.
+	CalibrationMaxAge string
+	// ProfileChangeNotifications sends the notifications through support-notifications when the device resources
+	// matching the rules are changed by the device profile updates
+	ProfileChangeNotifications ProfileChangeNotifications
+	// ProfileCache configures the in-memory read-through cache of the device profiles queried by name
+	ProfileCache ProfileCache
+}
+
+// ValidateDefaultReadWrite validates the value types and the ReadWrite values of the DefaultReadWrite mapping
+func (w WritableInfo) ValidateDefaultReadWrite() error {
+	for valueType, readWrite := range w.DefaultReadWrite {
+		if _, err := common.NormalizeValueType(valueType); err != nil {
+			return fmt.Errorf("invalid value type '%s' in DefaultReadWrite: %w", valueType, err)
+		}
+		if !slices.Contains(readWriteValues, readWrite) {
+			return fmt.Errorf("invalid ReadWrite '%s' of the value type '%s' in DefaultReadWrite, must be one of %v", readWrite, valueType, readWriteValues)
+		}
+	}
+	return nil
 }
 
+// ValidateSampleIntervalBounds validates the MinSampleInterval and the MaxSampleInterval are positive durations, and
+// the minimum is not greater than the maximum
+func (w WritableInfo) ValidateSampleIntervalBounds() error {
+	var bounds [2]time.Duration
+	for i, bound := range []string{w.MinSampleInterval, w.MaxSampleInterval} {
+		if bound == "" {
+			continue
+		}
+		duration, err := time.ParseDuration(bound)
+		if err != nil {
+			return fmt.Errorf("invalid sample interval bound '%s': %w", bound, err)
+		}
+		if duration <= 0 {
+			return fmt.Errorf("invalid sample interval bound '%s', must be positive", bound)
+		}
+		bounds[i] = duration
+	}
+	if bounds[0] > 0 && bounds[1] > 0 && bounds[0] > bounds[1] {
+		return fmt.Errorf("MinSampleInterval '%s' is greater than MaxSampleInterval '%s'", w.MinSampleInterval, w.MaxSampleInterval)
+	}
+	return nil
+}
+
+var readWriteValues = []string{common.ReadWrite_R, common.ReadWrite_W, common.ReadWrite_RW, common.ReadWrite_WR}
+
 type ProfileChange struct {
 	StrictDeviceProfileChanges bool
 	StrictDeviceProfileDeletes bool
+	// AllowValueTypeNarrowing allows migrating the device resource value type to a narrower numeric value type, e.g.
+	// Int32 to Int16, while only the widening is allowed by default
+	AllowValueTypeNarrowing bool
+	// StrictResourceRemoval rejects the device profile updates removing the device resources or device commands still
+	// referenced by the auto events of the devices on the profile, while the other profile changes are allowed
+	StrictResourceRemoval bool
+}
+
+type SystemEventInfo struct {
+	// FailOperationOnPublishError makes the Add/Update/Delete operations publish the system event synchronously and
+	// return the publish error, instead of publishing it asynchronously in best-effort
+	FailOperationOnPublishError bool
+	// IncludeProfileChanges adds the summary of the added, removed and modified device resources and device commands
+	// to the details of the device profile update system events
+	IncludeProfileChanges bool
+	// MinimizedPayloadEventTypes are the system event types, e.g. deviceprofile, whose details are minimized to the name,
+	// id, action, correlation id and content hash of the entity instead of the full DTO, so the events of the large
+	// entities stay under the message size limitation of the message bus
+	MinimizedPayloadEventTypes []string
+}
+
+// ValidateMinimizedPayloadEventTypes validates the system event types of the MinimizedPayloadEventTypes
+func (s SystemEventInfo) ValidateMinimizedPayloadEventTypes() error {
+	for _, eventType := range s.MinimizedPayloadEventTypes {
+		if !slices.Contains(systemEventTypes, eventType) {
+			return fmt.Errorf("invalid system event type '%s' in MinimizedPayloadEventTypes, must be one of %v", eventType, systemEventTypes)
+		}
+	}
+	return nil
+}
+
+// ProfileCache configures the read-through cache of the device profiles queried by name. The cached device profiles
+// are evicted on the device profile update and delete system events, including the events of the other instances.
+type ProfileCache struct {
+	// MaxSize is the maximum number of the cached device profiles, the least recently used device profile is evicted
+	// beyond it. 0 disables the cache.
+	MaxSize uint32
+	// TTL is the duration a cached device profile is served before being read from the database again, e.g. 1m. Empty
+	// keeps the device profiles until they are changed or evicted.
+	TTL string
+}
+
+// Validate validates the TTL
+func (p ProfileCache) Validate() error {
+	if p.TTL == "" {
+		return nil
+	}
+	ttl, err := time.ParseDuration(p.TTL)
+	if err != nil {
+		return fmt.Errorf("invalid TTL '%s': %w", p.TTL, err)
+	}
+	if ttl < 0 {
+		return fmt.Errorf("invalid TTL '%s', must not be negative", p.TTL)
+	}
+	return nil
 }
 
+// ProfileChangeNotifications maps the device profile and device resource name patterns to the notification categories.
+// The notification subscriptions of the categories receive the summary of the matching changed device resources.
+type ProfileChangeNotifications struct {
+	Enabled bool
+	// Severity is the severity of the notifications, NORMAL if empty
+	Severity string
+	Rules    []ProfileChangeNotificationRule
+}
+
+// ProfileChangeNotificationRule sends a notification of the Category when the device resources matching the
+// ResourcePattern of the device profiles matching the ProfilePattern are added, removed or modified. The patterns use
+// the path.Match syntax, and the empty pattern matches all the names.
+type ProfileChangeNotificationRule struct {
+	ProfilePattern  string
+	ResourcePattern string
+	Category        string
+	// Labels are the additional labels of the notifications
+	Labels []string
+}
+
+// Validate validates the severity, and the patterns and the categories of the rules
+func (p ProfileChangeNotifications) Validate() error {
+	if p.Severity != "" && !slices.Contains(notificationSeverities, p.Severity) {
+		return fmt.Errorf("invalid Severity '%s', must be one of %v", p.Severity, notificationSeverities)
+	}
+	for i, rule := range p.Rules {
+		if rule.Category == "" {
+			return fmt.Errorf("the Category of the rule %d is empty", i)
+		}
+		for _, pattern := range []string{rule.ProfilePattern, rule.ResourcePattern} {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("invalid pattern '%s' of the rule %d: %w", pattern, i, err)
+			}
+		}
+	}
+	return nil
+}
+
+var notificationSeverities = []string{models.Minor, models.Normal, models.Critical}
+
+var systemEventTypes = []string{common.DeviceSystemEventType, common.DeviceProfileSystemEventType, common.ProvisionWatcherSystemEventType, common.DeviceServiceSystemEventType}
+
 type WritableUoM struct {
 	Validation bool
+	// Mode is the strictness of the units Validation. The "reject" mode rejects the device profiles with the invalid
+	// units, the "warn" mode logs the invalid units and still allows the device profiles, e.g. the legacy profiles
+	// with a few non-standard units. Empty is the reject mode for backward compatibility.
+	Mode string
+	// PhysicalLimitsMode checks the Minimum and the Maximum of the device resources against the physical limits of
+	// their units in the units of measure, e.g. a temperature below the absolute zero. The "warn" mode logs the
+	// violations, the "reject" mode rejects the device profiles with them, and empty disables the check.
+	PhysicalLimitsMode string
+}
+
+const (
+	UoMModeWarn   = "warn"
+	UoMModeReject = "reject"
+)
+
+// ValidatePhysicalLimitsMode validates the PhysicalLimitsMode is empty, warn or reject
+func (u WritableUoM) ValidatePhysicalLimitsMode() error {
+	if u.PhysicalLimitsMode != "" && u.PhysicalLimitsMode != UoMModeWarn && u.PhysicalLimitsMode != UoMModeReject {
+		return fmt.Errorf("invalid UoM PhysicalLimitsMode '%s', must be empty, %s or %s", u.PhysicalLimitsMode, UoMModeWarn, UoMModeReject)
+	}
+	return nil
+}
+
+// ValidateMode validates the Mode is empty, warn or reject
+func (u WritableUoM) ValidateMode() error {
+	if u.Mode != "" && u.Mode != UoMModeWarn && u.Mode != UoMModeReject {
+		return fmt.Errorf("invalid UoM Mode '%s', must be empty, %s or %s", u.Mode, UoMModeWarn, UoMModeReject)
+	}
+	return nil
 }
 
 type UoM struct {
 	UoMFile string
 }
 
+type ProtocolAttributes struct {
+	// SchemaFile is the YAML file of the attribute schemas by the protocol, which supplement or replace the compiled-in
+	// schemas of the modbus, bacnet and opcua protocols. Empty uses the compiled-in schemas only.
+	SchemaFile string
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
@@ -0,0 +1,56 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+// Constants related to defined routes in the core metadata service APIs, which will be added to go-mod-core-contracts in the future
+const (
+	Virtual   = "virtual"
+	Nullable  = "nullable"
+	UniqueKey = "uniquekey"
+	Coalesce  = "coalescegroup"
+	Subset    = "subset"
+	Upsert    = "upsert"
+
+	EngineeringRange = "engineeringrange"
+	Missing          = "missing"
+	History          = "history"
+	ValueType        = "valuetype"
+	Units            = "units"
+	Fix              = "fix"
+	ResourceOrder    = "resourceorder"
+	Capacity         = "capacity"
+	Calibration      = "calibration"
+	Stale            = "stale"
+	MaxAge           = "maxAge"
+	Impact           = "impact"
+	Yaml             = "yaml"
+	Validate         = "validate"
+	NameContains     = "nameContains"
+	Clone            = "clone"
+	TargetName       = "targetName"
+
+	ApiVirtualDeviceResourcesByProfileNameRoute       = common.ApiDeviceResourceRoute + "/" + common.Profile + "/:" + common.ProfileName + "/" + Virtual
+	ApiNullableDeviceResourcesByProfileNameRoute      = common.ApiDeviceResourceRoute + "/" + common.Profile + "/:" + common.ProfileName + "/" + Nullable
+	ApiUniqueKeyDeviceResourcesByProfileNameRoute     = common.ApiDeviceResourceRoute + "/" + common.Profile + "/:" + common.ProfileName + "/" + UniqueKey
+	ApiDeviceResourcesByCoalesceGroupRoute            = common.ApiDeviceResourceRoute + "/" + common.Profile + "/:" + common.ProfileName + "/" + Coalesce
+	ApiDeviceProfileSubsetByNameRoute                 = common.ApiDeviceProfileByNameRoute + "/" + Subset
+	ApiDeviceProfileYamlByNameRoute                   = common.ApiDeviceProfileByNameRoute + "/" + Yaml
+	ApiDeviceProfileCloneByNameRoute                  = common.ApiDeviceProfileByNameRoute + "/" + Clone + "/:" + TargetName
+	ApiDeviceProfileUpsertRoute                       = common.ApiDeviceProfileRoute + "/" + Upsert
+	ApiDeviceProfileValidateRoute                     = common.ApiDeviceProfileRoute + "/" + Validate
+	ApiMissingEngineeringRangeDeviceResourcesRoute    = common.ApiDeviceResourceRoute + "/" + EngineeringRange + "/" + Missing
+	ApiDeviceResourceHistoryByProfileAndResourceRoute = common.ApiDeviceResourceByProfileAndResourceRoute + "/" + History
+	ApiDeviceProfileUnitsFixRoute                     = common.ApiDeviceProfileRoute + "/" + Units + "/" + Fix
+	ApiDeviceProfileResourceOrderByNameRoute          = common.ApiDeviceProfileByNameRoute + "/" + ResourceOrder
+	ApiDeviceProfileCapacityByNameRoute               = common.ApiDeviceProfileByNameRoute + "/" + Capacity
+	ApiDeviceResourceValueTypeRoute                   = common.ApiDeviceResourceByProfileAndResourceRoute + "/" + ValueType + "/:" + common.ValueType
+	ApiStaleCalibrationDeviceResourcesRoute           = common.ApiDeviceResourceRoute + "/" + Calibration + "/" + Stale
+	ApiDeviceResourceImpactByNameRoute                = common.ApiDeviceResourceRoute + "/" + Impact + "/" + common.Name + "/:" + common.ResourceName
+)
@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+)
+
+// DeviceProfileCacheName contains the name of the metadata's utils.DeviceProfileCache implementation in the DIC.
+var DeviceProfileCacheName = di.TypeInstanceToName((*utils.DeviceProfileCache)(nil))
+
+// DeviceProfileCacheFrom helper function queries the DIC and returns metadata's utils.DeviceProfileCache implementation.
+func DeviceProfileCacheFrom(get di.Get) *utils.DeviceProfileCache {
+	return get(DeviceProfileCacheName).(*utils.DeviceProfileCache)
+}
@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
+)
+
+// ProtocolAttributesInterfaceName contains the name of the interfaces.ProtocolAttributes implementation in the DIC.
+var ProtocolAttributesInterfaceName = di.TypeInstanceToName((*interfaces.ProtocolAttributes)(nil))
+
+// ProtocolAttributesFrom helper function queries the DIC and returns the interfaces.ProtocolAttributes implementation,
+// or nil if the protocol attribute validation is not bootstrapped.
+func ProtocolAttributesFrom(get di.Get) interfaces.ProtocolAttributes {
+	validators, ok := get(ProtocolAttributesInterfaceName).(interfaces.ProtocolAttributes)
+	if !ok {
+		return nil
+	}
+	return validators
+}
@@ -0,0 +1,21 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+)
+
+// DeviceResourceHistoryName contains the name of the metadata's utils.DeviceResourceHistory implementation in the DIC.
+var DeviceResourceHistoryName = di.TypeInstanceToName((*utils.DeviceResourceHistory)(nil))
+
+// DeviceResourceHistoryFrom helper function queries the DIC and returns metadata's utils.DeviceResourceHistory
+// implementation.
+func DeviceResourceHistoryFrom(get di.Get) *utils.DeviceResourceHistory {
+	return get(DeviceResourceHistoryName).(*utils.DeviceResourceHistory)
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
 	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
@@ -29,7 +30,13 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
-const yamlFileName = "file"
+const (
+	yamlFileName              = "file"
+	includeUnitMetaQueryParam = "includeUnitMeta" // query param to specify whether to tag the resource units with the unit system
+	groupQueryParam           = "group"           // query param to specify the group of the exported device profile subset
+	onConflictQueryParam      = "onConflict"      // query param to specify the strategy to resolve the conflict of the imported device profiles
+	dryRunQueryParam          = "dryRun"          // query param to specify whether to only report the changes without persisting them
+)
 
 type DeviceProfileController struct {
 	jsonDtoReader io.DtoReader
@@ -40,8 +47,8 @@ type DeviceProfileController struct {
 // NewDeviceProfileController creates and initializes an DeviceProfileController
 func NewDeviceProfileController(dic *di.Container) *DeviceProfileController {
 	return &DeviceProfileController{
-		jsonDtoReader: io.NewJsonDtoReader(),
-		yamlDtoReader: io.NewYamlDtoReader(),
+		jsonDtoReader: newJsonDefaultReadWriteReader(dic),
+		yamlDtoReader: newYamlDefaultReadWriteReader(dic),
 		dic:           dic,
 	}
 }
@@ -65,6 +72,26 @@ func (dc *DeviceProfileController) AddDeviceProfile(c echo.Context) error {
 	}
 	deviceProfiles := requestDTO.DeviceProfileReqToDeviceProfileModels(reqDTOs)
 
+	if onConflict := utils.ParseQueryStringToString(r, onConflictQueryParam, ""); onConflict != "" {
+		if err = application.ValidateConflictStrategy(onConflict); err != nil {
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+		var importResponses []interface{}
+		for i, d := range deviceProfiles {
+			reqId := reqDTOs[i].RequestId
+			result, err := application.ImportDeviceProfile(d, onConflict, ctx, dc.dic)
+			if err != nil {
+				lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+				lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+				importResponses = append(importResponses, commonDTO.NewBaseResponse(reqId, err.Message(), err.Code()))
+				continue
+			}
+			importResponses = append(importResponses, newDeviceProfileImportResponse(reqId, result))
+		}
+		utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+		return pkg.EncodeAndWriteResponse(importResponses, w, lc)
+	}
+
 	var addResponses []interface{}
 	for i, d := range deviceProfiles {
 		var addDeviceProfileResponse interface{}
@@ -91,6 +118,72 @@ func (dc *DeviceProfileController) AddDeviceProfile(c echo.Context) error {
 	return pkg.EncodeAndWriteResponse(addResponses, w, lc)
 }
 
+// DeviceProfileImportResponse defines the response of a device profile imported with the onConflict strategy
+type DeviceProfileImportResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	application.DeviceProfileImportResult
+}
+
+// newDeviceProfileImportResponse returns the response of the imported device profile. The response of an added profile
+// has the 201 status code, and the response of a skipped or overwritten profile has the 200 status code.
+func newDeviceProfileImportResponse(reqId string, result application.DeviceProfileImportResult) DeviceProfileImportResponse {
+	statusCode := http.StatusOK
+	if result.Created {
+		statusCode = http.StatusCreated
+	}
+	return DeviceProfileImportResponse{
+		BaseResponse:              commonDTO.NewBaseResponse(reqId, "", statusCode),
+		DeviceProfileImportResult: result,
+	}
+}
+
+// DeviceProfileValidationResponse defines the response of the dry-run validation of a device profile
+type DeviceProfileValidationResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	application.DeviceProfileValidationResult
+}
+
+// ValidateDeviceProfile runs the server-side checks of the device profile addition on the device profiles without
+// adding them, and reports every failed check of each profile
+func (dc *DeviceProfileController) ValidateDeviceProfile(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var reqDTOs []requestDTO.DeviceProfileRequest
+	err := dc.jsonDtoReader.Read(r.Body, &reqDTOs)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	deviceProfiles := requestDTO.DeviceProfileReqToDeviceProfileModels(reqDTOs)
+
+	var responses []interface{}
+	for i, d := range deviceProfiles {
+		reqId := reqDTOs[i].RequestId
+		result, err := application.ValidateDeviceProfile(d, ctx, dc.dic)
+		if err != nil {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			responses = append(responses, commonDTO.NewBaseResponse(reqId, err.Message(), err.Code()))
+			continue
+		}
+		responses = append(responses, DeviceProfileValidationResponse{
+			BaseResponse:                  commonDTO.NewBaseResponse(reqId, "", http.StatusOK),
+			DeviceProfileValidationResult: result,
+		})
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	return pkg.EncodeAndWriteResponse(responses, w, lc)
+}
+
 func (dc *DeviceProfileController) UpdateDeviceProfile(c echo.Context) error {
 	r := c.Request()
 	w := c.Response()
@@ -140,6 +233,58 @@ func (dc *DeviceProfileController) UpdateDeviceProfile(c echo.Context) error {
 	return pkg.EncodeAndWriteResponse(responses, w, lc)
 }
 
+// UpsertDeviceProfile creates the device profiles with the new names and updates the existing ones. The response of a
+// created profile has the 201 status code with the id, and the response of an updated profile has the 200 status code.
+func (dc *DeviceProfileController) UpsertDeviceProfile(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var reqDTOs []requestDTO.DeviceProfileRequest
+	err := dc.jsonDtoReader.Read(r.Body, &reqDTOs)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	deviceProfiles := requestDTO.DeviceProfileReqToDeviceProfileModels(reqDTOs)
+
+	var responses []interface{}
+	for i, d := range deviceProfiles {
+		var response interface{}
+		reqId := reqDTOs[i].RequestId
+		newId, created, err := application.UpsertDeviceProfile(d, ctx, dc.dic)
+		if err != nil {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			response = commonDTO.NewBaseResponse(
+				reqId,
+				err.Message(),
+				err.Code())
+		} else if created {
+			response = commonDTO.NewBaseWithIdResponse(
+				reqId,
+				"",
+				http.StatusCreated,
+				newId)
+		} else {
+			response = commonDTO.NewBaseResponse(
+				reqId,
+				"",
+				http.StatusOK)
+		}
+		responses = append(responses, response)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	return pkg.EncodeAndWriteResponse(responses, w, lc)
+}
+
 func (dc *DeviceProfileController) AddDeviceProfileByYaml(c echo.Context) error {
 	r := c.Request()
 	w := c.Response()
@@ -164,6 +309,19 @@ func (dc *DeviceProfileController) AddDeviceProfileByYaml(c echo.Context) error
 	}
 	deviceProfile := dtos.ToDeviceProfileModel(deviceProfileDTO)
 
+	if onConflict := utils.ParseQueryStringToString(r, onConflictQueryParam, ""); onConflict != "" {
+		if err = application.ValidateConflictStrategy(onConflict); err != nil {
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+		result, err := application.ImportDeviceProfile(deviceProfile, onConflict, ctx, dc.dic)
+		if err != nil {
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+		response := newDeviceProfileImportResponse("", result)
+		utils.WriteHttpHeader(w, ctx, response.StatusCode)
+		return pkg.EncodeAndWriteResponse(response, w, lc)
+	}
+
 	newId, err := application.AddDeviceProfile(deviceProfile, ctx, dc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -227,12 +385,88 @@ func (dc *DeviceProfileController) DeviceProfileByName(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	// parse URL query string for includeUnitMeta
+	if utils.ParseQueryStringToString(r, includeUnitMetaQueryParam, common.ValueFalse) == common.ValueTrue {
+		application.TagDeviceProfileUnitMeta(&deviceProfile, dc.dic)
+	}
 
 	response := responseDTO.NewDeviceProfileResponse("", "", http.StatusOK, deviceProfile)
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	return pkg.EncodeAndWriteResponse(response, w, lc) // encode and send out the response
 }
 
+// DeviceProfileSubsetYaml exports the subset of the device profile in the group given by the group query parameter in YAML
+func (dc *DeviceProfileController) DeviceProfileSubsetYaml(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+	group := utils.ParseQueryStringToString(r, groupQueryParam, "")
+
+	data, err := application.DeviceProfileSubsetYaml(name, group, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	w.Header().Set(common.CorrelationHeader, correlation.FromContext(ctx))
+	w.Header().Set(common.ContentType, common.ContentTypeYAML)
+	w.WriteHeader(http.StatusOK)
+	_, e := w.Write(data)
+	if e != nil {
+		lc.Errorf("failed to write the device profile subset: %v", e)
+	}
+	return nil
+}
+
+// DeviceProfileInYAMLByName exports the device profile in the YAML accepted by the device profile upload
+func (dc *DeviceProfileController) DeviceProfileInYAMLByName(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	data, err := application.DeviceProfileInYAMLByName(name, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	w.Header().Set(common.CorrelationHeader, correlation.FromContext(ctx))
+	w.Header().Set(common.ContentType, common.ContentTypeYAML)
+	w.WriteHeader(http.StatusOK)
+	_, e := w.Write(data)
+	if e != nil {
+		lc.Errorf("failed to write the device profile YAML: %v", e)
+	}
+	return nil
+}
+
+// CloneDeviceProfile adds a copy of the device profile under the target name
+func (dc *DeviceProfileController) CloneDeviceProfile(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+	targetName := c.Param(constants.TargetName)
+
+	id, err := application.CloneDeviceProfile(name, targetName, ctx, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseWithIdResponse("", "", http.StatusCreated, id)
+	utils.WriteHttpHeader(w, ctx, http.StatusCreated)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
 func (dc *DeviceProfileController) DeleteDeviceProfileByName(c echo.Context) error {
 	lc := container.LoggingClientFrom(dc.dic.Get)
 	r := c.Request()
@@ -259,12 +493,13 @@ func (dc *DeviceProfileController) AllDeviceProfiles(c echo.Context) error {
 	ctx := r.Context()
 	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
 
-	// parse URL query string for offset, limit, and labels
+	// parse URL query string for offset, limit, labels, and nameContains
 	offset, limit, labels, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
-	deviceProfiles, totalCount, err := application.AllDeviceProfiles(offset, limit, labels, dc.dic)
+	nameContains := utils.ParseQueryStringToString(r, constants.NameContains, "")
+	deviceProfiles, totalCount, err := application.AllDeviceProfiles(offset, limit, labels, nameContains, dc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
@@ -383,6 +618,13 @@ func (dc *DeviceProfileController) PatchDeviceProfileBasicInfo(c echo.Context) e
 	return pkg.EncodeAndWriteResponse(updateResponses, w, lc)
 }
 
+// MultiDeviceProfileBasicInfosResponse defines the Response Content for GET multiple device profile basic infos, which
+// include the numbers of the device resources and device commands of each profile
+type MultiDeviceProfileBasicInfosResponse struct {
+	commonDTO.BaseWithTotalCountResponse `json:",inline"`
+	Profiles                             []application.DeviceProfileBasicInfoWithCounts `json:"profiles"`
+}
+
 func (dc *DeviceProfileController) AllDeviceProfileBasicInfos(c echo.Context) error {
 	lc := container.LoggingClientFrom(dc.dic.Get)
 	r := c.Request()
@@ -400,7 +642,68 @@ func (dc *DeviceProfileController) AllDeviceProfileBasicInfos(c echo.Context) er
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
 
-	response := responseDTO.NewMultiDeviceProfileBasicInfosResponse("", "", http.StatusOK, totalCount, deviceProfileBasicInfos)
+	response := MultiDeviceProfileBasicInfosResponse{
+		BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, totalCount),
+		Profiles:                   deviceProfileBasicInfos,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// UnitsFixResponse defines the response of the device profile units fix
+type UnitsFixResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	DryRun                 bool                       `json:"dryRun"`
+	Report                 application.UnitsFixReport `json:"report"`
+}
+
+// FixProfileUnits corrects the invalid units of the stored device profiles with the unit aliases of the units of measure
+func (dc *DeviceProfileController) FixProfileUnits(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	dryRun := utils.ParseQueryStringToString(r, dryRunQueryParam, common.ValueFalse) == common.ValueTrue
+
+	report, err := application.FixProfileUnits(dryRun, ctx, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := UnitsFixResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		DryRun:       dryRun,
+		Report:       report,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// ResourceCapacityResponse defines the response of the device profile resource capacity
+type ResourceCapacityResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Capacity               application.ResourceCapacity `json:"capacity"`
+}
+
+// ProfileResourceCapacity returns the resource capacity of the device profile against the MaxResources limitation
+func (dc *DeviceProfileController) ProfileResourceCapacity(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	name := c.Param(common.Name)
+
+	capacity, err := application.ProfileResourceCapacity(name, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := ResourceCapacityResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		Capacity:     capacity,
+	}
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
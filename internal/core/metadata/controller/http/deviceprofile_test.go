@@ -31,9 +31,12 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataUtils "github.com/edgexfoundry/edgex-go/internal/core/metadata/utils"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -146,6 +149,12 @@ func mockDic() *di.Container {
 		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
 			return logger.NewMockClient()
 		},
+		container.DeviceProfileCacheName: func(get di.Get) interface{} {
+			return metadataUtils.NewDeviceProfileCache()
+		},
+		container.DeviceResourceHistoryName: func(get di.Get) interface{} {
+			return metadataUtils.NewDeviceResourceHistory()
+		},
 	})
 }
 
@@ -1106,6 +1115,256 @@ func TestDeviceProfileByName(t *testing.T) {
 	}
 }
 
+func TestDeviceProfileSubsetYaml(t *testing.T) {
+	deviceProfile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	deviceProfile.DeviceCommands[0].Tags = map[string]any{application.GroupTagKey: "partner"}
+	notFoundName := "notFoundName"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", deviceProfile.Name).Return(deviceProfile, nil)
+	dbClientMock.On("DeviceProfileByName", notFoundName).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceProfileController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		deviceProfileName  string
+		group              string
+		expectedStatusCode int
+	}{
+		{"Valid - export the group subset", deviceProfile.Name, "partner", http.StatusOK},
+		{"Invalid - empty subset", deviceProfile.Name, "unknown", http.StatusBadRequest},
+		{"Invalid - group is empty", deviceProfile.Name, "", http.StatusBadRequest},
+		{"Invalid - device profile not found by name", notFoundName, "partner", http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiDeviceProfileSubsetByNameRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(groupQueryParam, testCase.group)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.deviceProfileName)
+			err = controller.DeviceProfileSubsetYaml(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			assert.Equal(t, common.ContentTypeYAML, recorder.Header().Get(common.ContentType))
+			var subset dtos.DeviceProfile
+			err = yaml.Unmarshal(recorder.Body.Bytes(), &subset)
+			require.NoError(t, err)
+			assert.Equal(t, deviceProfile.Name, subset.Name)
+			require.Len(t, subset.DeviceCommands, 1)
+			require.Len(t, subset.DeviceResources, 1)
+			assert.Equal(t, TestDeviceResourceName, subset.DeviceResources[0].Name)
+		})
+	}
+}
+
+func TestDeviceProfileInYAMLByName(t *testing.T) {
+	deviceProfile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	notFoundName := "notFoundName"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", deviceProfile.Name).Return(deviceProfile, nil)
+	dbClientMock.On("DeviceProfileByName", notFoundName).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceProfileController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		deviceProfileName  string
+		expectedStatusCode int
+	}{
+		{"Valid - export the device profile", deviceProfile.Name, http.StatusOK},
+		{"Invalid - device profile not found by name", notFoundName, http.StatusNotFound},
+		{"Invalid - name is empty", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiDeviceProfileYamlByNameRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.deviceProfileName)
+			err = controller.DeviceProfileInYAMLByName(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			assert.Equal(t, common.ContentTypeYAML, recorder.Header().Get(common.ContentType))
+			var exported dtos.DeviceProfile
+			err = yaml.Unmarshal(recorder.Body.Bytes(), &exported)
+			require.NoError(t, err)
+			assert.Equal(t, deviceProfile.Name, exported.Name)
+			assert.Len(t, exported.DeviceResources, len(deviceProfile.DeviceResources))
+			assert.Len(t, exported.DeviceCommands, len(deviceProfile.DeviceCommands))
+		})
+	}
+}
+
+func TestCloneDeviceProfile(t *testing.T) {
+	deviceProfile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	cloneName := "clonedProfile"
+	existingName := "existingProfile"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileNameExists", cloneName).Return(false, nil)
+	dbClientMock.On("DeviceProfileNameExists", existingName).Return(true, nil)
+	dbClientMock.On("DeviceProfileByName", deviceProfile.Name).Return(deviceProfile, nil)
+	dbClientMock.On("AddDeviceProfile", mock.Anything).Return(func(p models.DeviceProfile) (models.DeviceProfile, errors.EdgeX) {
+		p.Id = ExampleUUID
+		return p, nil
+	})
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceProfileController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		targetName         string
+		expectedStatusCode int
+	}{
+		{"Valid - clone the device profile", cloneName, http.StatusCreated},
+		{"Invalid - target name exists", existingName, http.StatusConflict},
+		{"Invalid - target name is empty", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodPost, constants.ApiDeviceProfileCloneByNameRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, constants.TargetName)
+			c.SetParamValues(deviceProfile.Name, testCase.targetName)
+			err = controller.CloneDeviceProfile(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res commonDTO.BaseWithIdResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusCreated {
+				assert.Equal(t, ExampleUUID, res.Id)
+			} else {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
+
+func TestDeviceProfileByName_IncludeUnitMeta(t *testing.T) {
+	deviceProfile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	deviceProfile.DeviceResources[1].Properties.Units = "unknown"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", deviceProfile.Name).Return(deviceProfile, nil)
+	uomMock := &mocks.UnitsOfMeasure{}
+	uomMock.On("Lookup", TestUnits).Return("temperature", "metric", true)
+	uomMock.On("Lookup", "unknown").Return("", "", false)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		container.UnitsOfMeasureInterfaceName: func(get di.Get) interface{} {
+			return uomMock
+		},
+	})
+
+	controller := NewDeviceProfileController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name            string
+		includeUnitMeta string
+	}{
+		{"Valid - include unit meta", common.ValueTrue},
+		{"Valid - exclude unit meta", common.ValueFalse},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			reqPath := fmt.Sprintf("%s/%s/%s", common.ApiDeviceProfileRoute, common.Name, deviceProfile.Name)
+			req, err := http.NewRequest(http.MethodGet, reqPath, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(includeUnitMetaQueryParam, testCase.includeUnitMeta)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(deviceProfile.Name)
+			err = controller.DeviceProfileByName(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res responseDTO.DeviceProfileResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+			require.Len(t, res.Profile.DeviceResources, 2)
+			knownUnitOptional := res.Profile.DeviceResources[0].Properties.Optional
+			unknownUnitOptional := res.Profile.DeviceResources[1].Properties.Optional
+			if testCase.includeUnitMeta == common.ValueTrue {
+				assert.Equal(t, map[string]any{"dimension": "temperature", "system": "metric"}, knownUnitOptional[application.UnitMetaKey])
+				unitMeta, ok := unknownUnitOptional[application.UnitMetaKey]
+				assert.True(t, ok, "unit meta should be tagged for the unit not in the registry")
+				assert.Nil(t, unitMeta)
+			} else {
+				assert.NotContains(t, knownUnitOptional, application.UnitMetaKey)
+				assert.NotContains(t, unknownUnitOptional, application.UnitMetaKey)
+			}
+			// the unit meta should not be stored to the profile
+			assert.NotContains(t, deviceProfile.DeviceResources[0].Properties.Optional, application.UnitMetaKey)
+		})
+	}
+}
+
 func TestDeleteDeviceProfileByName(t *testing.T) {
 	deviceProfile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
 	noName := ""
@@ -1297,6 +1556,69 @@ func TestAllDeviceProfiles(t *testing.T) {
 	}
 }
 
+func TestAllDeviceProfiles_NameContains(t *testing.T) {
+	thermostat := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	thermostat.Name = "thermostat-a"
+	other := thermostat
+	other.Name = "thermostat-b"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	// the whole table has more profiles than the name filter matches
+	dbClientMock.On("DeviceProfileCountByLabels", []string(nil)).Return(uint32(10), nil)
+	dbClientMock.On("DeviceProfileCountByNameContains", "thermo", []string(nil)).Return(uint32(2), nil)
+	dbClientMock.On("DeviceProfileCountByNameContains", "thermo", testDeviceProfileLabels).Return(uint32(1), nil)
+	dbClientMock.On("DeviceProfilesByNameContains", 0, 10, "thermo", []string(nil)).Return([]models.DeviceProfile{thermostat, other}, nil)
+	dbClientMock.On("DeviceProfilesByNameContains", 0, 10, "thermo", testDeviceProfileLabels).Return([]models.DeviceProfile{thermostat}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceProfileController(dic)
+
+	tests := []struct {
+		name               string
+		labels             string
+		expectedNames      []string
+		expectedTotalCount uint32
+	}{
+		{"name contains", "", []string{"thermostat-a", "thermostat-b"}, 2},
+		{"name contains with labels", strings.Join(testDeviceProfileLabels, ","), []string{"thermostat-a"}, 1},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, common.ApiAllDeviceProfileRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Offset, "0")
+			query.Add(common.Limit, "10")
+			query.Add(constants.NameContains, "thermo")
+			if len(testCase.labels) > 0 {
+				query.Add(common.Labels, testCase.labels)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.AllDeviceProfiles(c)
+			require.NoError(t, err)
+
+			var res responseDTO.MultiDeviceProfilesResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+			assert.Equal(t, testCase.expectedTotalCount, res.TotalCount, "the total count should be the count of the filtered profiles")
+			var names []string
+			for _, profile := range res.Profiles {
+				names = append(names, profile.Name)
+			}
+			assert.Equal(t, testCase.expectedNames, names)
+		})
+	}
+	dbClientMock.AssertNotCalled(t, "DeviceProfileCountByLabels", mock.Anything)
+}
+
 func TestDeviceProfilesByModel(t *testing.T) {
 	deviceProfile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
 	deviceProfiles := []models.DeviceProfile{deviceProfile, deviceProfile, deviceProfile}
@@ -1605,3 +1927,257 @@ func TestAllDeviceProfileBasicInfos(t *testing.T) {
 		})
 	}
 }
+
+func TestAllDeviceProfileBasicInfos_Counts(t *testing.T) {
+	deviceProfile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	empty := models.DeviceProfile{Name: "empty"}
+	expectedTotalProfileCount := uint32(5)
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileCountByLabels", []string(nil)).Return(expectedTotalProfileCount, nil)
+	dbClientMock.On("AllDeviceProfiles", 0, 2, []string(nil)).Return([]models.DeviceProfile{deviceProfile, empty}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceProfileController(dic)
+
+	e := echo.New()
+	req, err := http.NewRequest(http.MethodGet, common.ApiAllDeviceProfileBasicInfoRoute, http.NoBody)
+	require.NoError(t, err)
+	query := req.URL.Query()
+	query.Add(common.Offset, "0")
+	query.Add(common.Limit, "2")
+	req.URL.RawQuery = query.Encode()
+
+	recorder := httptest.NewRecorder()
+	c := e.NewContext(req, recorder)
+	err = controller.AllDeviceProfileBasicInfos(c)
+	require.NoError(t, err)
+
+	var res MultiDeviceProfileBasicInfosResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+	assert.Equal(t, expectedTotalProfileCount, res.TotalCount, "the total count should still be the count of all the profiles")
+	require.Len(t, res.Profiles, 2)
+	assert.Equal(t, deviceProfile.Name, res.Profiles[0].Name)
+	assert.Equal(t, len(deviceProfile.DeviceResources), res.Profiles[0].ResourceCount)
+	assert.Equal(t, len(deviceProfile.DeviceCommands), res.Profiles[0].CommandCount)
+	assert.Zero(t, res.Profiles[1].ResourceCount)
+	assert.Zero(t, res.Profiles[1].CommandCount)
+
+	// the counts are added to the basic infos, which still decode as the basic info DTOs
+	var basicInfos responseDTO.MultiDeviceProfileBasicInfoResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &basicInfos))
+	assert.Equal(t, deviceProfile.Manufacturer, basicInfos.Profiles[0].Manufacturer)
+}
+
+func TestUpsertDeviceProfile(t *testing.T) {
+	newProfile := buildTestDeviceProfileRequest()
+	newProfile.Profile.Name = "newProfile"
+	existingProfile := buildTestDeviceProfileRequest()
+	existingProfile.Profile.Name = "existingProfile"
+	racingProfile := buildTestDeviceProfileRequest()
+	racingProfile.Profile.Name = "racingProfile"
+	newModel := requests.DeviceProfileReqToDeviceProfileModel(newProfile)
+	existingModel := requests.DeviceProfileReqToDeviceProfileModel(existingProfile)
+	racingModel := requests.DeviceProfileReqToDeviceProfileModel(racingProfile)
+	createdModel := newModel
+	createdModel.Id = ExampleUUID
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileNameExists", newModel.Name).Return(false, nil)
+	dbClientMock.On("DeviceProfileNameExists", existingModel.Name).Return(true, nil)
+	// the racing profile is created by another upsert after the existence check
+	dbClientMock.On("DeviceProfileNameExists", racingModel.Name).Return(false, nil)
+	dbClientMock.On("AddDeviceProfile", newModel).Return(createdModel, nil)
+	dbClientMock.On("AddDeviceProfile", racingModel).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindDuplicateName, "device profile name racingProfile already exists", nil))
+	for _, m := range []models.DeviceProfile{existingModel, racingModel} {
+		dbClientMock.On("UpdateDeviceProfile", m).Return(nil)
+		dbClientMock.On("DeviceProfileByName", m.Name).Return(m, nil)
+		dbClientMock.On("DeviceCountByProfileName", m.Name).Return(uint32(0), nil)
+	}
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceProfileController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		request            requests.DeviceProfileRequest
+		expectedStatusCode int
+		expectedId         string
+	}{
+		{"Valid - create the new profile", newProfile, http.StatusCreated, ExampleUUID},
+		{"Valid - update the existing profile", existingProfile, http.StatusOK, ""},
+		{"Valid - update the profile created concurrently", racingProfile, http.StatusOK, ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			jsonData, err := json.Marshal([]requests.DeviceProfileRequest{testCase.request})
+			require.NoError(t, err)
+
+			reader := strings.NewReader(string(jsonData))
+			req, err := http.NewRequest(http.MethodPut, constants.ApiDeviceProfileUpsertRoute, reader)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.UpsertDeviceProfile(c)
+			require.NoError(t, err)
+
+			var res []commonDTO.BaseWithIdResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res[0].StatusCode, "BaseResponse status code not as expected")
+			assert.Equal(t, testCase.expectedId, res[0].Id)
+			assert.Empty(t, res[0].Message, "Message should be empty when it is successful")
+		})
+	}
+	dbClientMock.AssertCalled(t, "UpdateDeviceProfile", racingModel)
+	dbClientMock.AssertNotCalled(t, "UpdateDeviceProfile", newModel)
+}
+
+func TestValidateDeviceProfile(t *testing.T) {
+	newProfile := buildTestDeviceProfileRequest()
+	newProfile.Profile.Name = "newProfile"
+	existingProfile := buildTestDeviceProfileRequest()
+	existingProfile.Profile.Name = "existingProfile"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileNameExists", newProfile.Profile.Name).Return(false, nil)
+	dbClientMock.On("DeviceProfileNameExists", existingProfile.Profile.Name).Return(true, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceProfileController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name           string
+		request        requests.DeviceProfileRequest
+		expectedValid  bool
+		expectedChecks []string
+	}{
+		{"Valid - the profile passes all the checks", newProfile, true, nil},
+		{"Valid - the duplicated name is reported", existingProfile, false, []string{"duplicateName"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			jsonData, err := json.Marshal([]requests.DeviceProfileRequest{testCase.request})
+			require.NoError(t, err)
+
+			reader := strings.NewReader(string(jsonData))
+			req, err := http.NewRequest(http.MethodPost, constants.ApiDeviceProfileValidateRoute, reader)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.ValidateDeviceProfile(c)
+			require.NoError(t, err)
+
+			var res []DeviceProfileValidationResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+			require.Len(t, res, 1)
+			assert.Equal(t, http.StatusOK, res[0].StatusCode, "BaseResponse status code not as expected")
+			assert.Equal(t, testCase.expectedValid, res[0].Valid)
+			var checks []string
+			for _, failure := range res[0].Failures {
+				checks = append(checks, failure.Check)
+			}
+			assert.Equal(t, testCase.expectedChecks, checks)
+		})
+	}
+	dbClientMock.AssertNotCalled(t, "AddDeviceProfile", mock.Anything)
+}
+
+func TestAddDeviceProfile_OnConflict(t *testing.T) {
+	existingProfile := buildTestDeviceProfileRequest()
+	existingModel := requests.DeviceProfileReqToDeviceProfileModel(existingProfile)
+	renamedModel := existingModel
+	renamedModel.Id = ""
+	renamedModel.Name = existingModel.Name + "-1"
+	createdModel := renamedModel
+	createdModel.Id = ExampleUUID
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileNameExists", existingModel.Name).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", renamedModel.Name).Return(false, nil)
+	dbClientMock.On("AddDeviceProfile", renamedModel).Return(createdModel, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceProfileController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		onConflict         string
+		expectedStatusCode int
+		expectedResult     application.DeviceProfileImportResult
+	}{
+		{"Valid - skip the existing profile", application.ConflictStrategySkip, http.StatusOK,
+			application.DeviceProfileImportResult{Name: existingModel.Name, AppliedStrategy: application.ConflictStrategySkip}},
+		{"Valid - rename the imported profile", application.ConflictStrategyRename, http.StatusCreated,
+			application.DeviceProfileImportResult{Id: ExampleUUID, Name: renamedModel.Name, Created: true, AppliedStrategy: application.ConflictStrategyRename}},
+		{"Invalid - unknown strategy", "merge", http.StatusBadRequest, application.DeviceProfileImportResult{}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			jsonData, err := json.Marshal([]requests.DeviceProfileRequest{existingProfile})
+			require.NoError(t, err)
+
+			reader := strings.NewReader(string(jsonData))
+			req, err := http.NewRequest(http.MethodPost, common.ApiDeviceProfileRoute, reader)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(onConflictQueryParam, testCase.onConflict)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.AddDeviceProfile(c)
+			require.NoError(t, err)
+
+			// Assert
+			if testCase.expectedStatusCode == http.StatusBadRequest {
+				assert.Equal(t, http.StatusBadRequest, recorder.Result().StatusCode, "HTTP status code not as expected")
+				return
+			}
+			var res []DeviceProfileImportResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res[0].StatusCode, "BaseResponse status code not as expected")
+			assert.Equal(t, testCase.expectedResult, res[0].DeviceProfileImportResult)
+		})
+	}
+}
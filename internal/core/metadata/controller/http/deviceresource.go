@@ -6,9 +6,13 @@
 package http
 
 import (
+	"math"
 	"net/http"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataUtils "github.com/edgexfoundry/edgex-go/internal/core/metadata/utils"
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
@@ -33,7 +37,7 @@ type DeviceResourceController struct {
 // NewDeviceResourceController creates and initializes an DeviceResourceController
 func NewDeviceResourceController(dic *di.Container) *DeviceResourceController {
 	return &DeviceResourceController{
-		reader: io.NewJsonDtoReader(),
+		reader: newJsonDefaultReadWriteReader(dic),
 		dic:    dic,
 	}
 }
@@ -59,6 +63,293 @@ func (dc *DeviceResourceController) DeviceResourceByProfileNameAndResourceName(c
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
 
+// MultiDeviceResourcesResponse defines the Response Content for GET multiple DeviceResource DTOs.
+type MultiDeviceResourcesResponse struct {
+	commonDTO.BaseWithTotalCountResponse `json:",inline"`
+	Resources                            []dtos.DeviceResource `json:"resources"`
+}
+
+// VirtualDeviceResourcesByProfileName query the virtual device resources by profileName
+func (dc *DeviceResourceController) VirtualDeviceResourcesByProfileName(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	profileName := c.Param(common.ProfileName)
+
+	resources, err := application.VirtualDeviceResourcesByProfileName(profileName, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := MultiDeviceResourcesResponse{
+		BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, uint32(len(resources))),
+		Resources:                  resources,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// NullableDeviceResourcesByProfileName query the nullable device resources by profileName
+func (dc *DeviceResourceController) NullableDeviceResourcesByProfileName(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	profileName := c.Param(common.ProfileName)
+
+	resources, err := application.NullableDeviceResourcesByProfileName(profileName, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := MultiDeviceResourcesResponse{
+		BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, uint32(len(resources))),
+		Resources:                  resources,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// UniqueKeyDeviceResourcesByProfileName query the unique key device resources by profileName
+func (dc *DeviceResourceController) UniqueKeyDeviceResourcesByProfileName(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	profileName := c.Param(common.ProfileName)
+
+	resources, err := application.UniqueKeyDeviceResourcesByProfileName(profileName, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := MultiDeviceResourcesResponse{
+		BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, uint32(len(resources))),
+		Resources:                  resources,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// CoalesceGroupsResponse defines the response of the device resources grouped by the coalesceGroup
+type CoalesceGroupsResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Groups                 map[string][]dtos.DeviceResource `json:"groups"`
+}
+
+// DeviceResourcesByCoalesceGroup query the device resources of the profile grouped by the coalesceGroup
+func (dc *DeviceResourceController) DeviceResourcesByCoalesceGroup(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	profileName := c.Param(common.ProfileName)
+
+	groups, err := application.DeviceResourcesByCoalesceGroup(profileName, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := CoalesceGroupsResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		Groups:       groups,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// DeviceResourceHistoryResponse defines the response of the device resource change history query
+type DeviceResourceHistoryResponse struct {
+	commonDTO.BaseWithTotalCountResponse `json:",inline"`
+	Changes                              []metadataUtils.DeviceResourceChange `json:"changes"`
+}
+
+// DeviceResourceHistory query the recorded changes of the device resource by profileName and resourceName
+func (dc *DeviceResourceController) DeviceResourceHistory(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	profileName := c.Param(common.ProfileName)
+	resourceName := c.Param(common.ResourceName)
+
+	changes, err := application.DeviceResourceHistory(profileName, resourceName, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := DeviceResourceHistoryResponse{
+		BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, uint32(len(changes))),
+		Changes:                    changes,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// MigrateResourceValueType migrates the value type of the device resource by profileName and resourceName
+func (dc *DeviceResourceController) MigrateResourceValueType(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	profileName := c.Param(common.ProfileName)
+	resourceName := c.Param(common.ResourceName)
+	valueType := c.Param(common.ValueType)
+
+	err := application.MigrateResourceValueType(profileName, resourceName, valueType, ctx, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// ReorderResourcesRequest defines the request to set the order of the device resources of a device profile
+type ReorderResourcesRequest struct {
+	commonDTO.BaseRequest `json:",inline"`
+	ResourceNames         []string `json:"resourceNames"`
+}
+
+// ReorderResources sets the order of the device resources of the device profile to the order of the resource names
+func (dc *DeviceResourceController) ReorderResources(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+	ctx := r.Context()
+
+	// URL parameters
+	profileName := c.Param(common.Name)
+
+	var reqDTO ReorderResourcesRequest
+	err := dc.reader.Read(r.Body, &reqDTO)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	err = application.ReorderResources(profileName, reqDTO.ResourceNames, ctx, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse(reqDTO.RequestId, "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// MultiMissingEngineeringRangeResponse defines the response of the numeric device resources missing the engineering range
+type MultiMissingEngineeringRangeResponse struct {
+	commonDTO.BaseWithTotalCountResponse `json:",inline"`
+	Resources                            []application.MissingEngineeringRange `json:"resources"`
+}
+
+// DeviceResourcesMissingEngineeringRange reports the numeric device resources without both minimum and maximum across
+// the device profiles
+func (dc *DeviceResourceController) DeviceResourcesMissingEngineeringRange(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	// parse URL query string for offset and limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	resources, totalCount, err := application.DeviceResourcesMissingEngineeringRange(offset, limit, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := MultiMissingEngineeringRangeResponse{
+		BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, totalCount),
+		Resources:                  resources,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// MultiStaleCalibrationResponse defines the response of the device resources with the stale calibration
+type MultiStaleCalibrationResponse struct {
+	commonDTO.BaseWithTotalCountResponse `json:",inline"`
+	Resources                            []application.StaleCalibration `json:"resources"`
+}
+
+// DeviceResourcesWithStaleCalibration reports the device resources whose calibration is older than the maxAge query
+// parameter, or the CalibrationMaxAge configuration, across the device profiles
+func (dc *DeviceResourceController) DeviceResourcesWithStaleCalibration(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	// parse URL query string for offset and limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	resources, totalCount, err := application.DeviceResourcesWithStaleCalibration(c.QueryParam(constants.MaxAge), offset, limit, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := MultiStaleCalibrationResponse{
+		BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, totalCount),
+		Resources:                  resources,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// DeprecatedResourceImpactResponse defines the response of the deprecated device resource impact assessment
+type DeprecatedResourceImpactResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Impact                 application.DeprecatedResourceImpactReport `json:"impact"`
+}
+
+// DeprecatedResourceImpact reports the device profiles, the devices and the device commands affected by removing the
+// device resource by resourceName
+func (dc *DeviceResourceController) DeprecatedResourceImpact(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	resourceName := c.Param(common.ResourceName)
+
+	report, err := application.DeprecatedResourceImpact(resourceName, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := DeprecatedResourceImpactResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		Impact:       report,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
 func (dc *DeviceResourceController) AddDeviceProfileResource(c echo.Context) error {
 	r := c.Request()
 	w := c.Response()
@@ -12,7 +12,10 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
 
@@ -137,6 +140,422 @@ func TestDeviceResourceByProfileNameAndResourceName(t *testing.T) {
 	}
 }
 
+func TestVirtualDeviceResourcesByProfileName(t *testing.T) {
+	deviceProfile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	virtualResource := models.DeviceResource{
+		Name: "TestVirtualResource",
+		Properties: models.ResourceProperties{
+			ValueType: common.ValueTypeFloat32,
+			ReadWrite: common.ReadWrite_R,
+			Optional:  map[string]any{application.IsVirtualKey: true},
+		},
+	}
+	deviceProfile.DeviceResources = append(deviceProfile.DeviceResources, virtualResource)
+	emptyName := ""
+	profileNotFoundName := "profileNotFoundName"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", deviceProfile.Name).Return(deviceProfile, nil)
+	dbClientMock.On("DeviceProfileByName", profileNotFoundName).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceResourceController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		profileName        string
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid - find virtual device resources by profileName", deviceProfile.Name, false, http.StatusOK},
+		{"Invalid - profile name is empty", emptyName, true, http.StatusBadRequest},
+		{"Invalid - device profile not found", profileNotFoundName, true, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiVirtualDeviceResourcesByProfileNameRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.ProfileName)
+			c.SetParamValues(testCase.profileName)
+			err = controller.VirtualDeviceResourcesByProfileName(c)
+			require.NoError(t, err)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res MultiDeviceResourcesResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.Equal(t, uint32(1), res.TotalCount, "Total count not as expected")
+				require.Len(t, res.Resources, 1)
+				assert.Equal(t, virtualResource.Name, res.Resources[0].Name, "Resource name not as expected")
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+			}
+		})
+	}
+}
+
+func TestNullableDeviceResourcesByProfileName(t *testing.T) {
+	deviceProfile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	nullableResource := models.DeviceResource{
+		Name: "TestNullableResource",
+		Properties: models.ResourceProperties{
+			ValueType: common.ValueTypeFloat32,
+			ReadWrite: common.ReadWrite_R,
+			Optional:  map[string]any{application.NullableKey: true},
+		},
+	}
+	deviceProfile.DeviceResources = append(deviceProfile.DeviceResources, nullableResource)
+	emptyName := ""
+	profileNotFoundName := "profileNotFoundName"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", deviceProfile.Name).Return(deviceProfile, nil)
+	dbClientMock.On("DeviceProfileByName", profileNotFoundName).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceResourceController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		profileName        string
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid - find nullable device resources by profileName", deviceProfile.Name, false, http.StatusOK},
+		{"Invalid - profile name is empty", emptyName, true, http.StatusBadRequest},
+		{"Invalid - device profile not found", profileNotFoundName, true, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiNullableDeviceResourcesByProfileNameRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.ProfileName)
+			c.SetParamValues(testCase.profileName)
+			err = controller.NullableDeviceResourcesByProfileName(c)
+			require.NoError(t, err)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res MultiDeviceResourcesResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.Equal(t, uint32(1), res.TotalCount, "Total count not as expected")
+				require.Len(t, res.Resources, 1)
+				assert.Equal(t, nullableResource.Name, res.Resources[0].Name, "Resource name not as expected")
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+			}
+		})
+	}
+}
+
+func TestUniqueKeyDeviceResourcesByProfileName(t *testing.T) {
+	deviceProfile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	uniqueKeyResource := models.DeviceResource{
+		Name: "TestUniqueKeyResource",
+		Properties: models.ResourceProperties{
+			ValueType: common.ValueTypeFloat32,
+			ReadWrite: common.ReadWrite_R,
+			Optional:  map[string]any{application.UniqueKeyKey: true},
+		},
+	}
+	deviceProfile.DeviceResources = append(deviceProfile.DeviceResources, uniqueKeyResource)
+	emptyName := ""
+	profileNotFoundName := "profileNotFoundName"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", deviceProfile.Name).Return(deviceProfile, nil)
+	dbClientMock.On("DeviceProfileByName", profileNotFoundName).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceResourceController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		profileName        string
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid - find unique key device resources by profileName", deviceProfile.Name, false, http.StatusOK},
+		{"Invalid - profile name is empty", emptyName, true, http.StatusBadRequest},
+		{"Invalid - device profile not found", profileNotFoundName, true, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiUniqueKeyDeviceResourcesByProfileNameRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.ProfileName)
+			c.SetParamValues(testCase.profileName)
+			err = controller.UniqueKeyDeviceResourcesByProfileName(c)
+			require.NoError(t, err)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res MultiDeviceResourcesResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.Equal(t, uint32(1), res.TotalCount, "Total count not as expected")
+				require.Len(t, res.Resources, 1)
+				assert.Equal(t, uniqueKeyResource.Name, res.Resources[0].Name, "Resource name not as expected")
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+			}
+		})
+	}
+}
+
+func TestDeviceResourcesMissingEngineeringRange(t *testing.T) {
+	maximum := 100.0
+	deviceProfile := models.DeviceProfile{Name: TestDeviceProfileName, DeviceResources: []models.DeviceResource{
+		{Name: "TestUnboundedResource", Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, Maximum: &maximum}},
+		{Name: "TestStringResource", Properties: models.ResourceProperties{ValueType: common.ValueTypeString}},
+	}}
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AllDeviceProfiles", 0, mock.Anything, []string(nil)).Return([]models.DeviceProfile{deviceProfile}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceResourceController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		offset             string
+		expectedStatusCode int
+	}{
+		{"Valid - report the resources missing the engineering range", "0", http.StatusOK},
+		{"Invalid - offset out of range", "5", http.StatusRequestedRangeNotSatisfiable},
+		{"Invalid - offset is not a number", "one", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiMissingEngineeringRangeDeviceResourcesRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Offset, testCase.offset)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.DeviceResourcesMissingEngineeringRange(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res MultiMissingEngineeringRangeResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, uint32(1), res.TotalCount, "Total count not as expected")
+				require.Len(t, res.Resources, 1)
+				assert.Equal(t, "TestUnboundedResource", res.Resources[0].ResourceName, "Resource name not as expected")
+				assert.True(t, res.Resources[0].MissingMinimum)
+				assert.False(t, res.Resources[0].MissingMaximum)
+			}
+		})
+	}
+}
+
+func TestDeviceResourcesWithStaleCalibration(t *testing.T) {
+	calibrated := func(name string, date string) models.DeviceResource {
+		return models.DeviceResource{Name: name, Properties: models.ResourceProperties{ValueType: common.ValueTypeFloat32, Optional: map[string]any{
+			application.CalibrationKey: map[string]any{
+				application.CalibrationCoefficientsKey: map[string]any{"gain": 1.02},
+				application.CalibrationDateKey:         date,
+			},
+		}}}
+	}
+	deviceProfile := models.DeviceProfile{Name: TestDeviceProfileName, DeviceResources: []models.DeviceResource{
+		calibrated("TestStaleResource", "2020-01-01"),
+		calibrated("TestCalibratedResource", time.Now().Format(time.DateOnly)),
+	}}
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AllDeviceProfiles", 0, mock.Anything, []string(nil)).Return([]models.DeviceProfile{deviceProfile}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceResourceController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		offset             string
+		maxAge             string
+		expectedStatusCode int
+	}{
+		{"Valid - report the resources with the stale calibration", "0", "8760h", http.StatusOK},
+		{"Invalid - offset out of range", "5", "8760h", http.StatusRequestedRangeNotSatisfiable},
+		{"Invalid - offset is not a number", "one", "8760h", http.StatusBadRequest},
+		{"Invalid - max age is not a duration", "0", "1y", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiStaleCalibrationDeviceResourcesRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Offset, testCase.offset)
+			query.Add(constants.MaxAge, testCase.maxAge)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.DeviceResourcesWithStaleCalibration(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res MultiStaleCalibrationResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, uint32(1), res.TotalCount, "Total count not as expected")
+				require.Len(t, res.Resources, 1)
+				assert.Equal(t, "TestStaleResource", res.Resources[0].ResourceName, "Resource name not as expected")
+				assert.Equal(t, "2020-01-01", res.Resources[0].CalibrationDate)
+			}
+		})
+	}
+}
+
+func TestDeprecatedResourceImpact(t *testing.T) {
+	deviceProfile := models.DeviceProfile{
+		Name:            TestDeviceProfileName,
+		DeviceResources: []models.DeviceResource{{Name: TestDeviceResourceName}},
+		DeviceCommands:  []models.DeviceCommand{{Name: TestDeviceCommandName, ResourceOperations: []models.ResourceOperation{{DeviceResource: TestDeviceResourceName}}}},
+	}
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AllDeviceProfiles", 0, mock.Anything, []string(nil)).Return([]models.DeviceProfile{deviceProfile}, nil)
+	dbClientMock.On("DevicesByProfileName", 0, -1, TestDeviceProfileName).Return([]models.Device{{Name: TestDeviceName}}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceResourceController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		resourceName       string
+		expectedStatusCode int
+	}{
+		{"Valid - report the impact of the resource", TestDeviceResourceName, http.StatusOK},
+		{"Invalid - empty resource name", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiDeviceResourceImpactByNameRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.ResourceName)
+			c.SetParamValues(testCase.resourceName)
+			err = controller.DeprecatedResourceImpact(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res DeprecatedResourceImpactResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, TestDeviceResourceName, res.Impact.ResourceName)
+				assert.Equal(t, 1, res.Impact.DeviceCount)
+				require.Len(t, res.Impact.Profiles, 1)
+				assert.Equal(t, []string{TestDeviceName}, res.Impact.Profiles[0].DeviceNames)
+				assert.Equal(t, []string{TestDeviceCommandName}, res.Impact.Profiles[0].DeviceCommands)
+			}
+		})
+	}
+}
+
 func TestAddDeviceProfileResource(t *testing.T) {
 	deviceProfile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
 	expectedRequestId := ExampleUUID
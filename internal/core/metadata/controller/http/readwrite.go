@@ -0,0 +1,46 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	stdio "io"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/io"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// defaultReadWriteReader applies the configured default ReadWrite to the device resources of the request body
+// before passing it to the wrapped DtoReader
+type defaultReadWriteReader struct {
+	reader io.DtoReader
+	apply  func(data []byte, dic *di.Container) ([]byte, errors.EdgeX)
+	dic    *di.Container
+}
+
+func newJsonDefaultReadWriteReader(dic *di.Container) io.DtoReader {
+	return defaultReadWriteReader{reader: io.NewJsonDtoReader(), apply: application.ApplyDefaultReadWriteToJSON, dic: dic}
+}
+
+func newYamlDefaultReadWriteReader(dic *di.Container) io.DtoReader {
+	return defaultReadWriteReader{reader: io.NewYamlDtoReader(), apply: application.ApplyDefaultReadWriteToYAML, dic: dic}
+}
+
+// Read reads the data, applies the default ReadWrite and decodes it into the specified DTO
+func (d defaultReadWriteReader) Read(reader stdio.Reader, v interface{}) errors.EdgeX {
+	data, err := stdio.ReadAll(reader)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindIOError, "failed to read the request body", err)
+	}
+	data, edgexErr := d.apply(data, d.dic)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	return d.reader.Read(bytes.NewReader(data), v)
+}
@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+)
+
+// SubscribeSystemEvents subscribes to the device profile system events published by all the core-metadata instances,
+// so the device profiles changed by the other instances are evicted from the profile cache
+func SubscribeSystemEvents(ctx context.Context, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	configuration := container.ConfigurationFrom(dic.Get)
+
+	// device profile event edgex/system-events/core-metadata/deviceprofile/<action>/<owner>/<device profile name>
+	deviceProfileSystemEventTopic := common.NewPathBuilder().EnableNameFieldEscape(configuration.Service.EnableNameFieldEscape).
+		SetPath(configuration.MessageBus.GetBaseTopicPrefix()).SetPath(common.SystemEventPublishTopic).SetPath(common.CoreMetaDataServiceKey).
+		SetPath(common.DeviceProfileSystemEventType).SetPath("#").BuildPath()
+	lc.Infof("Subscribing to System Events on topic: %s", deviceProfileSystemEventTopic)
+
+	messages := make(chan types.MessageEnvelope, 1)
+	messageErrors := make(chan error, 1)
+	topics := []types.TopicChannel{
+		{
+			Topic:    deviceProfileSystemEventTopic,
+			Messages: messages,
+		},
+	}
+
+	messageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
+	err := messageBus.Subscribe(topics, messageErrors)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Infof("Exiting waiting for MessageBus '%s' topic messages", deviceProfileSystemEventTopic)
+				return
+			case err = <-messageErrors:
+				lc.Error(err.Error())
+			case msgEnvelope := <-messages:
+				lc.Debugf("System event received on message queue. Topic: %s, Correlation-id: %s", msgEnvelope.ReceivedTopic, msgEnvelope.CorrelationID)
+				var systemEvent dtos.SystemEvent
+				systemEvent, err = types.GetMsgPayload[dtos.SystemEvent](msgEnvelope)
+				if err != nil {
+					lc.Errorf("failed to JSON decoding system event: %s", err.Error())
+					continue
+				}
+
+				if systemEvent.Type == common.DeviceProfileSystemEventType {
+					err = deviceProfileSystemEventAction(systemEvent, dic)
+					if err != nil {
+						lc.Error(err.Error(), common.CorrelationHeader, msgEnvelope.CorrelationID)
+					}
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+func deviceProfileSystemEventAction(systemEvent dtos.SystemEvent, dic *di.Container) error {
+	// the details are the device profile, the device profile with the changes, or the minimized details, which all
+	// carry the name of the device profile
+	var details struct {
+		Name string `json:"name"`
+	}
+	err := systemEvent.DecodeDetails(&details)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s system event details: %s", systemEvent.Type, err.Error())
+	}
+
+	switch systemEvent.Action {
+	case common.SystemEventActionUpdate, common.SystemEventActionDelete:
+		application.InvalidateCachedDeviceProfile(details.Name, dic)
+	}
+	return nil
+}
@@ -14,6 +14,7 @@ type DBClient interface {
 	CloseSession()
 
 	AddDeviceProfile(e model.DeviceProfile) (model.DeviceProfile, errors.EdgeX)
+	AddDeviceProfiles(e []model.DeviceProfile) ([]model.DeviceProfile, errors.EdgeX)
 	UpdateDeviceProfile(e model.DeviceProfile) errors.EdgeX
 	DeviceProfileById(id string) (model.DeviceProfile, errors.EdgeX)
 	DeviceProfileByName(name string) (model.DeviceProfile, errors.EdgeX)
@@ -24,10 +25,12 @@ type DBClient interface {
 	DeviceProfilesByModel(offset int, limit int, model string) ([]model.DeviceProfile, errors.EdgeX)
 	DeviceProfilesByManufacturer(offset int, limit int, manufacturer string) ([]model.DeviceProfile, errors.EdgeX)
 	DeviceProfilesByManufacturerAndModel(offset int, limit int, manufacturer string, model string) ([]model.DeviceProfile, errors.EdgeX)
+	DeviceProfilesByNameContains(offset int, limit int, nameContains string, labels []string) ([]model.DeviceProfile, errors.EdgeX)
 	DeviceProfileCountByLabels(labels []string) (uint32, errors.EdgeX)
 	DeviceProfileCountByManufacturer(manufacturer string) (uint32, errors.EdgeX)
 	DeviceProfileCountByModel(model string) (uint32, errors.EdgeX)
 	DeviceProfileCountByManufacturerAndModel(manufacturer string, model string) (uint32, errors.EdgeX)
+	DeviceProfileCountByNameContains(nameContains string, labels []string) (uint32, errors.EdgeX)
 	InUseResourceCount() (uint32, errors.EdgeX)
 
 	AddDeviceService(ds model.DeviceService) (model.DeviceService, errors.EdgeX)
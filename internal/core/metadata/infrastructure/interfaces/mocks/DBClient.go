@@ -75,6 +75,38 @@ func (_m *DBClient) AddDeviceProfile(e models.DeviceProfile) (models.DeviceProfi
 	return r0, r1
 }
 
+// AddDeviceProfiles provides a mock function with given fields: e
+func (_m *DBClient) AddDeviceProfiles(e []models.DeviceProfile) ([]models.DeviceProfile, errors.EdgeX) {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDeviceProfiles")
+	}
+
+	var r0 []models.DeviceProfile
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func([]models.DeviceProfile) ([]models.DeviceProfile, errors.EdgeX)); ok {
+		return rf(e)
+	}
+	if rf, ok := ret.Get(0).(func([]models.DeviceProfile) []models.DeviceProfile); ok {
+		r0 = rf(e)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeviceProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.DeviceProfile) errors.EdgeX); ok {
+		r1 = rf(e)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddDeviceService provides a mock function with given fields: ds
 func (_m *DBClient) AddDeviceService(ds models.DeviceService) (models.DeviceService, errors.EdgeX) {
 	ret := _m.Called(ds)
@@ -798,6 +830,36 @@ func (_m *DBClient) DeviceProfileCountByModel(model string) (uint32, errors.Edge
 	return r0, r1
 }
 
+// DeviceProfileCountByNameContains provides a mock function with given fields: nameContains, labels
+func (_m *DBClient) DeviceProfileCountByNameContains(nameContains string, labels []string) (uint32, errors.EdgeX) {
+	ret := _m.Called(nameContains, labels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeviceProfileCountByNameContains")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, []string) (uint32, errors.EdgeX)); ok {
+		return rf(nameContains, labels)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string) uint32); ok {
+		r0 = rf(nameContains, labels)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string) errors.EdgeX); ok {
+		r1 = rf(nameContains, labels)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceProfileNameExists provides a mock function with given fields: name
 func (_m *DBClient) DeviceProfileNameExists(name string) (bool, errors.EdgeX) {
 	ret := _m.Called(name)
@@ -924,6 +986,38 @@ func (_m *DBClient) DeviceProfilesByModel(offset int, limit int, model string) (
 	return r0, r1
 }
 
+// DeviceProfilesByNameContains provides a mock function with given fields: offset, limit, nameContains, labels
+func (_m *DBClient) DeviceProfilesByNameContains(offset int, limit int, nameContains string, labels []string) ([]models.DeviceProfile, errors.EdgeX) {
+	ret := _m.Called(offset, limit, nameContains, labels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeviceProfilesByNameContains")
+	}
+
+	var r0 []models.DeviceProfile
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int, string, []string) ([]models.DeviceProfile, errors.EdgeX)); ok {
+		return rf(offset, limit, nameContains, labels)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string, []string) []models.DeviceProfile); ok {
+		r0 = rf(offset, limit, nameContains, labels)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeviceProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string, []string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, nameContains, labels)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceServiceById provides a mock function with given fields: id
 func (_m *DBClient) DeviceServiceById(id string) (models.DeviceService, errors.EdgeX) {
 	ret := _m.Called(id)
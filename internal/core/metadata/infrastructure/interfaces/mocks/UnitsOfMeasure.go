@@ -22,3 +22,84 @@ func (_m *UnitsOfMeasure) Validate(_a0 string) bool {
 
 	return r0
 }
+
+// Lookup provides a mock function with given fields: _a0
+func (_m *UnitsOfMeasure) Lookup(_a0 string) (string, string, bool) {
+	ret := _m.Called(_a0)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(string) string); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 bool
+	if rf, ok := ret.Get(2).(func(string) bool); ok {
+		r2 = rf(_a0)
+	} else {
+		r2 = ret.Get(2).(bool)
+	}
+
+	return r0, r1, r2
+}
+
+// Canonical provides a mock function with given fields: _a0
+func (_m *UnitsOfMeasure) Canonical(_a0 string) (string, bool) {
+	ret := _m.Called(_a0)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// PhysicalLimits provides a mock function with given fields: _a0
+func (_m *UnitsOfMeasure) PhysicalLimits(_a0 string) (*float64, *float64, bool) {
+	ret := _m.Called(_a0)
+
+	var r0 *float64
+	if rf, ok := ret.Get(0).(func(string) *float64); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*float64)
+		}
+	}
+
+	var r1 *float64
+	if rf, ok := ret.Get(1).(func(string) *float64); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*float64)
+		}
+	}
+
+	var r2 bool
+	if rf, ok := ret.Get(2).(func(string) bool); ok {
+		r2 = rf(_a0)
+	} else {
+		r2 = ret.Get(2).(bool)
+	}
+
+	return r0, r1, r2
+}
@@ -0,0 +1,14 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+// ProtocolAttributes defines the validation of the Attributes of the device
+// resources, whose shape is specific to the protocol of the device service
+type ProtocolAttributes interface {
+	// Validate validates the attributes with the validator registered for
+	// the protocol. known is false if no validator is registered.
+	Validate(protocol string, attributes map[string]any) (known bool, err error)
+}
@@ -11,4 +11,13 @@ type UnitsOfMeasure interface {
 	// Validate validates DeviceResource's unit against the list of
 	// units of measure by core metadata.
 	Validate(string) bool
+	// Lookup returns the dimension and the unit system of the unit, and
+	// whether the unit is found in the units of measure.
+	Lookup(string) (string, string, bool)
+	// Canonical returns the unit value of the unit alias, and whether the
+	// alias is found in the units of measure.
+	Canonical(string) (string, bool)
+	// PhysicalLimits returns the physical minimum and maximum of the unit,
+	// either of which may be nil, and whether the unit has the limits.
+	PhysicalLimits(string) (*float64, *float64, bool)
 }
@@ -20,9 +20,12 @@ import (
 	"context"
 	"sync"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/controller/messaging"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/utils"
 
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 
@@ -45,8 +48,55 @@ func NewBootstrap(router *echo.Echo, serviceName string) *Bootstrap {
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization needed by the metadata service.
 func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	if err := container.ConfigurationFrom(dic.Get).Writable.ValidateDefaultReadWrite(); err != nil {
+		lc.Errorf("Invalid Writable.DefaultReadWrite configuration: %v", err)
+		return false
+	}
+	if err := container.ConfigurationFrom(dic.Get).Writable.UoM.ValidateMode(); err != nil {
+		lc.Errorf("Invalid Writable.UoM.Mode configuration: %v", err)
+		return false
+	}
+	if err := container.ConfigurationFrom(dic.Get).Writable.UoM.ValidatePhysicalLimitsMode(); err != nil {
+		lc.Errorf("Invalid Writable.UoM.PhysicalLimitsMode configuration: %v", err)
+		return false
+	}
+	if err := container.ConfigurationFrom(dic.Get).Writable.SystemEvent.ValidateMinimizedPayloadEventTypes(); err != nil {
+		lc.Errorf("Invalid Writable.SystemEvent.MinimizedPayloadEventTypes configuration: %v", err)
+		return false
+	}
+	if err := container.ConfigurationFrom(dic.Get).Writable.ProfileChangeNotifications.Validate(); err != nil {
+		lc.Errorf("Invalid Writable.ProfileChangeNotifications configuration: %v", err)
+		return false
+	}
+	if err := container.ConfigurationFrom(dic.Get).Writable.ProfileCache.Validate(); err != nil {
+		lc.Errorf("Invalid Writable.ProfileCache configuration: %v", err)
+		return false
+	}
+	if err := container.ConfigurationFrom(dic.Get).Writable.ValidateSampleIntervalBounds(); err != nil {
+		lc.Errorf("Invalid Writable.MinSampleInterval or Writable.MaxSampleInterval configuration: %v", err)
+		return false
+	}
+
+	profileCache := utils.NewDeviceProfileCache()
+	resourceHistory := utils.NewDeviceResourceHistory()
+	dic.Update(di.ServiceConstructorMap{
+		container.DeviceProfileCacheName: func(get di.Get) interface{} {
+			return profileCache
+		},
+		container.DeviceResourceHistoryName: func(get di.Get) interface{} {
+			return resourceHistory
+		},
+	})
+
 	LoadRestRoutes(b.router, dic, b.serviceName)
 
+	application.RegisterMetrics(dic)
+	if err := messaging.SubscribeSystemEvents(ctx, dic); err != nil {
+		lc.Errorf("Failed to subscribe system events from message bus, %v", err)
+		return false
+	}
+
 	capacityCheckLock := utils.NewCapacityCheckLock()
 	dic.Update(di.ServiceConstructorMap{
 		container.CapacityCheckLockName: func(get di.Get) interface{} {
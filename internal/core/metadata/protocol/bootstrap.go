@@ -0,0 +1,67 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol
+
+import (
+	"context"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/file"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+)
+
+// schemaFile is the content of the ProtocolAttributes.SchemaFile
+type schemaFile struct {
+	Protocols map[string]AttributeSchema `yaml:"Protocols"`
+}
+
+// BootstrapHandler registers the compiled-in attribute schemas, along with the schemas loaded from the
+// ProtocolAttributes.SchemaFile which replace the compiled-in schemas of the same protocols
+func BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+
+	registry := NewRegistry()
+	for protocol, schema := range BuiltinSchemas {
+		registry.Register(protocol, schema)
+	}
+
+	if filepath := config.ProtocolAttributes.SchemaFile; filepath != "" {
+		secretProvider := bootstrapContainer.SecretProviderFrom(dic.Get)
+		contents, err := file.Load(filepath, secretProvider, lc)
+		if err != nil {
+			lc.Errorf("could not load protocol attribute schema file: %s", err.Error())
+			return false
+		}
+		var schemas schemaFile
+		if err = yaml.Unmarshal(contents, &schemas); err != nil {
+			lc.Errorf("could not load protocol attribute schema file: %s", err.Error())
+			return false
+		}
+		for protocol, schema := range schemas.Protocols {
+			if err = schema.Validate(); err != nil {
+				lc.Errorf("invalid attribute schema of the protocol %s: %s", protocol, err.Error())
+				return false
+			}
+			registry.Register(protocol, schema)
+		}
+		lc.Infof("Loaded protocol attribute schemas from %s", filepath)
+	}
+
+	dic.Update(di.ServiceConstructorMap{
+		container.ProtocolAttributesInterfaceName: func(get di.Get) interface{} {
+			return registry
+		},
+	})
+	lc.Infof("Registered the attribute validators of %d protocols", registry.Protocols())
+
+	return true
+}
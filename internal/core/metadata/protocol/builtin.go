@@ -0,0 +1,40 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol
+
+// BuiltinSchemas are the compiled-in attribute schemas of the protocols of the EdgeX device services. They only check
+// the well-known attributes and allow the others, as the device services may support more attributes than listed.
+var BuiltinSchemas = map[string]AttributeSchema{
+	"modbus": {
+		Required: []string{"primaryTable", "startingAddress"},
+		Properties: map[string]AttributeProperty{
+			"primaryTable":       {Type: TypeString, Enum: []string{"COILS", "DISCRETE_INPUTS", "INPUT_REGISTERS", "HOLDING_REGISTERS"}},
+			"startingAddress":    {Type: TypeInteger},
+			"rawType":            {Type: TypeString},
+			"isByteSwap":         {Type: TypeBoolean},
+			"isWordSwap":         {Type: TypeBoolean},
+			"stringRegisterSize": {Type: TypeInteger},
+		},
+		AdditionalProperties: true,
+	},
+	"bacnet": {
+		Required: []string{"type", "instance", "property"},
+		Properties: map[string]AttributeProperty{
+			"type":     {Type: TypeString},
+			"instance": {Type: TypeInteger},
+			"property": {Type: TypeString},
+			"index":    {Type: TypeInteger},
+		},
+		AdditionalProperties: true,
+	},
+	"opcua": {
+		Required: []string{"nodeId"},
+		Properties: map[string]AttributeProperty{
+			"nodeId": {Type: TypeString},
+		},
+		AdditionalProperties: true,
+	},
+}
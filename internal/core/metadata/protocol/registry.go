@@ -0,0 +1,57 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol
+
+import (
+	"strings"
+	"sync"
+)
+
+// AttributeValidator validates the Attributes of a device resource of a protocol
+type AttributeValidator interface {
+	ValidateAttributes(attributes map[string]any) error
+}
+
+// AttributeValidatorFunc adapts a function to the AttributeValidator
+type AttributeValidatorFunc func(attributes map[string]any) error
+
+func (f AttributeValidatorFunc) ValidateAttributes(attributes map[string]any) error {
+	return f(attributes)
+}
+
+// Registry holds the attribute validators by the protocol identifier, which is case-insensitive, e.g. modbus
+type Registry struct {
+	mutex      sync.RWMutex
+	validators map[string]AttributeValidator
+}
+
+func NewRegistry() *Registry {
+	return &Registry{validators: make(map[string]AttributeValidator)}
+}
+
+// Register registers the attribute validator of the protocol, which replaces the validator registered before
+func (r *Registry) Register(protocol string, validator AttributeValidator) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.validators[strings.ToLower(protocol)] = validator
+}
+
+// Protocols returns the number of the protocols with a registered validator
+func (r *Registry) Protocols() int {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return len(r.validators)
+}
+
+func (r *Registry) Validate(protocol string, attributes map[string]any) (bool, error) {
+	r.mutex.RLock()
+	validator, ok := r.validators[strings.ToLower(protocol)]
+	r.mutex.RUnlock()
+	if !ok {
+		return false, nil
+	}
+	return true, validator.ValidateAttributes(attributes)
+}
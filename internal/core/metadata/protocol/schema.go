@@ -0,0 +1,128 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"sort"
+)
+
+// The attribute types of the AttributeProperty
+const (
+	TypeString  = "string"
+	TypeNumber  = "number"
+	TypeInteger = "integer"
+	TypeBoolean = "boolean"
+	TypeArray   = "array"
+	TypeObject  = "object"
+)
+
+var attributeTypes = []string{TypeString, TypeNumber, TypeInteger, TypeBoolean, TypeArray, TypeObject}
+
+// AttributeSchema describes the Attributes expected by the device service of a protocol
+type AttributeSchema struct {
+	// Required lists the attributes every device resource must declare
+	Required []string `json:"required,omitempty" yaml:"Required,omitempty"`
+	// Properties maps the attribute names to their expected types and values
+	Properties map[string]AttributeProperty `json:"properties,omitempty" yaml:"Properties,omitempty"`
+	// AdditionalProperties allows the attributes not listed in the Properties
+	AdditionalProperties bool `json:"additionalProperties,omitempty" yaml:"AdditionalProperties,omitempty"`
+}
+
+type AttributeProperty struct {
+	// Type is one of string, number, integer, boolean, array and object, empty allows any type
+	Type string `json:"type,omitempty" yaml:"Type,omitempty"`
+	// Enum lists the allowed values of the string attribute, empty allows any value
+	Enum []string `json:"enum,omitempty" yaml:"Enum,omitempty"`
+}
+
+// Validate validates the schema itself, e.g. the schema loaded from the configuration
+func (s AttributeSchema) Validate() error {
+	for name, property := range s.Properties {
+		if property.Type != "" && !slices.Contains(attributeTypes, property.Type) {
+			return fmt.Errorf("attribute %s has the unknown type %s, must be one of %v", name, property.Type, attributeTypes)
+		}
+		if len(property.Enum) > 0 && property.Type != TypeString {
+			return fmt.Errorf("attribute %s declares the enum, which is only allowed for the string type", name)
+		}
+	}
+	if !s.AdditionalProperties {
+		for _, name := range s.Required {
+			if _, ok := s.Properties[name]; !ok {
+				return fmt.Errorf("required attribute %s is not allowed by the properties", name)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateAttributes validates the attributes against the schema
+func (s AttributeSchema) ValidateAttributes(attributes map[string]any) error {
+	for _, name := range s.Required {
+		if _, ok := attributes[name]; !ok {
+			return fmt.Errorf("required attribute %s is missing", name)
+		}
+	}
+
+	// validate in the order of the names, so the error is deterministic
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := attributes[name]
+		property, ok := s.Properties[name]
+		if !ok {
+			if !s.AdditionalProperties {
+				return fmt.Errorf("attribute %s is not allowed", name)
+			}
+			continue
+		}
+		if property.Type != "" && !isAttributeType(value, property.Type) {
+			return fmt.Errorf("attribute %s %v is not of the type %s", name, value, property.Type)
+		}
+		if len(property.Enum) > 0 {
+			if str, ok := value.(string); !ok || !slices.Contains(property.Enum, str) {
+				return fmt.Errorf("attribute %s %v is not one of %v", name, value, property.Enum)
+			}
+		}
+	}
+	return nil
+}
+
+// isAttributeType returns whether the attribute value decoded from JSON or YAML is of the attribute type
+func isAttributeType(value any, attributeType string) bool {
+	if value == nil {
+		return false
+	}
+	v := reflect.ValueOf(value)
+	switch attributeType {
+	case TypeString:
+		return v.Kind() == reflect.String
+	case TypeBoolean:
+		return v.Kind() == reflect.Bool
+	case TypeArray:
+		return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+	case TypeObject:
+		return v.Kind() == reflect.Map
+	case TypeNumber, TypeInteger:
+		switch {
+		case v.CanInt(), v.CanUint():
+			return true
+		case v.CanFloat():
+			f := v.Float()
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				return false
+			}
+			return attributeType == TypeNumber || f == math.Trunc(f)
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttributeSchema_ValidateAttributes(t *testing.T) {
+	schema := BuiltinSchemas["modbus"]
+	tests := []struct {
+		name          string
+		attributes    map[string]any
+		expectedError bool
+	}{
+		{"valid - JSON numbers", map[string]any{"primaryTable": "HOLDING_REGISTERS", "startingAddress": 100.0}, false},
+		{"valid - YAML integers", map[string]any{"primaryTable": "COILS", "startingAddress": 1, "isByteSwap": true}, false},
+		{"valid - additional attribute", map[string]any{"primaryTable": "COILS", "startingAddress": 1, "timeout": "1s"}, false},
+		{"invalid - missing required", map[string]any{"primaryTable": "COILS"}, true},
+		{"invalid - not in the enum", map[string]any{"primaryTable": "REGISTERS", "startingAddress": 1}, true},
+		{"invalid - fraction integer", map[string]any{"primaryTable": "COILS", "startingAddress": 1.5}, true},
+		{"invalid - wrong type", map[string]any{"primaryTable": "COILS", "startingAddress": "1"}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := schema.ValidateAttributes(testCase.attributes)
+			if testCase.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	strict := AttributeSchema{Properties: map[string]AttributeProperty{"nodeId": {Type: TypeString}}}
+	assert.Error(t, strict.ValidateAttributes(map[string]any{"nodeId": "ns=2;s=Temp", "other": 1}), "additional attributes should be rejected")
+}
+
+func TestAttributeSchema_Validate(t *testing.T) {
+	for protocol, schema := range BuiltinSchemas {
+		assert.NoError(t, schema.Validate(), protocol)
+	}
+	assert.Error(t, AttributeSchema{Properties: map[string]AttributeProperty{"a": {Type: "float"}}}.Validate())
+	assert.Error(t, AttributeSchema{Properties: map[string]AttributeProperty{"a": {Type: TypeInteger, Enum: []string{"1"}}}}.Validate())
+	assert.Error(t, AttributeSchema{Required: []string{"a"}}.Validate())
+}
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("Modbus", BuiltinSchemas["modbus"])
+
+	known, err := registry.Validate("MODBUS", map[string]any{"primaryTable": "COILS", "startingAddress": 1})
+	require.True(t, known)
+	assert.NoError(t, err)
+
+	known, err = registry.Validate("modbus", map[string]any{})
+	require.True(t, known)
+	assert.Error(t, err)
+
+	known, err = registry.Validate("unknown", map[string]any{})
+	assert.False(t, known)
+	assert.NoError(t, err)
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/constants"
 	metadataController "github.com/edgexfoundry/edgex-go/internal/core/metadata/controller/http"
 
 	"github.com/labstack/echo/v4"
@@ -33,9 +34,16 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	dc := metadataController.NewDeviceProfileController(dic)
 	r.POST(common.ApiDeviceProfileRoute, dc.AddDeviceProfile, authenticationHook)
 	r.PUT(common.ApiDeviceProfileRoute, dc.UpdateDeviceProfile, authenticationHook)
+	r.PUT(constants.ApiDeviceProfileUpsertRoute, dc.UpsertDeviceProfile, authenticationHook)
+	r.POST(constants.ApiDeviceProfileValidateRoute, dc.ValidateDeviceProfile, authenticationHook)
+	r.POST(constants.ApiDeviceProfileCloneByNameRoute, dc.CloneDeviceProfile, authenticationHook)
+	r.POST(constants.ApiDeviceProfileUnitsFixRoute, dc.FixProfileUnits, authenticationHook)
 	r.POST(common.ApiDeviceProfileUploadFileRoute, dc.AddDeviceProfileByYaml, authenticationHook)
 	r.PUT(common.ApiDeviceProfileUploadFileRoute, dc.UpdateDeviceProfileByYaml, authenticationHook)
 	r.GET(common.ApiDeviceProfileByNameRoute, dc.DeviceProfileByName, authenticationHook)
+	r.GET(constants.ApiDeviceProfileSubsetByNameRoute, dc.DeviceProfileSubsetYaml, authenticationHook)
+	r.GET(constants.ApiDeviceProfileYamlByNameRoute, dc.DeviceProfileInYAMLByName, authenticationHook)
+	r.GET(constants.ApiDeviceProfileCapacityByNameRoute, dc.ProfileResourceCapacity, authenticationHook)
 	r.DELETE(common.ApiDeviceProfileByNameRoute, dc.DeleteDeviceProfileByName, authenticationHook)
 	r.GET(common.ApiAllDeviceProfileRoute, dc.AllDeviceProfiles, authenticationHook)
 	r.GET(common.ApiDeviceProfileByModelRoute, dc.DeviceProfilesByModel, authenticationHook)
@@ -47,6 +55,16 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	// Device Resource
 	dr := metadataController.NewDeviceResourceController(dic)
 	r.GET(common.ApiDeviceResourceByProfileAndResourceRoute, dr.DeviceResourceByProfileNameAndResourceName, authenticationHook)
+	r.GET(constants.ApiVirtualDeviceResourcesByProfileNameRoute, dr.VirtualDeviceResourcesByProfileName, authenticationHook)
+	r.GET(constants.ApiNullableDeviceResourcesByProfileNameRoute, dr.NullableDeviceResourcesByProfileName, authenticationHook)
+	r.GET(constants.ApiUniqueKeyDeviceResourcesByProfileNameRoute, dr.UniqueKeyDeviceResourcesByProfileName, authenticationHook)
+	r.GET(constants.ApiDeviceResourcesByCoalesceGroupRoute, dr.DeviceResourcesByCoalesceGroup, authenticationHook)
+	r.GET(constants.ApiMissingEngineeringRangeDeviceResourcesRoute, dr.DeviceResourcesMissingEngineeringRange, authenticationHook)
+	r.GET(constants.ApiStaleCalibrationDeviceResourcesRoute, dr.DeviceResourcesWithStaleCalibration, authenticationHook)
+	r.GET(constants.ApiDeviceResourceImpactByNameRoute, dr.DeprecatedResourceImpact, authenticationHook)
+	r.GET(constants.ApiDeviceResourceHistoryByProfileAndResourceRoute, dr.DeviceResourceHistory, authenticationHook)
+	r.PUT(constants.ApiDeviceResourceValueTypeRoute, dr.MigrateResourceValueType, authenticationHook)
+	r.PUT(constants.ApiDeviceProfileResourceOrderByNameRoute, dr.ReorderResources, authenticationHook)
 	r.POST(common.ApiDeviceProfileResourceRoute, dr.AddDeviceProfileResource, authenticationHook)
 	r.PATCH(common.ApiDeviceProfileResourceRoute, dr.PatchDeviceProfileResource, authenticationHook)
 	r.DELETE(common.ApiDeviceProfileResourceByNameRoute, dr.DeleteDeviceResourceByName, authenticationHook)
@@ -13,6 +13,20 @@ type UnitsOfMeasureImpl struct {
 type Unit struct {
 	Source string   `json:"source,omitempty" yaml:"Source,omitempty"`
 	Values []string `json:"values,omitempty" yaml:"Values,omitempty"`
+	// Systems maps the unit value to the unit system it belongs to, e.g. metric or imperial
+	Systems map[string]string `json:"systems,omitempty" yaml:"Systems,omitempty"`
+	// Aliases maps the known alternative spellings to the unit values, e.g. celsius to C, which are used to correct the
+	// invalid units of the stored device profiles
+	Aliases map[string]string `json:"aliases,omitempty" yaml:"Aliases,omitempty"`
+	// Limits maps the unit values to their physical limits, e.g. the absolute zero of the temperature units, which the
+	// Minimum and the Maximum of the device resources are checked against
+	Limits map[string]Limit `json:"limits,omitempty" yaml:"Limits,omitempty"`
+}
+
+// Limit is the physical limit of a unit value, either bound may be omitted
+type Limit struct {
+	Minimum *float64 `json:"minimum,omitempty" yaml:"Minimum,omitempty"`
+	Maximum *float64 `json:"maximum,omitempty" yaml:"Maximum,omitempty"`
 }
 
 func (u *UnitsOfMeasureImpl) Validate(unit string) bool {
@@ -30,3 +44,52 @@ func (u *UnitsOfMeasureImpl) Validate(unit string) bool {
 
 	return false
 }
+
+// Lookup returns the dimension, i.e. the name of the unit group, and the unit system of the specified unit.
+// The found flag is false if the unit is not in the units of measure.
+func (u *UnitsOfMeasureImpl) Lookup(unit string) (dimension string, system string, found bool) {
+	if unit == "" {
+		return "", "", false
+	}
+
+	for name, units := range u.Units {
+		for _, v := range units.Values {
+			if unit == v {
+				return name, units.Systems[v], true
+			}
+		}
+	}
+
+	return "", "", false
+}
+
+// Canonical returns the unit value of the alias, and whether the alias is found in the units of measure
+func (u *UnitsOfMeasureImpl) Canonical(alias string) (string, bool) {
+	if alias == "" {
+		return "", false
+	}
+
+	for _, units := range u.Units {
+		if v, ok := units.Aliases[alias]; ok {
+			return v, true
+		}
+	}
+
+	return "", false
+}
+
+// PhysicalLimits returns the physical minimum and maximum of the specified unit, either of which may be nil, and whether
+// the unit has the physical limits in the units of measure
+func (u *UnitsOfMeasureImpl) PhysicalLimits(unit string) (minimum *float64, maximum *float64, found bool) {
+	if unit == "" {
+		return nil, nil, false
+	}
+
+	for _, units := range u.Units {
+		if limit, ok := units.Limits[unit]; ok {
+			return limit.Minimum, limit.Maximum, true
+		}
+	}
+
+	return nil, nil, false
+}
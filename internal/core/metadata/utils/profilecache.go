@@ -0,0 +1,191 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"container/list"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	gometrics "github.com/rcrowley/go-metrics"
+)
+
+// DeviceProfileCache is the least recently used read-through cache of the device profiles queried by name
+type DeviceProfileCache struct {
+	mutex   sync.Mutex
+	entries map[string]*list.Element
+	// lru orders the entries from the most to the least recently used
+	lru *list.List
+	// generation is increased on every invalidation, so a device profile read from the database before the
+	// invalidation is not cached after it
+	generation uint64
+	hits       gometrics.Counter
+	misses     gometrics.Counter
+}
+
+type deviceProfileCacheEntry struct {
+	name    string
+	profile dtos.DeviceProfile
+	expires time.Time
+}
+
+// NewDeviceProfileCache returns an empty device profile cache
+func NewDeviceProfileCache() *DeviceProfileCache {
+	return &DeviceProfileCache{
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+		hits:    gometrics.NewCounter(),
+		misses:  gometrics.NewCounter(),
+	}
+}
+
+// Get returns the cached device profile which is not expired, and the generation to store the device profile read
+// from the database on miss
+func (c *DeviceProfileCache) Get(name string) (dtos.DeviceProfile, bool, uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if element, ok := c.entries[name]; ok {
+		entry := element.Value.(*deviceProfileCacheEntry)
+		if entry.expires.IsZero() || time.Now().Before(entry.expires) {
+			c.lru.MoveToFront(element)
+			c.hits.Inc(1)
+			return cloneDeviceProfileDTO(entry.profile), true, c.generation
+		}
+		c.remove(element)
+	}
+	c.misses.Inc(1)
+	return dtos.DeviceProfile{}, false, c.generation
+}
+
+// Add caches the device profile read from the database unless the cache is invalidated since the read, and evicts the
+// least recently used device profiles beyond the max size
+func (c *DeviceProfileCache) Add(profile dtos.DeviceProfile, generation uint64, settings config.ProfileCache) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if generation != c.generation {
+		return
+	}
+	var expires time.Time
+	if ttl, err := time.ParseDuration(settings.TTL); err == nil && ttl > 0 {
+		expires = time.Now().Add(ttl)
+	}
+	entry := &deviceProfileCacheEntry{name: profile.Name, profile: cloneDeviceProfileDTO(profile), expires: expires}
+	if element, ok := c.entries[profile.Name]; ok {
+		element.Value = entry
+		c.lru.MoveToFront(element)
+	} else {
+		c.entries[profile.Name] = c.lru.PushFront(entry)
+	}
+	for c.lru.Len() > int(settings.MaxSize) {
+		c.remove(c.lru.Back())
+	}
+}
+
+// Invalidate evicts the device profile
+func (c *DeviceProfileCache) Invalidate(name string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generation++
+	if element, ok := c.entries[name]; ok {
+		c.remove(element)
+	}
+}
+
+// Hits returns the counter of the requests served from the cache
+func (c *DeviceProfileCache) Hits() gometrics.Counter {
+	return c.hits
+}
+
+// Misses returns the counter of the requests read from the database
+func (c *DeviceProfileCache) Misses() gometrics.Counter {
+	return c.misses
+}
+
+// cloneDeviceProfileDTO deep-copies the device profile, including the maps and pointers of the device resources and
+// device commands, so a request modifying the device profile it is served, e.g. tagging the unit meta, does not modify
+// the device profile cached for the other requests
+func cloneDeviceProfileDTO(p dtos.DeviceProfile) dtos.DeviceProfile {
+	p.Labels = slices.Clone(p.Labels)
+	if p.DeviceResources != nil {
+		resources := make([]dtos.DeviceResource, len(p.DeviceResources))
+		for i, r := range p.DeviceResources {
+			r.Attributes = cloneAnyMap(r.Attributes)
+			r.Tags = cloneAnyMap(r.Tags)
+			r.Properties.Optional = cloneAnyMap(r.Properties.Optional)
+			r.Properties.Minimum = clonePointer(r.Properties.Minimum)
+			r.Properties.Maximum = clonePointer(r.Properties.Maximum)
+			r.Properties.Mask = clonePointer(r.Properties.Mask)
+			r.Properties.Shift = clonePointer(r.Properties.Shift)
+			r.Properties.Scale = clonePointer(r.Properties.Scale)
+			r.Properties.Offset = clonePointer(r.Properties.Offset)
+			r.Properties.Base = clonePointer(r.Properties.Base)
+			resources[i] = r
+		}
+		p.DeviceResources = resources
+	}
+	if p.DeviceCommands != nil {
+		commands := make([]dtos.DeviceCommand, len(p.DeviceCommands))
+		for i, c := range p.DeviceCommands {
+			c.Tags = cloneAnyMap(c.Tags)
+			if c.ResourceOperations != nil {
+				operations := make([]dtos.ResourceOperation, len(c.ResourceOperations))
+				for j, o := range c.ResourceOperations {
+					o.Mappings = maps.Clone(o.Mappings)
+					operations[j] = o
+				}
+				c.ResourceOperations = operations
+			}
+			commands[i] = c
+		}
+		p.DeviceCommands = commands
+	}
+	return p
+}
+
+// cloneAnyMap copies the map along with the nested maps and slices of its values
+func cloneAnyMap(m map[string]any) map[string]any {
+	if m == nil {
+		return nil
+	}
+	cloned := make(map[string]any, len(m))
+	for k, v := range m {
+		cloned[k] = cloneAnyValue(v)
+	}
+	return cloned
+}
+
+func cloneAnyValue(v any) any {
+	switch value := v.(type) {
+	case map[string]any:
+		return cloneAnyMap(value)
+	case []any:
+		cloned := make([]any, len(value))
+		for i, e := range value {
+			cloned[i] = cloneAnyValue(e)
+		}
+		return cloned
+	}
+	return v
+}
+
+func clonePointer[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+func (c *DeviceProfileCache) remove(element *list.Element) {
+	delete(c.entries, element.Value.(*deviceProfileCacheEntry).name)
+	c.lru.Remove(element)
+}
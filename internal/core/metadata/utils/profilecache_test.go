@@ -0,0 +1,54 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+)
+
+func cacheTestProfile(name string) dtos.DeviceProfile {
+	return dtos.DeviceProfile{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: name}}
+}
+
+func TestDeviceProfileCache(t *testing.T) {
+	cache := NewDeviceProfileCache()
+	settings := config.ProfileCache{MaxSize: 2}
+
+	_, _, generation := cache.Get("a")
+	cache.Add(cacheTestProfile("a"), generation, settings)
+	cache.Add(cacheTestProfile("b"), generation, settings)
+	_, ok, _ := cache.Get("a")
+	require.True(t, ok)
+	cache.Add(cacheTestProfile("c"), generation, settings)
+	_, ok, _ = cache.Get("b")
+	assert.False(t, ok, "the least recently used profile should be evicted beyond the max size")
+	_, ok, _ = cache.Get("a")
+	assert.True(t, ok)
+
+	// the profile read before the invalidation should not be cached after it
+	_, _, generation = cache.Get("d")
+	cache.Invalidate("a")
+	cache.Add(cacheTestProfile("d"), generation, settings)
+	_, ok, _ = cache.Get("d")
+	assert.False(t, ok)
+	_, ok, _ = cache.Get("a")
+	assert.False(t, ok)
+
+	settings.TTL = "1ms"
+	_, _, generation = cache.Get("e")
+	cache.Add(cacheTestProfile("e"), generation, settings)
+	time.Sleep(5 * time.Millisecond)
+	_, ok, _ = cache.Get("e")
+	assert.False(t, ok, "the expired profile should not be served")
+	assert.Equal(t, int64(2), cache.hits.Count())
+}
@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import "sync"
+
+// DeviceResourceChange is a device profile update which modified the device resource, with only the changed fields.
+// The field names are the JSON names of the device resource, and the properties are prefixed with "properties.", e.g.
+// "properties.scale".
+type DeviceResourceChange struct {
+	Timestamp     int64                  `json:"timestamp"`
+	CorrelationId string                 `json:"correlationId,omitempty"`
+	Changes       map[string]FieldChange `json:"changes"`
+}
+
+// FieldChange is the value of a device resource field before and after the update, the absent value is omitted
+type FieldChange struct {
+	Old any `json:"old,omitempty"`
+	New any `json:"new,omitempty"`
+}
+
+// DeviceResourceHistory keeps the latest changes of each device resource in memory, the changes are lost on restart
+type DeviceResourceHistory struct {
+	mutex   sync.RWMutex
+	changes map[string]map[string][]DeviceResourceChange
+}
+
+// NewDeviceResourceHistory returns an empty device resource history
+func NewDeviceResourceHistory() *DeviceResourceHistory {
+	return &DeviceResourceHistory{changes: make(map[string]map[string][]DeviceResourceChange)}
+}
+
+// Append appends the change of the device resource, and drops the oldest changes beyond the limit
+func (h *DeviceResourceHistory) Append(profileName, resourceName string, change DeviceResourceChange, limit int) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	resources, ok := h.changes[profileName]
+	if !ok {
+		resources = make(map[string][]DeviceResourceChange)
+		h.changes[profileName] = resources
+	}
+	changes := append(resources[resourceName], change)
+	if len(changes) > limit {
+		changes = append([]DeviceResourceChange(nil), changes[len(changes)-limit:]...)
+	}
+	resources[resourceName] = changes
+}
+
+// Get returns a copy of the changes of the device resource, oldest first
+func (h *DeviceResourceHistory) Get(profileName, resourceName string) []DeviceResourceChange {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	return append([]DeviceResourceChange{}, h.changes[profileName][resourceName]...)
+}
+
+// RemoveProfile drops the changes of the device resources of the deleted device profile
+func (h *DeviceResourceHistory) RemoveProfile(profileName string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	delete(h.changes, profileName)
+}
@@ -20,11 +20,14 @@ const (
 	configTableName               = coreKeeperSchema + ".config"
 	eventTableName                = coreDataSchema + ".event"
 	deviceInfoTableName           = coreDataSchema + ".device_info"
+	deadLetterTableName           = supportNotificationsSchema + ".dead_letter"
+	dispatchAuditTableName        = supportNotificationsSchema + ".dispatch_audit"
 	deviceServiceTableName        = coreMetaDataSchema + ".device_service"
 	deviceProfileTableName        = coreMetaDataSchema + ".device_profile"
 	deviceTableName               = coreMetaDataSchema + ".device"
 	provisionWatcherTableName     = coreMetaDataSchema + ".provision_watcher"
 	notificationTableName         = supportNotificationsSchema + ".notification"
+	notificationTemplateTableName = supportNotificationsSchema + ".notification_template"
 	readingTableName              = coreDataSchema + ".reading"
 	registryTableName             = coreKeeperSchema + ".registry"
 	scheduleActionRecordTableName = supportSchedulerSchema + ".record"
@@ -78,12 +81,14 @@ const (
 // constants relate to the notification postgres db table column names
 const (
 	notificationIdCol = "notification_id"
+	sequenceCol       = "sequence"
 )
 
 // constants relate to the field names in the content column
 const (
 	categoryField         = "Category"
 	categoriesField       = "Categories"
+	contentField          = "Content"
 	createdField          = "Created"
 	labelsField           = "Labels"
 	parentField           = "Parent"
@@ -93,6 +98,7 @@ const (
 	notificationIdField   = "NotificationId"
 	profileNameField      = "ProfileName"
 	receiverField         = "Receiver"
+	senderField           = "Sender"
 	serviceIdField        = "ServiceId"
 	serviceNameField      = "ServiceName"
 	statusField           = "Status"
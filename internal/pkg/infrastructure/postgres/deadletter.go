@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// AddDeadLetter adds a new dead letter to the database
+func (c *Client) AddDeadLetter(d notificationModels.DeadLetter) (notificationModels.DeadLetter, errors.EdgeX) {
+	if len(d.Id) == 0 {
+		d.Id = uuid.New().String()
+	}
+	if d.Created == 0 {
+		d.Created = time.Now().UTC().UnixMilli()
+	}
+	dataBytes, err := json.Marshal(d)
+	if err != nil {
+		return d, errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal DeadLetter model", err)
+	}
+
+	_, err = c.ConnPool.Exec(context.Background(), sqlInsert(deadLetterTableName, idCol, contentCol), d.Id, dataBytes)
+	if err != nil {
+		return d, pgClient.WrapDBError("failed to insert row to dead_letter table", err)
+	}
+	return d, nil
+}
+
+// DeadLetterById queries the dead letter by id
+func (c *Client) DeadLetterById(id string) (notificationModels.DeadLetter, errors.EdgeX) {
+	var d notificationModels.DeadLetter
+	row := c.ConnPool.QueryRow(context.Background(), sqlQueryContentById(deadLetterTableName), id)
+	if err := row.Scan(&d); err != nil {
+		return d, pgClient.WrapDBError(fmt.Sprintf("failed to query dead letter by id '%s'", id), err)
+	}
+	return d, nil
+}
+
+// AllDeadLetters queries the dead letters from the latest one with the given offset, and limit
+func (c *Client) AllDeadLetters(offset, limit int) ([]notificationModels.DeadLetter, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryContentWithPaginationDescByCol(deadLetterTableName, createdCol), offset, validLimit)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from dead_letter table", err)
+	}
+	deadLetters, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (notificationModels.DeadLetter, error) {
+		var d notificationModels.DeadLetter
+		scanErr := row.Scan(&d)
+		return d, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to DeadLetter model", err)
+	}
+	return deadLetters, nil
+}
+
+// DeadLetterTotalCount returns the total count of dead letters
+func (c *Client) DeadLetterTotalCount() (uint32, errors.EdgeX) {
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCount(deadLetterTableName))
+}
+
+// DeleteDeadLetterById deletes the dead letter by id
+func (c *Client) DeleteDeadLetterById(id string) errors.EdgeX {
+	result, err := c.ConnPool.Exec(context.Background(), sqlDeleteById(deadLetterTableName), id)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete dead letter by id '%s'", id), err)
+	}
+	if result.RowsAffected() == 0 {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("dead letter id '%s' does not exist", id), nil)
+	}
+	return nil
+}
+
+// CleanupDeadLettersByAge deletes the dead letters that are older than age
+func (c *Client) CleanupDeadLettersByAge(age int64) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByAge(deadLetterTableName), age)
+	if err != nil {
+		return pgClient.WrapDBError("failed to cleanup dead letters by age", err)
+	}
+	return nil
+}
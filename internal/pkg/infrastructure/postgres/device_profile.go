@@ -53,6 +53,51 @@ func (c *Client) AddDeviceProfile(dp model.DeviceProfile) (model.DeviceProfile,
 	return dp, nil
 }
 
+// AddDeviceProfiles adds the device profiles in one transaction, so either all or none of them are added
+func (c *Client) AddDeviceProfiles(dps []model.DeviceProfile) ([]model.DeviceProfile, errors.EdgeX) {
+	ctx := context.Background()
+	added := make([]model.DeviceProfile, len(dps))
+	timestamp := pkgCommon.MakeTimestamp()
+
+	var edgeXErr errors.EdgeX
+	err := pgx.BeginFunc(ctx, c.ConnPool, func(tx pgx.Tx) error {
+		for i, dp := range dps {
+			if len(dp.Id) == 0 {
+				dp.Id = uuid.New().String()
+			}
+			var exists bool
+			queryObj := map[string]any{nameField: dp.Name}
+			if err := tx.QueryRow(ctx, sqlCheckExistsByJSONField(deviceProfileTableName), queryObj).Scan(&exists); err != nil {
+				return err
+			}
+			if exists {
+				edgeXErr = errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device profile name %s already exists", dp.Name), nil)
+				return edgeXErr
+			}
+
+			dp.Created = timestamp
+			dp.Modified = timestamp
+			deviceProfileJSONBytes, err := json.Marshal(dp)
+			if err != nil {
+				edgeXErr = errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device profile for Postgres persistence", err)
+				return edgeXErr
+			}
+			if _, err = tx.Exec(ctx, sqlInsert(deviceProfileTableName, idCol, contentCol), dp.Id, deviceProfileJSONBytes); err != nil {
+				return err
+			}
+			added[i] = dp
+		}
+		return nil
+	})
+	if edgeXErr != nil {
+		return nil, edgeXErr
+	}
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to insert device profiles", err)
+	}
+	return added, nil
+}
+
 // UpdateDeviceProfile updates a new device profile
 func (c *Client) UpdateDeviceProfile(dp model.DeviceProfile) errors.EdgeX {
 	ctx := context.Background()
@@ -186,6 +231,22 @@ func (c *Client) DeviceProfilesByManufacturerAndModel(offset int, limit int, man
 	return queryDeviceProfiles(ctx, c.ConnPool, sqlQueryContentByJSONFieldWithPagination(deviceProfileTableName), queryObj, offset, validLimit)
 }
 
+// DeviceProfilesByNameContains query device profiles with offset, limit, labels and the text contained in the name
+// case-insensitively
+func (c *Client) DeviceProfilesByNameContains(offset int, limit int, nameContains string, labels []string) ([]model.DeviceProfile, errors.EdgeX) {
+	ctx := context.Background()
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	queryObj := map[string]any{}
+	if len(labels) > 0 {
+		queryObj[labelsField] = labels
+	}
+	profiles, err := queryDeviceProfiles(ctx, c.ConnPool, sqlQueryContentByJSONFieldAndJSONFieldLikeWithPagination(deviceProfileTableName, nameField), queryObj, containsLikePattern(nameContains), offset, validLimit)
+	if err != nil {
+		return profiles, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query device profiles by name containing %s", nameContains), err)
+	}
+	return profiles, nil
+}
+
 // DeviceProfileCountByLabels returns the total count of Device Profiles with labels specified.  If no label is specified, the total count of all device profiles will be returned.
 func (c *Client) DeviceProfileCountByLabels(labels []string) (uint32, errors.EdgeX) {
 	ctx := context.Background()
@@ -197,6 +258,16 @@ func (c *Client) DeviceProfileCountByLabels(labels []string) (uint32, errors.Edg
 	return getTotalRowsCount(ctx, c.ConnPool, sqlQueryCount(deviceProfileTableName))
 }
 
+// DeviceProfileCountByNameContains returns the count of Device Profiles with labels specified and the text contained in
+// the name case-insensitively
+func (c *Client) DeviceProfileCountByNameContains(nameContains string, labels []string) (uint32, errors.EdgeX) {
+	queryObj := map[string]any{}
+	if len(labels) > 0 {
+		queryObj[labelsField] = labels
+	}
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCountByJSONFieldAndJSONFieldLike(deviceProfileTableName, nameField), queryObj, containsLikePattern(nameContains))
+}
+
 // DeviceProfileCountByManufacturer returns the count of Device Profiles associated with specified manufacturer
 func (c *Client) DeviceProfileCountByManufacturer(manufacturer string) (uint32, errors.EdgeX) {
 	ctx := context.Background()
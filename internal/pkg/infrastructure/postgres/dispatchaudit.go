@@ -0,0 +1,67 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// AddDispatchAuditRecord appends a dispatch audit record of a notification to the database, which fails with
+// KindDuplicateName if the notification already has a record of the same sequence
+func (c *Client) AddDispatchAuditRecord(r notificationModels.DispatchAuditRecord) errors.EdgeX {
+	if len(r.Id) == 0 {
+		r.Id = uuid.New().String()
+	}
+	dataBytes, err := json.Marshal(r)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal DispatchAuditRecord model", err)
+	}
+
+	_, err = c.ConnPool.Exec(context.Background(), sqlInsert(dispatchAuditTableName, idCol, notificationIdCol, sequenceCol, contentCol),
+		r.Id, r.NotificationId, r.Sequence, dataBytes)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to insert the dispatch audit record %d of notification '%s' to dispatch_audit table", r.Sequence, r.NotificationId), err)
+	}
+	return nil
+}
+
+// DispatchAuditRecordsByNotificationId queries the dispatch audit records of a notification in the order of the sequence
+func (c *Client) DispatchAuditRecordsByNotificationId(notificationId string) ([]notificationModels.DispatchAuditRecord, errors.EdgeX) {
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryContentByColOrderByCol(dispatchAuditTableName, notificationIdCol, sequenceCol, false), notificationId)
+	if err != nil {
+		return nil, pgClient.WrapDBError(fmt.Sprintf("failed to query dispatch audit records by notification id '%s'", notificationId), err)
+	}
+
+	records, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (notificationModels.DispatchAuditRecord, error) {
+		var r notificationModels.DispatchAuditRecord
+		scanErr := row.Scan(&r)
+		return r, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to DispatchAuditRecord model", err)
+	}
+	return records, nil
+}
+
+// LatestDispatchAuditRecordByNotificationId queries the dispatch audit record of a notification with the greatest sequence
+func (c *Client) LatestDispatchAuditRecordByNotificationId(notificationId string) (notificationModels.DispatchAuditRecord, errors.EdgeX) {
+	var r notificationModels.DispatchAuditRecord
+	row := c.ConnPool.QueryRow(context.Background(), sqlQueryContentByColOrderByCol(dispatchAuditTableName, notificationIdCol, sequenceCol, true)+" LIMIT 1", notificationId)
+	if err := row.Scan(&r); err != nil {
+		return r, pgClient.WrapDBError(fmt.Sprintf("failed to query the latest dispatch audit record by notification id '%s'", notificationId), err)
+	}
+	return r, nil
+}
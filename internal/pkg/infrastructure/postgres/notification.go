@@ -9,6 +9,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -111,6 +113,55 @@ func (c *Client) NotificationsByStatus(offset, limit int, ack, status string) ([
 	return notifications, nil
 }
 
+// NotificationsBySender queries the notification by sender
+func (c *Client) NotificationsBySender(offset, limit int, sender string) ([]models.Notification, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	queryObj := map[string]any{senderField: sender}
+
+	notifications, err := queryNotifications(context.Background(), c.ConnPool, sqlQueryContentByJSONFieldWithPagination(notificationTableName), queryObj, offset, validLimit)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query all notifications by sender %s", sender), err)
+	}
+
+	return notifications, nil
+}
+
+// contentSearchScanWarning warns once that the notification content search scans the notification table
+var contentSearchScanWarning sync.Once
+
+// NotificationsByContentSearch queries the notifications whose content contains the query case-insensitively
+func (c *Client) NotificationsByContentSearch(offset, limit int, query string) ([]models.Notification, errors.EdgeX) {
+	c.warnContentSearchScan()
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	notifications, err := queryNotifications(context.Background(), c.ConnPool, sqlQueryContentByJSONFieldLikeWithPagination(notificationTableName, contentField), containsLikePattern(query), offset, validLimit)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query all notifications by content search %s", query), err)
+	}
+
+	return notifications, nil
+}
+
+// NotificationCountByContentSearch returns the count of the notifications whose content contains the query case-insensitively
+func (c *Client) NotificationCountByContentSearch(query string) (uint32, errors.EdgeX) {
+	c.warnContentSearchScan()
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCountByJSONFieldLike(notificationTableName, contentField), containsLikePattern(query))
+}
+
+// warnContentSearchScan warns the operators that the content search isn't backed by a full-text index, so each search
+// scans the notification table
+func (c *Client) warnContentSearchScan() {
+	contentSearchScanWarning.Do(func() {
+		c.loggingClient.Warn("the notification content search is a case-insensitive LIKE scan of the notification table without a full-text index, which slows down as the notifications grow")
+	})
+}
+
+// containsLikePattern returns the LIKE pattern matching the values containing the literal text, the LIKE wildcards in
+// the text are escaped
+func containsLikePattern(text string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(text)
+	return "%" + escaped + "%"
+}
+
 // NotificationsByTimeRange queries the notification by time range
 func (c *Client) NotificationsByTimeRange(start int64, end int64, offset, limit int, ack string) ([]models.Notification, errors.EdgeX) {
 	return notificationsByTimeRange(c.ConnPool, start, end, offset, limit, ack)
@@ -118,9 +169,9 @@ func (c *Client) NotificationsByTimeRange(start int64, end int64, offset, limit
 
 // DeleteNotificationById deletes the notification by id
 func (c *Client) DeleteNotificationById(id string) errors.EdgeX {
-	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteById(notificationTableName), id)
+	err := c.deleteNotificationsWithTransmissions(fmt.Sprintf("%s = $1", idCol), id)
 	if err != nil {
-		return pgClient.WrapDBError(fmt.Sprintf("failed to delete notification by id %s", id), err)
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete notification by id %s", id), err)
 	}
 
 	return nil
@@ -151,9 +202,32 @@ func (c *Client) UpdateNotification(n models.Notification) errors.EdgeX {
 
 // CleanupNotificationsByAge deletes the notifications that are older than a specific age
 func (c *Client) CleanupNotificationsByAge(age int64) errors.EdgeX {
-	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByContentAge(notificationTableName), age)
+	err := c.deleteNotificationsWithTransmissions(contentAgeCondition(1), age)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), "failed to cleanup notifications by age", err)
+	}
+
+	return nil
+}
+
+// CleanupNotificationsByCategoryAndAge deletes the notifications of the category that are older than a specific age
+func (c *Client) CleanupNotificationsByCategoryAndAge(category string, age int64) errors.EdgeX {
+	queryObj := map[string]any{categoryField: category}
+	err := c.deleteNotificationsWithTransmissions("content @> $1::jsonb AND "+contentAgeCondition(2), queryObj, age)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to cleanup notifications by category '%s' and age", category), err)
+	}
+
+	return nil
+}
+
+// CleanupNotificationsByAgeExcludingCategories deletes the notifications that are older than a specific age, except the
+// notifications of the excluded categories
+func (c *Client) CleanupNotificationsByAgeExcludingCategories(age int64, categories []string) errors.EdgeX {
+	condition := fmt.Sprintf("COALESCE(content->>'%s', '') <> ALL($2)", categoryField)
+	err := c.deleteNotificationsWithTransmissions(condition+" AND "+contentAgeCondition(1), age, categories)
 	if err != nil {
-		return pgClient.WrapDBError("failed to cleanup notifications by age", err)
+		return errors.NewCommonEdgeX(errors.Kind(err), "failed to cleanup notifications by age excluding categories", err)
 	}
 
 	return nil
@@ -162,21 +236,37 @@ func (c *Client) CleanupNotificationsByAge(age int64) errors.EdgeX {
 // DeleteProcessedNotificationsByAge deletes the processed notifications that are older than a specific age
 func (c *Client) DeleteProcessedNotificationsByAge(age int64) errors.EdgeX {
 	queryObj := map[string]any{statusField: models.Processed}
-	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByJSONFieldAndAge(notificationTableName), queryObj, age)
+	err := c.deleteNotificationsWithTransmissions("content @> $1::jsonb AND "+contentAgeCondition(2), queryObj, age)
 	if err != nil {
-		return pgClient.WrapDBError("failed to delete processed notifications by age", err)
+		return errors.NewCommonEdgeX(errors.Kind(err), "failed to delete processed notifications by age", err)
 	}
 
 	return nil
 }
 
-// DeleteNotificationByIds deletes the notification by ids
+// DeleteNotificationByIds deletes the notifications by ids along with their transmissions in one transaction
 func (c *Client) DeleteNotificationByIds(ids []string) errors.EdgeX {
-	for _, id := range ids {
-		err := c.DeleteNotificationById(id)
-		if err != nil {
-			return errors.NewCommonEdgeXWrapper(err)
+	err := c.deleteNotificationsWithTransmissions(fmt.Sprintf("%s = ANY($1::uuid[])", idCol), ids)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete notifications by ids %v", ids), err)
+	}
+	return nil
+}
+
+// deleteNotificationsWithTransmissions deletes the notifications matching the where condition along with their
+// transmissions in one transaction
+func (c *Client) deleteNotificationsWithTransmissions(condition string, args ...any) errors.EdgeX {
+	ctx := context.Background()
+	err := pgx.BeginFunc(ctx, c.ConnPool, func(tx pgx.Tx) error {
+		for _, sql := range sqlDeleteNotificationsWithTransmissions(condition) {
+			if _, err := tx.Exec(ctx, sql, args...); err != nil {
+				return err
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return pgClient.WrapDBError("failed to delete notifications with their transmissions", err)
 	}
 	return nil
 }
@@ -229,6 +319,12 @@ func (c *Client) NotificationCountByStatus(status string, ack string) (uint32, e
 	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCountByJSONField(notificationTableName), queryObj)
 }
 
+// NotificationCountBySender returns the count of notifications by sender
+func (c *Client) NotificationCountBySender(sender string) (uint32, errors.EdgeX) {
+	queryObj := map[string]any{senderField: sender}
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCountByJSONField(notificationTableName), queryObj)
+}
+
 // NotificationCountByTimeRange returns the count of notifications by time range
 func (c *Client) NotificationCountByTimeRange(start int64, end int64, ack string) (uint32, errors.EdgeX) {
 	notifications, err := notificationsByTimeRange(c.ConnPool, start, end, 0, -1, ack)
@@ -271,6 +367,29 @@ func (c *Client) LatestNotificationByOffset(offset uint32) (models.Notification,
 	return notification, nil
 }
 
+// LatestNotificationByCategoryAndOffset returns the notification of the category at the offset from the latest one
+func (c *Client) LatestNotificationByCategoryAndOffset(category string, offset uint32) (models.Notification, errors.EdgeX) {
+	queryObj := map[string]any{categoryField: category}
+	notification, err := queryNotification(context.Background(), c.ConnPool, sqlQueryLatestContentByJSONFieldWithPagination(notificationTableName), queryObj, offset, 1)
+	if err != nil {
+		return notification, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query latest notification of the category '%s' by offset", category), err)
+	}
+
+	return notification, nil
+}
+
+// LatestNotificationByOffsetExcludingCategories returns the notification at the offset from the latest one, except the
+// notifications of the excluded categories
+func (c *Client) LatestNotificationByOffsetExcludingCategories(offset uint32, categories []string) (models.Notification, errors.EdgeX) {
+	notification, err := queryNotification(context.Background(), c.ConnPool,
+		sqlQueryLatestContentExcludingJSONFieldValuesWithPagination(notificationTableName, categoryField), categories, offset, 1)
+	if err != nil {
+		return notification, errors.NewCommonEdgeX(errors.Kind(err), "failed to query latest notification by offset excluding categories", err)
+	}
+
+	return notification, nil
+}
+
 func queryNotification(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) (models.Notification, errors.EdgeX) {
 	var notification models.Notification
 	row := connPool.QueryRow(ctx, sql, args...)
@@ -0,0 +1,123 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// AddNotificationTemplate adds a new notification template to the database
+func (c *Client) AddNotificationTemplate(t notificationModels.NotificationTemplate) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	ctx := context.Background()
+	if len(t.Id) == 0 {
+		t.Id = uuid.New().String()
+	}
+
+	var exists bool
+	queryObj := map[string]any{nameField: t.Name}
+	err := c.ConnPool.QueryRow(ctx, sqlCheckExistsByJSONField(notificationTemplateTableName), queryObj).Scan(&exists)
+	if err != nil {
+		return t, pgClient.WrapDBError(fmt.Sprintf("failed to query row by name '%s' from notification_template table", t.Name), err)
+	}
+	if exists {
+		return t, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("notification template name %s already exists", t.Name), nil)
+	}
+
+	timestamp := time.Now().UTC().UnixMilli()
+	t.Created = timestamp
+	t.Modified = timestamp
+	dataBytes, err := json.Marshal(t)
+	if err != nil {
+		return t, errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal NotificationTemplate model", err)
+	}
+
+	_, err = c.ConnPool.Exec(ctx, sqlInsert(notificationTemplateTableName, idCol, contentCol), t.Id, dataBytes)
+	if err != nil {
+		return t, pgClient.WrapDBError("failed to insert row to notification_template table", err)
+	}
+	return t, nil
+}
+
+// NotificationTemplateByName queries the notification template by name
+func (c *Client) NotificationTemplateByName(name string) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	queryObj := map[string]any{nameField: name}
+	template, err := queryNotificationTemplate(context.Background(), c.ConnPool, sqlQueryContentByJSONField(notificationTemplateTableName), queryObj)
+	if err != nil {
+		return template, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query notification template by name %s", name), err)
+	}
+	return template, nil
+}
+
+// AllNotificationTemplates queries the notification templates with the given offset, and limit
+func (c *Client) AllNotificationTemplates(offset, limit int) ([]notificationModels.NotificationTemplate, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryContentWithPagination(notificationTemplateTableName), offset, validLimit)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from notification_template table", err)
+	}
+	templates, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (notificationModels.NotificationTemplate, error) {
+		var t notificationModels.NotificationTemplate
+		scanErr := row.Scan(&t)
+		return t, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to NotificationTemplate model", err)
+	}
+	return templates, nil
+}
+
+// UpdateNotificationTemplate updates the notification template
+func (c *Client) UpdateNotificationTemplate(t notificationModels.NotificationTemplate) errors.EdgeX {
+	t.Modified = time.Now().UTC().UnixMilli()
+	dataBytes, err := json.Marshal(t)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal NotificationTemplate model", err)
+	}
+
+	_, err = c.ConnPool.Exec(context.Background(), sqlUpdateContentById(notificationTemplateTableName), dataBytes, t.Id)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to update row by notification template id '%s' from notification_template table", t.Id), err)
+	}
+	return nil
+}
+
+// DeleteNotificationTemplateByName deletes the notification template by name
+func (c *Client) DeleteNotificationTemplateByName(name string) errors.EdgeX {
+	queryObj := map[string]any{nameField: name}
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByJSONField(notificationTemplateTableName), queryObj)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete notification template by name %s", name), err)
+	}
+	return nil
+}
+
+// NotificationTemplateTotalCount returns the total count of notification templates
+func (c *Client) NotificationTemplateTotalCount() (uint32, errors.EdgeX) {
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCount(notificationTemplateTableName))
+}
+
+func queryNotificationTemplate(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	var template notificationModels.NotificationTemplate
+	row := connPool.QueryRow(ctx, sql, args...)
+
+	if err := row.Scan(&template); err != nil {
+		return template, pgClient.WrapDBError("failed to query notification template", err)
+	}
+	return template, nil
+}
@@ -227,6 +227,16 @@ func sqlQueryContentById(table string) string {
 	return fmt.Sprintf("SELECT content FROM %s WHERE %s = $1", table, idCol)
 }
 
+// sqlQueryContentByColOrderByCol returns the SQL statement for selecting content column in the table by the given column
+// in the order of orderCol, which is descending if desc is true
+func sqlQueryContentByColOrderByCol(table string, column string, orderCol string, desc bool) string {
+	order := "ASC"
+	if desc {
+		order = "DESC"
+	}
+	return fmt.Sprintf("SELECT content FROM %s WHERE %s = $1 ORDER BY %s %s", table, column, orderCol, order)
+}
+
 // sqlQueryContent returns the SQL statement for selecting content column in the table for all entries
 func sqlQueryContent(table string) string {
 	return fmt.Sprintf("SELECT content FROM %s", table)
@@ -237,6 +247,12 @@ func sqlQueryContentWithPagination(table string) string {
 	return fmt.Sprintf("SELECT content FROM %s ORDER BY COALESCE((content->>'%s')::bigint, 0) OFFSET $1 LIMIT $2", table, createdField)
 }
 
+// sqlQueryContentWithPaginationDescByCol returns the SQL statement for selecting content column from the table with
+// pagination and desc by descCol
+func sqlQueryContentWithPaginationDescByCol(table string, descCol string) string {
+	return fmt.Sprintf("SELECT content FROM %s ORDER BY %s DESC OFFSET $1 LIMIT $2", table, descCol)
+}
+
 // sqlQueryContentWithTimeRangeAndPagination returns the SQL statement for selecting content column from the table by the given time range with pagination
 func sqlQueryContentWithTimeRangeAndPagination(table string) string {
 	return fmt.Sprintf("SELECT content FROM %s WHERE COALESCE((content->>'%s')::bigint, 0) BETWEEN $1 AND $2 AND content @> $3::jsonb ORDER BY COALESCE((content->>'%s')::bigint, 0) OFFSET $4 LIMIT $5", table, createdField, createdField)
@@ -247,11 +263,33 @@ func sqlQueryContentByJSONField(table string) string {
 	return fmt.Sprintf("SELECT content FROM %s WHERE content @> $1::jsonb", table)
 }
 
+// sqlQueryContentByJSONFieldLikeWithPagination returns the SQL statement for selecting content column in the table by
+// the case-insensitive LIKE pattern of the given JSON field with pagination
+func sqlQueryContentByJSONFieldLikeWithPagination(table string, field string) string {
+	return fmt.Sprintf("SELECT content FROM %s WHERE content->>'%s' ILIKE $1 ORDER BY COALESCE((content->>'%s')::bigint, 0) OFFSET $2 LIMIT $3", table, field, createdField)
+}
+
+// sqlQueryContentByJSONFieldAndJSONFieldLikeWithPagination returns the SQL statement for selecting content column in
+// the table by the given JSON query string and the case-insensitive LIKE pattern of the given JSON field with pagination
+func sqlQueryContentByJSONFieldAndJSONFieldLikeWithPagination(table string, field string) string {
+	return fmt.Sprintf("SELECT content FROM %s WHERE content @> $1::jsonb AND content->>'%s' ILIKE $2 ORDER BY COALESCE((content->>'%s')::bigint, 0) OFFSET $3 LIMIT $4", table, field, createdField)
+}
+
 // sqlQueryContentByJSONFieldWithPagination returns the SQL statement for selecting content column in the table by the given JSON query string with pagination
 func sqlQueryContentByJSONFieldWithPagination(table string) string {
 	return fmt.Sprintf("SELECT content FROM %s WHERE content @> $1::jsonb ORDER BY COALESCE((content->>'%s')::bigint, 0) OFFSET $2 LIMIT $3", table, createdField)
 }
 
+// sqlQueryLatestContentByJSONFieldWithPagination returns the SQL statement for selecting content column by the given JSON query string from the latest created with pagination
+func sqlQueryLatestContentByJSONFieldWithPagination(table string) string {
+	return fmt.Sprintf("SELECT content FROM %s WHERE content @> $1::jsonb ORDER BY COALESCE((content->>'%s')::bigint, 0) DESC OFFSET $2 LIMIT $3", table, createdField)
+}
+
+// sqlQueryLatestContentExcludingJSONFieldValuesWithPagination returns the SQL statement for selecting content column whose JSON field is none of the given values from the latest created with pagination
+func sqlQueryLatestContentExcludingJSONFieldValuesWithPagination(table string, field string) string {
+	return fmt.Sprintf("SELECT content FROM %s WHERE COALESCE(content->>'%s', '') <> ALL($1) ORDER BY COALESCE((content->>'%s')::bigint, 0) DESC OFFSET $2 LIMIT $3", table, field, createdField)
+}
+
 // sqlQueryContentByJSONFieldTimeRange returns the SQL statement for selecting content column by the given time range of the JSON field name
 //func sqlQueryContentByJSONFieldTimeRange(table string, field string) string {
 //	return fmt.Sprintf("SELECT content FROM %s WHERE (content->'%s')::bigint  >= $1 AND (content->'%s')::bigint <= $2 ORDER BY %s OFFSET $3 LIMIT $4", table, field, field, createdCol)
@@ -317,6 +355,18 @@ func sqlQueryCountByColAndLikePat(table string, columns ...string) string {
 	return fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", table, whereCondition)
 }
 
+// sqlQueryCountByJSONFieldLike returns the SQL statement for counting the number of rows in the table by the
+// case-insensitive LIKE pattern of the given JSON field
+func sqlQueryCountByJSONFieldLike(table string, field string) string {
+	return fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE content->>'%s' ILIKE $1", table, field)
+}
+
+// sqlQueryCountByJSONFieldAndJSONFieldLike returns the SQL statement for counting the number of rows in the table by
+// the given JSON query string and the case-insensitive LIKE pattern of the given JSON field
+func sqlQueryCountByJSONFieldAndJSONFieldLike(table string, field string) string {
+	return fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE content @> $1::jsonb AND content->>'%s' ILIKE $2", table, field)
+}
+
 // sqlQueryCountByJSONField returns the SQL statement for counting the number of rows in the table by the given JSON query string
 func sqlQueryCountByJSONField(table string) string {
 	return fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE content @> $1::jsonb", table)
@@ -389,26 +439,32 @@ func sqlDeleteByAge(table string) string {
 	return fmt.Sprintf("DELETE FROM %s WHERE %s < NOW() - INTERVAL '1 millisecond' * $1", table, createdCol)
 }
 
-// sqlDeleteByContentAge returns the SQL statement for deleting rows from the table by created timestamp from content column.
-func sqlDeleteByContentAge(table string) string {
-	return fmt.Sprintf("DELETE FROM %s WHERE COALESCE((content->>'%s')::bigint, 0) < (EXTRACT(EPOCH FROM NOW()) * 1000)::bigint - $1", table, createdField)
-}
-
 // sqlDeleteByContentAgeWithConds returns the SQL statement for deleting rows from the table by created timestamp from content column with the given where condition.
 func sqlDeleteByContentAgeWithConds(table string, condition string) string {
 	return fmt.Sprintf("DELETE FROM %s WHERE %s AND COALESCE((content->>'%s')::bigint, 0) < (EXTRACT(EPOCH FROM NOW()) * 1000)::bigint - $1", table, condition, createdField)
 }
 
+// sqlDeleteNotificationsWithTransmissions returns the SQL statements for deleting the notifications matching the where
+// condition along with their transmissions, which are deleted first. The statements must run in one transaction, so no
+// orphan transmission is left even if the foreign key of the transmission table doesn't cascade the deletion.
+func sqlDeleteNotificationsWithTransmissions(condition string) []string {
+	return []string{
+		fmt.Sprintf("DELETE FROM %s WHERE %s IN (SELECT %s FROM %s WHERE %s)", transmissionTableName, notificationIdCol, idCol, notificationTableName, condition),
+		fmt.Sprintf("DELETE FROM %s WHERE %s", notificationTableName, condition),
+	}
+}
+
+// contentAgeCondition returns the where condition of the rows whose created timestamp from content column is older than
+// the age, in milliseconds, of the given parameter number
+func contentAgeCondition(param int) string {
+	return fmt.Sprintf("COALESCE((content->>'%s')::bigint, 0) < (EXTRACT(EPOCH FROM NOW()) * 1000)::bigint - $%d", createdField, param)
+}
+
 // sqlDeleteByJSONField returns the SQL statement for deleting rows from the table by the given JSON query string
 func sqlDeleteByJSONField(table string) string {
 	return fmt.Sprintf("DELETE FROM %s WHERE content @> $1::jsonb", table)
 }
 
-// sqlDeleteByJSONFieldAndAge returns the SQL statement for deleting rows from the table by the given column and created timestamp.
-func sqlDeleteByJSONFieldAndAge(table string) string {
-	return fmt.Sprintf("DELETE FROM %s WHERE content @> $1::jsonb AND COALESCE((content->>'%s')::bigint, 0) < (EXTRACT(EPOCH FROM NOW()) * 1000)::bigint - $2", table, createdField)
-}
-
 // sqlDeleteTimeRangeByColumn returns the SQL statement for deleting rows from the table by time range with the specified column
 // the time range is calculated from the caller function since the interval unit might be different
 func sqlDeleteTimeRangeByColumn(table string, upperLimitTimeRangeCol string, cols ...string) string {
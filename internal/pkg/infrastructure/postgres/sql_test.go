@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqlDeleteNotificationsWithTransmissions(t *testing.T) {
+	conditions := []string{
+		fmt.Sprintf("%s = $1", idCol),
+		fmt.Sprintf("%s = ANY($1::uuid[])", idCol),
+		contentAgeCondition(1),
+		"content @> $1::jsonb AND " + contentAgeCondition(2),
+	}
+	for _, condition := range conditions {
+		t.Run(condition, func(t *testing.T) {
+			statements := sqlDeleteNotificationsWithTransmissions(condition)
+			require.Len(t, statements, 2)
+			// the transmissions of the matching notifications are deleted before the notifications, so no orphan
+			// transmission is left once the notifications are gone
+			assert.Equal(t,
+				fmt.Sprintf("DELETE FROM %s WHERE %s IN (SELECT %s FROM %s WHERE %s)", transmissionTableName, notificationIdCol, idCol, notificationTableName, condition),
+				statements[0])
+			assert.Equal(t, fmt.Sprintf("DELETE FROM %s WHERE %s", notificationTableName, condition), statements[1])
+		})
+	}
+}
+
+func TestContentAgeCondition(t *testing.T) {
+	assert.Equal(t, "COALESCE((content->>'Created')::bigint, 0) < (EXTRACT(EPOCH FROM NOW()) * 1000)::bigint - $2", contentAgeCondition(2))
+}
+
+func TestSqlQueryByJSONFieldAndJSONFieldLike(t *testing.T) {
+	assert.Equal(t,
+		"SELECT content FROM core_metadata.device_profile WHERE content @> $1::jsonb AND content->>'Name' ILIKE $2 ORDER BY COALESCE((content->>'Created')::bigint, 0) OFFSET $3 LIMIT $4",
+		sqlQueryContentByJSONFieldAndJSONFieldLikeWithPagination(deviceProfileTableName, nameField))
+	assert.Equal(t,
+		"SELECT COUNT(*) FROM core_metadata.device_profile WHERE content @> $1::jsonb AND content->>'Name' ILIKE $2",
+		sqlQueryCountByJSONFieldAndJSONFieldLike(deviceProfileTableName, nameField))
+}
+
+func TestSqlQueryLatestContent(t *testing.T) {
+	assert.Equal(t,
+		"SELECT content FROM support_notifications.notification WHERE content @> $1::jsonb ORDER BY COALESCE((content->>'Created')::bigint, 0) DESC OFFSET $2 LIMIT $3",
+		sqlQueryLatestContentByJSONFieldWithPagination(notificationTableName))
+	assert.Equal(t,
+		"SELECT content FROM support_notifications.notification WHERE COALESCE(content->>'Category', '') <> ALL($1) ORDER BY COALESCE((content->>'Created')::bigint, 0) DESC OFFSET $2 LIMIT $3",
+		sqlQueryLatestContentExcludingJSONFieldValuesWithPagination(notificationTableName, categoryField))
+}
+
+func TestSqlQueryContentWithPaginationDescByCol(t *testing.T) {
+	assert.Equal(t,
+		"SELECT content FROM support_notifications.dead_letter ORDER BY created DESC OFFSET $1 LIMIT $2",
+		sqlQueryContentWithPaginationDescByCol(deadLetterTableName, createdCol))
+}
@@ -141,6 +141,22 @@ func (c *Client) TransmissionsBySubscriptionName(offset, limit int, subscription
 	return transmissions, nil
 }
 
+// TransmissionsBySubscriptionNameAndTimeRange queries the transmissions by subscription name and time range
+func (c *Client) TransmissionsBySubscriptionNameAndTimeRange(start int64, end int64, offset, limit int, subscriptionName string) ([]models.Transmission, errors.EdgeX) {
+	validStart, validEnd, offset, validLimit, err := getValidRangeParameters(start, end, offset, limit)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	queryObj := map[string]any{subscriptionNameField: subscriptionName}
+
+	transmissions, err := queryTransmissions(context.Background(), c.ConnPool, sqlQueryContentWithTimeRangeAndPagination(transmissionTableName), validStart, validEnd, queryObj, offset, validLimit)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query transmissions by subscription name %s and time range", subscriptionName), err)
+	}
+
+	return transmissions, nil
+}
+
 // TransmissionTotalCount returns the total count of transmissions
 func (c *Client) TransmissionTotalCount() (uint32, errors.EdgeX) {
 	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCount(transmissionTableName))
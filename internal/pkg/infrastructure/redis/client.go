@@ -7,6 +7,7 @@ package redis
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	redisClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/redis"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	"github.com/google/uuid"
 )
@@ -94,6 +96,25 @@ func (c *Client) AddDeviceProfile(dp model.DeviceProfile) (model.DeviceProfile,
 	return addDeviceProfile(conn, dp)
 }
 
+// AddDeviceProfiles adds the device profiles in one transaction, so either all or none of them are added
+func (c *Client) AddDeviceProfiles(dps []model.DeviceProfile) ([]model.DeviceProfile, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	for i := range dps {
+		if dps[i].Id != "" {
+			_, err := uuid.Parse(dps[i].Id)
+			if err != nil {
+				return nil, errors.NewCommonEdgeX(errors.KindInvalidId, fmt.Sprintf("device profile %s ID failed UUID parsing", dps[i].Name), err)
+			}
+		} else {
+			dps[i].Id = uuid.New().String()
+		}
+	}
+
+	return addDeviceProfiles(conn, dps)
+}
+
 // UpdateDeviceProfile updates a new device profile
 func (c *Client) UpdateDeviceProfile(dp model.DeviceProfile) errors.EdgeX {
 	conn := c.Pool.Get()
@@ -250,6 +271,19 @@ func (c *Client) AllDeviceProfiles(offset int, limit int, labels []string) ([]mo
 	return deviceProfiles, nil
 }
 
+// DeviceProfilesByNameContains query device profiles with offset, limit, labels and the text contained in the name
+func (c *Client) DeviceProfilesByNameContains(offset int, limit int, nameContains string, labels []string) ([]model.DeviceProfile, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	deviceProfiles, edgeXerr := deviceProfilesByNameContains(conn, offset, limit, nameContains, labels)
+	if edgeXerr != nil {
+		return deviceProfiles, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query device profiles by offset %d, limit %d, and name containing %s", offset, limit, nameContains), edgeXerr)
+	}
+	return deviceProfiles, nil
+}
+
 // DeviceProfilesByModel query device profiles with offset, limit and model
 func (c *Client) DeviceProfilesByModel(offset int, limit int, model string) ([]model.DeviceProfile, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -870,6 +904,19 @@ func (c *Client) DeviceProfileCountByLabels(labels []string) (uint32, errors.Edg
 	return count, nil
 }
 
+// DeviceProfileCountByNameContains returns the count of Device Profiles with labels specified and the text contained in
+// the name
+func (c *Client) DeviceProfileCountByNameContains(nameContains string, labels []string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	deviceProfiles, edgeXerr := deviceProfilesMatchingName(conn, nameContains, labels)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return uint32(len(deviceProfiles)), nil
+}
+
 // DeviceProfileCountByManufacturer returns the count of Device Profiles associated with specified manufacturer
 func (c *Client) DeviceProfileCountByManufacturer(manufacturer string) (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -1192,6 +1239,33 @@ func (c *Client) NotificationsByStatus(offset int, limit int, ack, status string
 	return notifications, nil
 }
 
+// NotificationsBySender queries notifications by offset, limit and sender
+func (c *Client) NotificationsBySender(offset int, limit int, sender string) (notifications []model.Notification, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	notifications, edgeXerr = notificationsBySender(conn, offset, limit, sender)
+	if edgeXerr != nil {
+		return notifications, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query notifications by offset %d, limit %d, and sender %s", offset, limit, sender), edgeXerr)
+	}
+	return notifications, nil
+}
+
+// NotificationsByContentSearch query notifications whose content contains the query case-insensitively by offset and limit
+func (c *Client) NotificationsByContentSearch(offset int, limit int, query string) (notifications []model.Notification, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	c.warnContentSearchScan()
+	notifications, edgeXerr = notificationsByContentSearch(conn, offset, limit, query)
+	if edgeXerr != nil {
+		return notifications, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query notifications by offset %d, limit %d, and content search %s", offset, limit, query), edgeXerr)
+	}
+	return notifications, nil
+}
+
 // NotificationsByTimeRange query notifications by time range, ack, offset, and limit
 func (c *Client) NotificationsByTimeRange(start int64, end int64, offset int, limit int, ack string) (notifications []model.Notification, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -1254,6 +1328,43 @@ func (c *Client) NotificationCountByStatus(status, ack string) (uint32, errors.E
 	return uint32(len(notifications)), nil
 }
 
+// NotificationCountBySender returns the count of Notification associated with specified sender from the database
+func (c *Client) NotificationCountBySender(sender string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, err := getMemberNumber(conn, ZCARD, CreateKey(NotificationCollectionSender, sender))
+	if err != nil {
+		return 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	return count, nil
+}
+
+// NotificationCountByContentSearch returns the count of Notification whose content contains the query case-insensitively
+func (c *Client) NotificationCountByContentSearch(query string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	c.warnContentSearchScan()
+	notifications, edgeXerr := notificationsByContentSearch(conn, 0, -1, query)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return uint32(len(notifications)), nil
+}
+
+// contentSearchScanWarning warns once that the notification content search loads all the notifications
+var contentSearchScanWarning sync.Once
+
+// warnContentSearchScan warns the operators that Redis can't search the notification content, so each search loads
+// and filters all the notifications
+func (c *Client) warnContentSearchScan() {
+	contentSearchScanWarning.Do(func() {
+		c.loggingClient.Warn("the notification content search loads and filters all the notifications since Redis has no full-text search, which slows down as the notifications grow")
+	})
+}
+
 // NotificationCountByTimeRange returns the count of Notification from the database within specified time range
 func (c *Client) NotificationCountByTimeRange(start int64, end int64, ack string) (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -1329,6 +1440,31 @@ func (c *Client) LatestNotificationByOffset(offset uint32) (model.Notification,
 	return notification, nil
 }
 
+// LatestNotificationByCategoryAndOffset returns the notification of the category at the offset from the latest one
+func (c *Client) LatestNotificationByCategoryAndOffset(category string, offset uint32) (model.Notification, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	notification, edgeXerr := latestNotificationByCategoryAndOffset(conn, category, int(offset))
+	if edgeXerr != nil {
+		return model.Notification{}, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return notification, nil
+}
+
+// LatestNotificationByOffsetExcludingCategories returns the notification at the offset from the latest one, except the
+// notifications of the excluded categories
+func (c *Client) LatestNotificationByOffsetExcludingCategories(offset uint32, categories []string) (model.Notification, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	notification, edgeXerr := latestNotificationByOffsetExcludingCategories(conn, int(offset), categories)
+	if edgeXerr != nil {
+		return model.Notification{}, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return notification, nil
+}
+
 // SubscriptionTotalCount returns the total count of Subscription from the database
 func (c *Client) SubscriptionTotalCount() (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -1560,6 +1696,19 @@ func (c *Client) TransmissionsBySubscriptionName(offset int, limit int, subscrip
 	return transmissions, nil
 }
 
+// TransmissionsBySubscriptionNameAndTimeRange queries transmissions by subscription name, time range, offset and limit
+func (c *Client) TransmissionsBySubscriptionNameAndTimeRange(start int64, end int64, offset int, limit int, subscriptionName string) (transmissions []model.Transmission, err errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	transmissions, err = transmissionsBySubscriptionNameAndTimeRange(conn, start, end, offset, limit, subscriptionName)
+	if err != nil {
+		return transmissions, errors.NewCommonEdgeX(errors.Kind(err),
+			fmt.Sprintf("fail to query transmissions by subscription name %s and time range %v ~ %v", subscriptionName, start, end), err)
+	}
+	return transmissions, nil
+}
+
 // TransmissionsByNotificationId queries transmissions by offset, limit and notification id
 func (c *Client) TransmissionsByNotificationId(offset int, limit int, id string) (transmissions []model.Transmission, err errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -1729,3 +1878,180 @@ func (c *Client) UpdateRegistration(r model.Registration) errors.EdgeX {
 
 	return nil
 }
+
+// AddNotificationTemplate adds a new notification template
+func (c *Client) AddNotificationTemplate(template notificationModels.NotificationTemplate) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(template.Id) == 0 {
+		template.Id = uuid.New().String()
+	}
+
+	return addNotificationTemplate(conn, template)
+}
+
+// NotificationTemplateByName queries notification template by name
+func (c *Client) NotificationTemplateByName(name string) (template notificationModels.NotificationTemplate, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	template, edgeXerr = notificationTemplateByName(conn, name)
+	if edgeXerr != nil {
+		return template, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query notification template by name %s", name), edgeXerr)
+	}
+	return template, nil
+}
+
+// AllNotificationTemplates queries notification templates by offset and limit
+func (c *Client) AllNotificationTemplates(offset int, limit int) ([]notificationModels.NotificationTemplate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	templates, edgeXerr := allNotificationTemplates(conn, offset, limit)
+	if edgeXerr != nil {
+		return templates, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return templates, nil
+}
+
+// UpdateNotificationTemplate updates a notification template
+func (c *Client) UpdateNotificationTemplate(template notificationModels.NotificationTemplate) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return updateNotificationTemplate(conn, template)
+}
+
+// DeleteNotificationTemplateByName deletes a notification template by name
+func (c *Client) DeleteNotificationTemplateByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteNotificationTemplateByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the notification template with name %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// NotificationTemplateTotalCount returns the total count of NotificationTemplate from the database
+func (c *Client) NotificationTemplateTotalCount() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, NotificationTemplateCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// AddDispatchAuditRecord appends a dispatch audit record of a notification
+func (c *Client) AddDispatchAuditRecord(r notificationModels.DispatchAuditRecord) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(r.Id) == 0 {
+		r.Id = uuid.New().String()
+	}
+	return addDispatchAuditRecord(conn, r)
+}
+
+// DispatchAuditRecordsByNotificationId queries the dispatch audit records of a notification in the order of the sequence
+func (c *Client) DispatchAuditRecordsByNotificationId(notificationId string) ([]notificationModels.DispatchAuditRecord, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	records, edgeXerr := dispatchAuditRecordsByNotificationId(conn, ZRANGE, notificationId, 0, -1)
+	if edgeXerr != nil {
+		return records, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return records, nil
+}
+
+// LatestDispatchAuditRecordByNotificationId queries the dispatch audit record of a notification with the greatest sequence
+func (c *Client) LatestDispatchAuditRecordByNotificationId(notificationId string) (notificationModels.DispatchAuditRecord, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	records, edgeXerr := dispatchAuditRecordsByNotificationId(conn, ZREVRANGE, notificationId, 0, 0)
+	if edgeXerr != nil {
+		return notificationModels.DispatchAuditRecord{}, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(records) == 0 {
+		return notificationModels.DispatchAuditRecord{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no dispatch audit record of notification %s", notificationId), nil)
+	}
+	return records[0], nil
+}
+
+// AddDeadLetter adds a new dead letter
+func (c *Client) AddDeadLetter(d notificationModels.DeadLetter) (notificationModels.DeadLetter, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(d.Id) == 0 {
+		d.Id = uuid.New().String()
+	}
+	return addDeadLetter(conn, d)
+}
+
+// DeadLetterById queries the dead letter by id
+func (c *Client) DeadLetterById(id string) (deadLetter notificationModels.DeadLetter, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	deadLetter, edgeXerr = deadLetterById(conn, id)
+	if edgeXerr != nil {
+		return deadLetter, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query dead letter by id %s", id), edgeXerr)
+	}
+	return deadLetter, nil
+}
+
+// AllDeadLetters queries the dead letters from the latest one by offset and limit
+func (c *Client) AllDeadLetters(offset int, limit int) ([]notificationModels.DeadLetter, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	deadLetters, edgeXerr := allDeadLetters(conn, offset, limit)
+	if edgeXerr != nil {
+		return deadLetters, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return deadLetters, nil
+}
+
+// DeadLetterTotalCount returns the total count of DeadLetter from the database
+func (c *Client) DeadLetterTotalCount() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, DeadLetterCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// DeleteDeadLetterById deletes a dead letter by id
+func (c *Client) DeleteDeadLetterById(id string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteDeadLetterById(conn, id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the dead letter with id %s", id), edgeXerr)
+	}
+	return nil
+}
+
+// CleanupDeadLettersByAge deletes the dead letters that are older than age
+func (c *Client) CleanupDeadLettersByAge(age int64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := cleanupDeadLettersByAge(conn, age)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to cleanup dead letters by age %d", age), edgeXerr)
+	}
+	return nil
+}
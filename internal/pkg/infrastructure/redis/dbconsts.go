@@ -25,6 +25,7 @@ const (
 	ZADD             = "ZADD"
 	ZREM             = "ZREM"
 	EXEC             = "EXEC"
+	DISCARD          = "DISCARD"
 	ZRANGE           = "ZRANGE"
 	ZREVRANGE        = "ZREVRANGE"
 	MGET             = "MGET"
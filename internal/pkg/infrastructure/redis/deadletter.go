@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	DeadLetterCollection = "sn|dead"
+)
+
+// deadLetterStoredKey return the dead letter's stored key which combines the collection name and object id
+func deadLetterStoredKey(id string) string {
+	return CreateKey(DeadLetterCollection, id)
+}
+
+// addDeadLetter adds a new dead letter into DB, which is scored by the created timestamp
+func addDeadLetter(conn redis.Conn, deadLetter notificationModels.DeadLetter) (notificationModels.DeadLetter, errors.EdgeX) {
+	if deadLetter.Created == 0 {
+		deadLetter.Created = pkgCommon.MakeTimestamp()
+	}
+	m, err := json.Marshal(deadLetter)
+	if err != nil {
+		return deadLetter, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal dead letter for Redis persistence", err)
+	}
+
+	storedKey := deadLetterStoredKey(deadLetter.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, DeadLetterCollection, deadLetter.Created, storedKey)
+	if _, err = conn.Do(EXEC); err != nil {
+		return deadLetter, errors.NewCommonEdgeX(errors.KindDatabaseError, "dead letter creation failed", err)
+	}
+	return deadLetter, nil
+}
+
+// deadLetterById queries the dead letter by id
+func deadLetterById(conn redis.Conn, id string) (deadLetter notificationModels.DeadLetter, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, deadLetterStoredKey(id), &deadLetter)
+	if edgeXerr != nil {
+		return deadLetter, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// allDeadLetters queries the dead letters from the latest one by offset and limit
+func allDeadLetters(conn redis.Conn, offset, limit int) ([]notificationModels.DeadLetter, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, DeadLetterCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	deadLetters := make([]notificationModels.DeadLetter, len(objects))
+	for i, o := range objects {
+		if err := json.Unmarshal(o, &deadLetters[i]); err != nil {
+			return []notificationModels.DeadLetter{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "dead letter format parsing failed from the database", err)
+		}
+	}
+	return deadLetters, nil
+}
+
+// deleteDeadLetterById deletes the dead letter by id
+func deleteDeadLetterById(conn redis.Conn, id string) errors.EdgeX {
+	if _, edgeXerr := deadLetterById(conn, id); edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return deleteDeadLettersByStoredKeys(conn, []string{deadLetterStoredKey(id)})
+}
+
+// cleanupDeadLettersByAge deletes the dead letters that are older than age
+func cleanupDeadLettersByAge(conn redis.Conn, age int64) errors.EdgeX {
+	expireTimestamp := pkgCommon.MakeTimestamp() - age
+	storedKeys, err := redis.Strings(conn.Do(ZRANGEBYSCORE, DeadLetterCollection, 0, expireTimestamp))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("retrieve dead letter storeKeys by %s failed", DeadLetterCollection), err)
+	}
+	if len(storedKeys) == 0 {
+		return nil
+	}
+	return deleteDeadLettersByStoredKeys(conn, storedKeys)
+}
+
+func deleteDeadLettersByStoredKeys(conn redis.Conn, storedKeys []string) errors.EdgeX {
+	_ = conn.Send(MULTI)
+	for _, storedKey := range storedKeys {
+		_ = conn.Send(DEL, storedKey)
+		_ = conn.Send(ZREM, DeadLetterCollection, storedKey)
+	}
+	if _, err := conn.Do(EXEC); err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "dead letter deletion failed", err)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ package redis
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 
@@ -105,6 +106,44 @@ func addDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (models.DevicePr
 	return dp, edgeXerr
 }
 
+// addDeviceProfiles adds the device profiles to DB in one transaction, after checking none of them conflicts with the
+// stored device profiles or with each other
+func addDeviceProfiles(conn redis.Conn, dps []models.DeviceProfile) ([]models.DeviceProfile, errors.EdgeX) {
+	added := make([]models.DeviceProfile, len(dps))
+	names := make(map[string]bool, len(dps))
+	ts := pkgCommon.MakeTimestamp()
+	for i, dp := range dps {
+		exists, edgeXerr := deviceProfileIdExists(conn, dp.Id)
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		} else if exists {
+			return nil, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device profile id %s exists", dp.Id), nil)
+		}
+		exists, edgeXerr = deviceProfileNameExists(conn, dp.Name)
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		} else if exists || names[dp.Name] {
+			return nil, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device profile name %s exists", dp.Name), nil)
+		}
+		names[dp.Name] = true
+		dp.Created = ts
+		dp.Modified = ts
+		added[i] = dp
+	}
+
+	_ = conn.Send(MULTI)
+	for _, dp := range added {
+		if edgeXerr := sendAddDeviceProfileCmd(conn, deviceProfileStoredKey(dp.Id), dp); edgeXerr != nil {
+			_, _ = conn.Do(DISCARD)
+			return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+	if _, err := conn.Do(EXEC); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "device profiles creation failed", err)
+	}
+	return added, nil
+}
+
 // deviceProfileById query device profile by id from DB
 func deviceProfileById(conn redis.Conn, id string) (deviceProfile models.DeviceProfile, edgeXerr errors.EdgeX) {
 	edgeXerr = getObjectById(conn, deviceProfileStoredKey(id), &deviceProfile)
@@ -242,6 +281,40 @@ func deviceProfilesByLabels(conn redis.Conn, offset int, limit int, labels []str
 	return deviceProfiles, nil
 }
 
+// deviceProfilesByNameContains query device profiles by offset, limit, labels and the text contained in the name
+// case-insensitively. The names aren't indexed, so the device profiles of the labels are all read to match the names.
+func deviceProfilesByNameContains(conn redis.Conn, offset int, limit int, nameContains string, labels []string) (deviceProfiles []models.DeviceProfile, edgeXerr errors.EdgeX) {
+	deviceProfiles, edgeXerr = deviceProfilesMatchingName(conn, nameContains, labels)
+	if edgeXerr != nil {
+		return deviceProfiles, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if offset >= len(deviceProfiles) {
+		return []models.DeviceProfile{}, nil
+	}
+	deviceProfiles = deviceProfiles[offset:]
+	if limit >= 0 && limit < len(deviceProfiles) {
+		deviceProfiles = deviceProfiles[:limit]
+	}
+	return deviceProfiles, nil
+}
+
+// deviceProfilesMatchingName returns all the device profiles of the labels whose name contains the text
+// case-insensitively
+func deviceProfilesMatchingName(conn redis.Conn, nameContains string, labels []string) ([]models.DeviceProfile, errors.EdgeX) {
+	all, edgeXerr := deviceProfilesByLabels(conn, 0, -1, labels)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	nameContains = strings.ToLower(nameContains)
+	deviceProfiles := []models.DeviceProfile{}
+	for _, dp := range all {
+		if strings.Contains(strings.ToLower(dp.Name), nameContains) {
+			deviceProfiles = append(deviceProfiles, dp)
+		}
+	}
+	return deviceProfiles, nil
+}
+
 // deviceProfilesByModel query device profiles by offset, limit and model
 func deviceProfilesByModel(conn redis.Conn, offset int, limit int, model string) (deviceProfiles []models.DeviceProfile, edgeXerr errors.EdgeX) {
 	objects, err := getObjectsByRevRange(conn, CreateKey(DeviceProfileCollectionModel, model), offset, limit)
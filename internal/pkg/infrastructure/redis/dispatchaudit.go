@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	DispatchAuditCollection = "sn|audit"
+)
+
+// dispatchAuditStoredKey returns the key of the sorted set storing the dispatch audit records of the notification,
+// which are scored by the sequence
+func dispatchAuditStoredKey(notificationId string) string {
+	return CreateKey(DispatchAuditCollection, notificationId)
+}
+
+// addDispatchAuditRecord appends a dispatch audit record to the records of its notification
+func addDispatchAuditRecord(conn redis.Conn, record notificationModels.DispatchAuditRecord) errors.EdgeX {
+	storedKey := dispatchAuditStoredKey(record.NotificationId)
+	count, err := redis.Int(conn.Do(ZCOUNT, storedKey, record.Sequence, record.Sequence))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "dispatch audit record existence check failed", err)
+	} else if count > 0 {
+		return errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("dispatch audit record %d of notification %s already exists", record.Sequence, record.NotificationId), nil)
+	}
+
+	m, err := json.Marshal(record)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal dispatch audit record for Redis persistence", err)
+	}
+	if _, err = conn.Do(ZADD, storedKey, record.Sequence, m); err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "dispatch audit record creation failed", err)
+	}
+	return nil
+}
+
+// dispatchAuditRecordsByNotificationId queries the dispatch audit records of the notification in the order of the
+// sequence from the start to the end, where -1 is the last record
+func dispatchAuditRecordsByNotificationId(conn redis.Conn, command string, notificationId string, start int, end int) ([]notificationModels.DispatchAuditRecord, errors.EdgeX) {
+	objects, err := redis.ByteSlices(conn.Do(command, dispatchAuditStoredKey(notificationId), start, end))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query dispatch audit records of notification %s failed", notificationId), err)
+	}
+
+	records := make([]notificationModels.DispatchAuditRecord, len(objects))
+	for i, object := range objects {
+		if err = json.Unmarshal(object, &records[i]); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "dispatch audit record format parsing failed from the database", err)
+		}
+	}
+	return records, nil
+}
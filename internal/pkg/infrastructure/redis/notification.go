@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 
@@ -141,6 +142,12 @@ func notificationsByStatus(conn redis.Conn, offset int, limit int, ack, status s
 	return getNotificationsByRedisKeyAndAck(conn, offset, limit, ack, redisKey)
 }
 
+// notificationsBySender queries notifications by offset, limit, and sender
+func notificationsBySender(conn redis.Conn, offset int, limit int, sender string) (notifications []models.Notification, edgeXerr errors.EdgeX) {
+	redisKey := CreateKey(NotificationCollectionSender, sender)
+	return getNotificationsByRedisKeyAndAck(conn, offset, limit, "", redisKey)
+}
+
 // notificationsByTimeRange query notifications by time range, offset, limit, and ack
 func notificationsByTimeRange(conn redis.Conn, startTime int64, endTime int64, offset int, limit int, ack string) (notifications []models.Notification, edgeXerr errors.EdgeX) {
 	redisKey := NotificationCollectionCreated
@@ -220,6 +227,34 @@ func notificationByQueryConditions(conn redis.Conn, offset, limit int, condition
 	return notifications, nil
 }
 
+// notificationsByContentSearch query the notifications whose content contains the query case-insensitively. Redis can't
+// search the content, so all the notifications are loaded and filtered, and the matched notifications are paginated.
+func notificationsByContentSearch(conn redis.Conn, offset int, limit int, query string) (notifications []models.Notification, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, NotificationCollectionCreated, 0, -1)
+	if edgeXerr != nil {
+		return notifications, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	all, edgeXerr := convertObjectsToNotifications(objects)
+	if edgeXerr != nil {
+		return notifications, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	query = strings.ToLower(query)
+	notifications = []models.Notification{}
+	for _, n := range all {
+		if strings.Contains(strings.ToLower(n.Content), query) {
+			notifications = append(notifications, n)
+		}
+	}
+	if offset >= len(notifications) {
+		return []models.Notification{}, nil
+	}
+	notifications = notifications[offset:]
+	if limit >= 0 && limit < len(notifications) {
+		notifications = notifications[:limit]
+	}
+	return notifications, nil
+}
+
 // sendDeleteNotificationCmd sends redis command to delete a notification
 func sendDeleteNotificationCmd(conn redis.Conn, storedKey string, n models.Notification) {
 	_ = conn.Send(DEL, storedKey)
@@ -237,31 +272,26 @@ func sendDeleteNotificationCmd(conn redis.Conn, storedKey string, n models.Notif
 	_ = conn.Send(ZREM, CreateKey(NotificationCollectionAck, strconv.FormatBool(n.Acknowledged)), storedKey)
 }
 
-// deleteNotificationById deletes the notification by id and all of its associated transmissions
+// deleteNotificationById deletes the notification by id and all of its associated transmissions in one transaction, so
+// no transmission is left without its notification
 func deleteNotificationById(conn redis.Conn, id string) errors.EdgeX {
 	notification, edgexErr := notificationById(conn, id)
 	if edgexErr != nil {
 		return errors.NewCommonEdgeXWrapper(edgexErr)
 	}
-	storedKey := notificationStoredKey(notification.Id)
+	transmissions, edgexErr := transmissionsByNotificationId(conn, 0, -1, notification.Id)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
 	_ = conn.Send(MULTI)
-	sendDeleteNotificationCmd(conn, storedKey, notification)
+	sendDeleteNotificationCmd(conn, notificationStoredKey(notification.Id), notification)
+	for _, transmission := range transmissions {
+		sendDeleteTransmissionCmd(conn, transmissionStoredKey(transmission.Id), transmission)
+	}
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "notification deletion failed", err)
 	}
-
-	// Remove the associated transmissions
-	transStoreKeys, err := redis.Strings(conn.Do(ZRANGE, CreateKey(TransmissionCollectionNotificationId, notification.Id), 0, -1))
-	if err != nil {
-		return errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to retrieve transmission storeKeys", err)
-	}
-	for _, storeKey := range transStoreKeys {
-		err = deleteTransmissionById(conn, idFromStoredKey(storeKey))
-		if err != nil {
-			return errors.NewCommonEdgeXWrapper(err)
-		}
-	}
 	return nil
 }
 
@@ -519,6 +549,59 @@ func (c *Client) CleanupNotificationsByAge(age int64) (err errors.EdgeX) {
 	return nil
 }
 
+// CleanupNotificationsByCategoryAndAge deletes notifications of the category and their corresponding transmissions that are
+// older than age. The deletion is run in the background like CleanupNotificationsByAge.
+func (c *Client) CleanupNotificationsByCategoryAndAge(category string, age int64) (err errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	ncStoreKeys, transStoreKeys, err := notificationAndTransmissionStoreKeys(conn, CreateKey(NotificationCollectionCategory, category), age)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	go c.asyncDeleteNotificationByStoreKeys(ncStoreKeys)
+	go c.asyncDeleteTransmissionByStoreKeys(transStoreKeys)
+	return nil
+}
+
+// CleanupNotificationsByAgeExcludingCategories deletes notifications and their corresponding transmissions that are older
+// than age, except the notifications of the excluded categories. The deletion is run in the background like
+// CleanupNotificationsByAge.
+func (c *Client) CleanupNotificationsByAgeExcludingCategories(age int64, categories []string) (err errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	expireTimestamp := pkgCommon.MakeTimestamp() - age
+	excluded := make(map[string]bool)
+	for _, category := range categories {
+		keys, err := redis.Strings(conn.Do(ZRANGEBYSCORE, CreateKey(NotificationCollectionCategory, category), 0, expireTimestamp))
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("retrieve notification storeKeys by category %s failed", category), err)
+		}
+		for _, key := range keys {
+			excluded[key] = true
+		}
+	}
+	allStoreKeys, e := redis.Strings(conn.Do(ZRANGEBYSCORE, NotificationCollection, 0, expireTimestamp))
+	if e != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("retrieve notification storeKeys by %s failed", NotificationCollection), e)
+	}
+
+	var ncStoreKeys, transStoreKeys []string
+	for _, ncStoreKey := range allStoreKeys {
+		if excluded[ncStoreKey] {
+			continue
+		}
+		keys, err := redis.Strings(conn.Do(ZRANGE, CreateKey(TransmissionCollectionNotificationId, idFromStoredKey(ncStoreKey)), 0, -1))
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to retrieve transmission storeKeys", err)
+		}
+		ncStoreKeys = append(ncStoreKeys, ncStoreKey)
+		transStoreKeys = append(transStoreKeys, keys...)
+	}
+	go c.asyncDeleteNotificationByStoreKeys(ncStoreKeys)
+	go c.asyncDeleteTransmissionByStoreKeys(transStoreKeys)
+	return nil
+}
+
 // DeleteProcessedNotificationsByAge deletes processed notifications and their corresponding transmissions that are older than age.
 // This function is implemented to starts up two goroutines to delete transmissions and notifications in the background to achieve better performance.
 func (c *Client) DeleteProcessedNotificationsByAge(age int64) (err errors.EdgeX) {
@@ -567,3 +650,59 @@ func latestNotificationByOffset(conn redis.Conn, offset int) (notification model
 	}
 	return notifications[0], nil
 }
+
+func latestNotificationByCategoryAndOffset(conn redis.Conn, category string, offset int) (notification models.Notification, edgeXerr errors.EdgeX) {
+	objects, err := getObjectsByRevRange(conn, CreateKey(NotificationCollectionCategory, category), offset, 1)
+	if err != nil {
+		return notification, errors.NewCommonEdgeXWrapper(err)
+	}
+	notifications, err := convertObjectsToNotifications(objects)
+	if err != nil {
+		return notification, errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(notifications) == 0 {
+		return notification, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("notification of the category %s not found from the offset %d", category, offset), nil)
+	}
+	return notifications[0], nil
+}
+
+// latestNotificationByOffsetExcludingCategories walks the notifications from the latest one, skipping the notifications
+// of the excluded categories, until the offset is reached
+func latestNotificationByOffsetExcludingCategories(conn redis.Conn, offset int, categories []string) (notification models.Notification, edgeXerr errors.EdgeX) {
+	excluded := make(map[string]bool)
+	for _, category := range categories {
+		keys, err := redis.Strings(conn.Do(ZRANGE, CreateKey(NotificationCollectionCategory, category), 0, -1))
+		if err != nil {
+			return notification, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("retrieve notification storeKeys by category %s failed", category), err)
+		}
+		for _, key := range keys {
+			excluded[key] = true
+		}
+	}
+	allStoreKeys, err := redis.Strings(conn.Do(ZREVRANGE, NotificationCollection, 0, -1))
+	if err != nil {
+		return notification, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("retrieve notification storeKeys by %s failed", NotificationCollection), err)
+	}
+	for _, storeKey := range allStoreKeys {
+		if excluded[storeKey] {
+			continue
+		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		objects, edgeXerr := getObjectsByIds(conn, []interface{}{storeKey})
+		if edgeXerr != nil {
+			return notification, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		notifications, edgeXerr := convertObjectsToNotifications(objects)
+		if edgeXerr != nil {
+			return notification, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		if len(notifications) == 0 {
+			break
+		}
+		return notifications[0], nil
+	}
+	return notification, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "notification not found from the offset excluding categories", nil)
+}
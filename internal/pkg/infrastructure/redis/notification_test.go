@@ -0,0 +1,192 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	goErrors "errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeConn is an in-memory redis.Conn supporting the string and sorted set commands used to delete the notifications.
+// The commands sent after MULTI are applied together by EXEC, and the EXEC numbered failExec fails without applying any.
+type fakeConn struct {
+	values   map[string][]byte
+	zsets    map[string]map[string]float64
+	queued   [][]interface{}
+	inMulti  bool
+	execs    int
+	failExec int
+}
+
+func newFakeConn() *fakeConn {
+	return &fakeConn{values: make(map[string][]byte), zsets: make(map[string]map[string]float64)}
+}
+
+func (c *fakeConn) Close() error { return nil }
+func (c *fakeConn) Err() error   { return nil }
+func (c *fakeConn) Flush() error { return nil }
+func (c *fakeConn) Receive() (interface{}, error) {
+	return nil, goErrors.New("receive is not supported")
+}
+
+func (c *fakeConn) Send(cmd string, args ...interface{}) error {
+	if cmd == MULTI {
+		c.inMulti = true
+		c.queued = nil
+		return nil
+	}
+	if !c.inMulti {
+		_, err := c.apply(cmd, args)
+		return err
+	}
+	c.queued = append(c.queued, append([]interface{}{cmd}, args...))
+	return nil
+}
+
+func (c *fakeConn) Do(cmd string, args ...interface{}) (interface{}, error) {
+	if cmd != EXEC {
+		return c.apply(cmd, args)
+	}
+	c.execs++
+	queued := c.queued
+	c.inMulti = false
+	c.queued = nil
+	if c.execs == c.failExec {
+		return nil, goErrors.New("connection reset")
+	}
+	for _, command := range queued {
+		if _, err := c.apply(command[0].(string), command[1:]); err != nil {
+			return nil, err
+		}
+	}
+	return []interface{}{}, nil
+}
+
+func (c *fakeConn) apply(cmd string, args []interface{}) (interface{}, error) {
+	key := argString(args[0])
+	switch cmd {
+	case GET:
+		if value, ok := c.values[key]; ok {
+			return value, nil
+		}
+		return nil, nil
+	case MGET:
+		values := make([]interface{}, len(args))
+		for i, arg := range args {
+			if value, ok := c.values[argString(arg)]; ok {
+				values[i] = value
+			}
+		}
+		return values, nil
+	case DEL:
+		delete(c.values, key)
+		return int64(1), nil
+	case ZADD:
+		if c.zsets[key] == nil {
+			c.zsets[key] = make(map[string]float64)
+		}
+		score, _ := redis.Float64(args[1], nil)
+		c.zsets[key][argString(args[2])] = score
+		return int64(1), nil
+	case ZREM:
+		delete(c.zsets[key], argString(args[1]))
+		return int64(1), nil
+	case ZCOUNT:
+		return int64(len(c.zsets[key])), nil
+	case ZRANGE, ZREVRANGE:
+		members := make([]string, 0, len(c.zsets[key]))
+		for member := range c.zsets[key] {
+			members = append(members, member)
+		}
+		sort.Slice(members, func(i, j int) bool {
+			if cmd == ZREVRANGE {
+				return c.zsets[key][members[i]] > c.zsets[key][members[j]]
+			}
+			return c.zsets[key][members[i]] < c.zsets[key][members[j]]
+		})
+		values := make([]interface{}, len(members))
+		for i, member := range members {
+			values[i] = []byte(member)
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("command %s is not supported", cmd)
+}
+
+// argString returns the key or member of the command argument, which is a string or the bytes replied by the range
+func argString(arg interface{}) string {
+	if b, ok := arg.([]byte); ok {
+		return string(b)
+	}
+	return fmt.Sprint(arg)
+}
+
+func (c *fakeConn) storeNotification(t *testing.T, n models.Notification, transmissions ...models.Transmission) {
+	value, err := json.Marshal(n)
+	require.NoError(t, err)
+	c.values[notificationStoredKey(n.Id)] = value
+	for i, trans := range transmissions {
+		value, err = json.Marshal(trans)
+		require.NoError(t, err)
+		storedKey := transmissionStoredKey(trans.Id)
+		c.values[storedKey] = value
+		_, err = c.apply(ZADD, []interface{}{CreateKey(TransmissionCollectionNotificationId, n.Id), i, storedKey})
+		require.NoError(t, err)
+	}
+}
+
+func TestDeleteNotificationById(t *testing.T) {
+	n := models.Notification{Id: exampleUUID, Category: "health-check", Sender: "core-metadata", Severity: models.Normal, Status: models.Processed}
+	address := models.RESTAddress{BaseAddress: models.BaseAddress{Type: common.REST, Host: "localhost", Port: 8080}, HTTPMethod: http.MethodPost}
+	transmissions := []models.Transmission{
+		{Id: "a0b9f6c1-2a2d-4e25-9a8e-2c1c3ad4d2e1", NotificationId: n.Id, SubscriptionName: "sub", Channel: address, Status: models.Sent},
+		{Id: "d6c2cf1f-58c3-4b69-8b79-2f7f8e0f1b7a", NotificationId: n.Id, SubscriptionName: "sub", Channel: address, Status: models.Failed},
+	}
+
+	tests := []struct {
+		name            string
+		failExec        int
+		expectedError   bool
+		expectedDeleted bool
+	}{
+		{"deleted with the transmissions", 0, false, true},
+		{"nothing deleted if the transaction fails", 1, true, false},
+		// the transmissions were deleted by the following transactions, whose failure left them orphaned
+		{"no following transaction to fail", 2, false, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			conn := newFakeConn()
+			conn.failExec = testCase.failExec
+			conn.storeNotification(t, n, transmissions...)
+
+			err := deleteNotificationById(conn, n.Id)
+			if testCase.expectedError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, 1, conn.execs, "the notification and its transmissions should be deleted in one transaction")
+			_, notificationExists := conn.values[notificationStoredKey(n.Id)]
+			assert.Equal(t, !testCase.expectedDeleted, notificationExists)
+			for _, trans := range transmissions {
+				_, transmissionExists := conn.values[transmissionStoredKey(trans.Id)]
+				assert.Equal(t, notificationExists, transmissionExists, "the transmission %s should be deleted along with the notification", trans.Id)
+			}
+		})
+	}
+}
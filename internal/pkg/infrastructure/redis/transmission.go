@@ -114,22 +114,6 @@ func updateTransmission(conn redis.Conn, trans models.Transmission) errors.EdgeX
 	return nil
 }
 
-// deleteTransmissionById deletes the transmission by id
-func deleteTransmissionById(conn redis.Conn, id string) errors.EdgeX {
-	transmission, edgexErr := transmissionById(conn, id)
-	if edgexErr != nil {
-		return errors.NewCommonEdgeXWrapper(edgexErr)
-	}
-	storedKey := transmissionStoredKey(transmission.Id)
-	_ = conn.Send(MULTI)
-	sendDeleteTransmissionCmd(conn, storedKey, transmission)
-	_, err := conn.Do(EXEC)
-	if err != nil {
-		return errors.NewCommonEdgeX(errors.KindDatabaseError, "transmission deletion failed", err)
-	}
-	return nil
-}
-
 // transmissionsByTimeRange query transmissions by time range, offset, and limit
 func transmissionsByTimeRange(conn redis.Conn, startTime int64, endTime int64, offset int, limit int) (transmissions []models.Transmission, edgeXerr errors.EdgeX) {
 	objects, edgeXerr := getObjectsByScoreRange(conn, TransmissionCollectionCreated, startTime, endTime, offset, limit)
@@ -170,6 +154,16 @@ func transmissionsBySubscriptionName(conn redis.Conn, offset int, limit int, sub
 	return objectsToTransmissions(objects)
 }
 
+// transmissionsBySubscriptionNameAndTimeRange queries transmissions by subscription name, time range, offset and limit
+func transmissionsBySubscriptionNameAndTimeRange(conn redis.Conn, startTime int64, endTime int64, offset int, limit int, subscriptionName string) (transmissions []models.Transmission, err errors.EdgeX) {
+	objects, err := getObjectsByScoreRange(conn, CreateKey(TransmissionCollectionSubscriptionName, subscriptionName), startTime, endTime, offset, limit)
+	if err != nil {
+		return transmissions, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return objectsToTransmissions(objects)
+}
+
 // transmissionsByNotificationId queries transmissions by offset, limit, and notification id
 func transmissionsByNotificationId(conn redis.Conn, offset int, limit int, id string) (transmissions []models.Transmission, err errors.EdgeX) {
 	objects, err := getObjectsByRevRange(conn, CreateKey(TransmissionCollectionNotificationId, id), offset, limit)
@@ -25,6 +25,17 @@ var methods = map[string]struct{}{
 	http.MethodDelete: {}, http.MethodTrace: {}, http.MethodConnect: {},
 }
 
+// HTTPStatusError is the error of the HTTP response with a failure status code, which keeps the status code for the
+// callers to tell the failures apart, e.g. 429 from the other 4xx
+type HTTPStatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e HTTPStatusError) Error() string {
+	return fmt.Sprintf("request failed, status code: %d, err: %s", e.StatusCode, e.Body)
+}
+
 // SendRequestWithRESTAddress sends request with REST address
 func SendRequestWithRESTAddress(lc logger.LoggingClient, content string, contentType string,
 	address models.RESTAddress, jwtSecretProvider interfaces.AuthenticationInjector) (res string, err errors.EdgeX) {
@@ -66,7 +77,7 @@ func SendRequestAndGetResponse(client *http.Client, req *http.Request) (res stri
 		return "", errors.NewCommonEdgeX(errors.KindIOError, "fail to read the response body", err)
 	}
 	if resp.StatusCode >= http.StatusBadRequest {
-		return "", errors.NewCommonEdgeX(errors.KindMapping(resp.StatusCode), fmt.Sprintf("request failed, status code: %d, err: %s", resp.StatusCode, string(bodyBytes)), nil)
+		return "", errors.NewCommonEdgeX(errors.KindMapping(resp.StatusCode), "", HTTPStatusError{StatusCode: resp.StatusCode, Body: string(bodyBytes)})
 	}
 	return string(bodyBytes), nil
 }
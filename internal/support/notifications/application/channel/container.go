@@ -21,6 +21,15 @@ var MQTTSenderName = di.TypeInstanceToName(MQTTSender{})
 // ZeroMQTSenderName contains the name of the channel.ZeroMQSender implementation in the DIC.
 var ZeroMQTSenderName = di.TypeInstanceToName(ZeroMQSender{})
 
+// WebhookSenderName contains the name of the channel.WebhookSender implementation in the DIC.
+var WebhookSenderName = di.TypeInstanceToName(WebhookSender{})
+
+// SlackSenderName contains the name of the channel.SlackSender implementation in the DIC.
+var SlackSenderName = di.TypeInstanceToName(SlackSender{})
+
+// TeamsSenderName contains the name of the channel.TeamsSender implementation in the DIC.
+var TeamsSenderName = di.TypeInstanceToName(TeamsSender{})
+
 // RESTSenderFrom helper function queries the DIC and returns the channel.Sender implementation.
 func RESTSenderFrom(get di.Get) Sender {
 	return get(RESTSenderName).(Sender)
@@ -40,3 +49,18 @@ func MQTTSenderFrom(get di.Get) Sender {
 func ZeroMQSenderFrom(get di.Get) Sender {
 	return get(ZeroMQTSenderName).(Sender)
 }
+
+// WebhookSenderFrom helper function queries the DIC and returns the channel.Sender implementation.
+func WebhookSenderFrom(get di.Get) Sender {
+	return get(WebhookSenderName).(Sender)
+}
+
+// SlackSenderFrom helper function queries the DIC and returns the channel.Sender implementation.
+func SlackSenderFrom(get di.Get) Sender {
+	return get(SlackSenderName).(Sender)
+}
+
+// TeamsSenderFrom helper function queries the DIC and returns the channel.Sender implementation.
+func TeamsSenderFrom(get di.Get) Sender {
+	return get(TeamsSenderName).(Sender)
+}
@@ -8,6 +8,7 @@ package channel
 import (
 	"bytes"
 	"crypto/tls"
+	goErrors "errors"
 	"fmt"
 	"net"
 	mail "net/smtp"
@@ -28,6 +29,26 @@ const (
 	secretKeyPassword = "password"
 )
 
+// RecipientFailure is the failure to deliver the email to a recipient, e.g. the recipient rejected by the SMTP server
+type RecipientFailure struct {
+	Recipient string
+	Err       error
+}
+
+// RecipientsError is the error of the email send which failed for some or all of the recipients. The email is
+// delivered to the recipients not listed in the Failures.
+type RecipientsError struct {
+	Failures []RecipientFailure
+}
+
+func (e RecipientsError) Error() string {
+	failures := make([]string, len(e.Failures))
+	for i, f := range e.Failures {
+		failures[i] = fmt.Sprintf("%s: %v", f.Recipient, f.Err)
+	}
+	return "fail to send the email to the recipients " + strings.Join(failures, "; ")
+}
+
 func buildSmtpMessage(sender string, subject string, toAddresses []string, contentType string, message string) []byte {
 	smtpNewline := "\r\n"
 
@@ -123,17 +144,23 @@ func sendEmail(s config.SmtpInfo, auth mail.Auth, to []string, msg []byte) error
 		}
 		err = c.Auth(auth)
 		if err != nil {
-			return errors.NewCommonEdgeXWrapper(err)
+			return errors.NewCommonEdgeX(errors.KindUnauthorized, "fail to authenticate to the SMTP server", err)
 		}
 	}
 	if err = c.Mail(s.Sender); err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	// The SMTP server accepts or rejects each recipient by the RCPT response, so the email is still delivered to the
+	// accepted recipients when some are rejected, e.g. a mistyped address
+	var recipientsErr RecipientsError
 	for _, addr := range to {
 		if err = c.Rcpt(addr); err != nil {
-			return errors.NewCommonEdgeXWrapper(err)
+			recipientsErr.Failures = append(recipientsErr.Failures, RecipientFailure{Recipient: addr, Err: err})
 		}
 	}
+	if len(recipientsErr.Failures) == len(to) {
+		return errors.NewCommonEdgeX(errors.KindServerError, "", recipientsErr)
+	}
 	w, err := c.Data()
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
@@ -150,5 +177,22 @@ func sendEmail(s config.SmtpInfo, auth mail.Auth, to []string, msg []byte) error
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	if len(recipientsErr.Failures) > 0 {
+		return errors.NewCommonEdgeX(errors.KindServerError, "", recipientsErr)
+	}
 	return nil
 }
+
+// FailedRecipients returns the email recipients the send failed for mapped to the failure responses, or nil if the err
+// doesn't tell the failed recipients
+func FailedRecipients(err error) map[string]string {
+	var recipientsErr RecipientsError
+	if !goErrors.As(err, &recipientsErr) {
+		return nil
+	}
+	failed := make(map[string]string, len(recipientsErr.Failures))
+	for _, f := range recipientsErr.Failures {
+		failed[f.Recipient] = f.Err.Error()
+	}
+	return failed
+}
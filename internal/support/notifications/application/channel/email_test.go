@@ -0,0 +1,171 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	bootstrapMocks "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startPlainSmtpServer starts an SMTP server on localhost which accepts any PLAIN authentication, and returns its port
+// and the channel of the received credentials
+func startPlainSmtpServer(t *testing.T) (int, <-chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+	credentials := make(chan string, 10)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			servePlainSmtpConn(conn, credentials)
+		}
+	}()
+	return listener.Addr().(*net.TCPAddr).Port, credentials
+}
+
+func servePlainSmtpConn(conn net.Conn, credentials chan<- string) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = fmt.Fprintf(conn, "%s\r\n", line) }
+	reply("220 localhost ESMTP")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimSpace(line)
+		switch command := strings.ToUpper(strings.SplitN(line, " ", 2)[0]); command {
+		case "EHLO":
+			reply("250-localhost")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			credential, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(line, "AUTH PLAIN "))
+			credentials <- string(credential)
+			reply("235 2.7.0 Accepted")
+		case "MAIL", "RCPT":
+			reply("250 OK")
+		case "DATA":
+			reply("354 Go ahead")
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil || dataLine == ".\r\n" {
+					break
+				}
+			}
+			reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
+
+func TestEmailSenderSendWithRotatedSecret(t *testing.T) {
+	port, credentials := startPlainSmtpServer(t)
+	secretProvider := &bootstrapMocks.SecretProvider{}
+	secretProvider.On("GetSecret", "smtp", secretKeyUsername, secretKeyPassword).
+		Return(map[string]string{secretKeyUsername: "user", secretKeyPassword: "old-password"}, nil).Once()
+	secretProvider.On("GetSecret", "smtp", secretKeyUsername, secretKeyPassword).
+		Return(map[string]string{secretKeyUsername: "user", secretKeyPassword: "new-password"}, nil).Once()
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+			return secretProvider
+		},
+		notificationContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{Smtp: config.SmtpInfo{Host: "127.0.0.1", Port: port, Sender: "edgex@example.com", SecretName: "smtp"}}
+		},
+	})
+	sender := NewEmailSender(dic)
+	address := models.EmailAddress{BaseAddress: models.BaseAddress{Type: common.EMAIL}, Recipients: []string{"ops@example.com"}}
+
+	_, err := sender.Send(models.Notification{Sender: "edgex", Content: "the device is down"}, address)
+	require.NoError(t, err)
+	assert.Equal(t, "\x00user\x00old-password", <-credentials)
+
+	// the secret is rotated, the next send should use the new credential without restarting the service
+	_, err = sender.Send(models.Notification{Sender: "edgex", Content: "the device is up"}, address)
+	require.NoError(t, err)
+	assert.Equal(t, "\x00user\x00new-password", <-credentials)
+	secretProvider.AssertExpectations(t)
+}
+
+func TestEmailSenderSendWithWritableSmtp(t *testing.T) {
+	port, messages := startXOAuth2SmtpServer(t, "stale-token")
+	changedPort, changedMessages := startXOAuth2SmtpServer(t, "stale-token")
+	secretProvider := &bootstrapMocks.SecretProvider{}
+	secretProvider.On("GetSecret", "smtp", secretKeyUsername, secretKeyClientId, secretKeyClientSecret).
+		Return(map[string]string{secretKeyUsername: "user@example.com", secretKeyClientId: "client", secretKeyClientSecret: "secret"}, nil)
+	configuration := &config.ConfigurationStruct{Smtp: config.SmtpInfo{Host: "127.0.0.1", Port: port, Sender: "edgex@example.com",
+		SecretName: "smtp", AuthMode: AuthModeOAuth2, TokenEndpoint: "https://login.example.com/token"}}
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+			return secretProvider
+		},
+		notificationContainer.ConfigurationName: func(get di.Get) interface{} {
+			return configuration
+		},
+	})
+	sender := &EmailSender{dic: dic, tokenProvider: &mockTokenProvider{}}
+	address := models.EmailAddress{BaseAddress: models.BaseAddress{Type: common.EMAIL}, Recipients: []string{"ops@example.com"}}
+
+	_, err := sender.Send(models.Notification{Sender: "edgex", Content: "the device is down"}, address)
+	require.NoError(t, err)
+	assert.Contains(t, <-messages, "the device is down")
+
+	// the SMTP server is changed in the Writable section, the next send should use it without restarting the service
+	configuration.Writable.Smtp = config.SmtpOverrideInfo{Host: "localhost", Port: changedPort}
+	_, err = sender.Send(models.Notification{Sender: "edgex", Content: "the device is up"}, address)
+	require.NoError(t, err)
+	assert.Contains(t, <-changedMessages, "the device is up")
+	assert.Empty(t, messages, "the previous SMTP server should not receive the email")
+}
+
+func TestEffectiveSmtpInfo(t *testing.T) {
+	configuration := &config.ConfigurationStruct{
+		Smtp: config.SmtpInfo{Host: "my.email.server", Port: 587, Sender: "sender", EnableSelfSignedCert: true, SecretName: "smtp", AuthMode: "usernamepassword"},
+	}
+	assert.Equal(t, configuration.Smtp, configuration.EffectiveSmtpInfo())
+
+	configuration.Writable.Smtp = config.SmtpOverrideInfo{SecretName: "smtp-rotated", Port: 25}
+	smtpInfo := configuration.EffectiveSmtpInfo()
+	assert.Equal(t, "smtp-rotated", smtpInfo.SecretName)
+	assert.Equal(t, 25, smtpInfo.Port)
+	assert.Equal(t, configuration.Smtp.Host, smtpInfo.Host)
+	assert.Equal(t, configuration.Smtp.Sender, smtpInfo.Sender)
+	assert.True(t, smtpInfo.EnableSelfSignedCert, "the unset EnableSelfSignedCert should keep the top-level setting")
+
+	disabled := false
+	configuration.Writable.Smtp.EnableSelfSignedCert = &disabled
+	assert.False(t, configuration.EffectiveSmtpInfo().EnableSelfSignedCert, "the EnableSelfSignedCert should be overridden to false")
+}
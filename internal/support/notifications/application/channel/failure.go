@@ -0,0 +1,73 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// FailureClass classifies the failed sends, so the resend budget can be picked by the kind of the failure
+type FailureClass string
+
+const (
+	// FailureClassNetwork is the failure to reach the endpoint, e.g. the connection refused or the broker unavailable
+	FailureClassNetwork FailureClass = "network"
+	// FailureClassTimeout is the send timed out before the endpoint responded
+	FailureClassTimeout FailureClass = "timeout"
+	// FailureClassClientError is the 4xx response except 429, which is usually permanent
+	FailureClassClientError FailureClass = "4xx"
+	// FailureClassTooManyRequests is the 429 response, which is worth retrying once the endpoint recovers
+	FailureClassTooManyRequests FailureClass = "429"
+	// FailureClassServerError is the 5xx response
+	FailureClassServerError FailureClass = "5xx"
+)
+
+// FailureClasses are all the failure classes
+var FailureClasses = []FailureClass{
+	FailureClassNetwork, FailureClassTimeout, FailureClassClientError, FailureClassTooManyRequests, FailureClassServerError,
+}
+
+// ClassifyFailure classifies the error returned by a Sender. The HTTP status code of the REST sends decides the class
+// of the response failures, the other errors are either timeouts or network failures.
+func ClassifyFailure(err error) FailureClass {
+	var statusErr utils.HTTPStatusError
+	if errors.As(err, &statusErr) {
+		switch {
+		case statusErr.StatusCode == http.StatusTooManyRequests:
+			return FailureClassTooManyRequests
+		case statusErr.StatusCode >= http.StatusInternalServerError:
+			return FailureClassServerError
+		default:
+			return FailureClassClientError
+		}
+	}
+	var netErr net.Error
+	if (errors.As(err, &netErr) && netErr.Timeout()) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, os.ErrDeadlineExceeded) {
+		return FailureClassTimeout
+	}
+	return FailureClassNetwork
+}
+
+// ValidateResendLimitByFailure validates the resend limits are keyed by the known failure classes and not negative
+func ValidateResendLimitByFailure(limits map[string]int) error {
+	for class, limit := range limits {
+		if !slices.Contains(FailureClasses, FailureClass(class)) {
+			return fmt.Errorf("unknown failure class '%s' in ResendLimitByFailure, must be one of %v", class, FailureClasses)
+		}
+		if limit < 0 {
+			return fmt.Errorf("negative resend limit %d of the failure class '%s' in ResendLimitByFailure", limit, class)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyFailure(t *testing.T) {
+	statusError := func(code int) error {
+		return errors.NewCommonEdgeXWrapper(errors.NewCommonEdgeX(errors.KindMapping(code), "", utils.HTTPStatusError{StatusCode: code}))
+	}
+	tests := []struct {
+		name     string
+		err      error
+		expected FailureClass
+	}{
+		{"400", statusError(http.StatusBadRequest), FailureClassClientError},
+		{"404", statusError(http.StatusNotFound), FailureClassClientError},
+		{"429", statusError(http.StatusTooManyRequests), FailureClassTooManyRequests},
+		{"500", statusError(http.StatusInternalServerError), FailureClassServerError},
+		{"503", statusError(http.StatusServiceUnavailable), FailureClassServerError},
+		{"timeout", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the HTTP request", &net.DNSError{IsTimeout: true}), FailureClassTimeout},
+		{"deadline exceeded", errors.NewCommonEdgeXWrapper(context.DeadlineExceeded), FailureClassTimeout},
+		{"connection refused", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the HTTP request", &net.OpError{Op: "dial"}), FailureClassNetwork},
+		{"other error", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the email", nil), FailureClassNetwork},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, ClassifyFailure(testCase.err))
+		})
+	}
+}
+
+func TestValidateResendLimitByFailure(t *testing.T) {
+	assert.NoError(t, ValidateResendLimitByFailure(nil))
+	assert.NoError(t, ValidateResendLimitByFailure(map[string]int{"4xx": 0, "429": 10, "5xx": 3, "network": 2, "timeout": 1}))
+	assert.Error(t, ValidateResendLimitByFailure(map[string]int{"3xx": 1}))
+	assert.Error(t, ValidateResendLimitByFailure(map[string]int{"5xx": -1}))
+}
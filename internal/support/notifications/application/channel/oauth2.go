@@ -0,0 +1,195 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	mail "net/smtp"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+const (
+	// AuthModeOAuth2 authenticates to the SMTP server with SASL XOAUTH2 and the token of the OAuth2 client credentials
+	AuthModeOAuth2 = "oauth2"
+
+	// secretKeyClientId is the key to read the OAuth2 client id from the secret data
+	secretKeyClientId = "clientId"
+	// secretKeyClientSecret is the key to read the OAuth2 client secret from the secret data
+	secretKeyClientSecret = "clientSecret"
+
+	// tokenExpiryDelta is how long before its expiry the cached token is refreshed, so it doesn't expire during a send
+	tokenExpiryDelta = 30 * time.Second
+	// tokenRequestTimeout is the timeout of requesting the token from the token endpoint
+	tokenRequestTimeout = 30 * time.Second
+)
+
+// OAuth2ClientCredentials is the OAuth2 client requesting the token from the token endpoint
+type OAuth2ClientCredentials struct {
+	TokenEndpoint string
+	ClientId      string
+	ClientSecret  string
+	Scope         string
+}
+
+// OAuth2TokenProvider provides the bearer tokens of the XOAUTH2 authentication to the SMTP server
+type OAuth2TokenProvider interface {
+	// Token returns the cached token of the client until it is about to expire, then requests a new one
+	Token(client OAuth2ClientCredentials) (string, errors.EdgeX)
+	// Invalidate drops the cached token of the client, e.g. the token rejected by the SMTP server, so the next Token
+	// requests a new one
+	Invalidate(client OAuth2ClientCredentials)
+}
+
+type cachedToken struct {
+	accessToken string
+	// expiry is zero if the token endpoint didn't tell when the token expires
+	expiry time.Time
+}
+
+// clientCredentialsTokenProvider requests the tokens with the OAuth2 client credentials grant, and caches them until
+// they are about to expire
+type clientCredentialsTokenProvider struct {
+	mutex  sync.Mutex
+	tokens map[OAuth2ClientCredentials]cachedToken
+	now    func() time.Time
+}
+
+// NewOAuth2TokenProvider creates the OAuth2TokenProvider requesting the tokens with the client credentials grant
+func NewOAuth2TokenProvider() OAuth2TokenProvider {
+	return &clientCredentialsTokenProvider{tokens: make(map[OAuth2ClientCredentials]cachedToken), now: time.Now}
+}
+
+func (p *clientCredentialsTokenProvider) Token(client OAuth2ClientCredentials) (string, errors.EdgeX) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if token, ok := p.tokens[client]; ok && (token.expiry.IsZero() || p.now().Add(tokenExpiryDelta).Before(token.expiry)) {
+		return token.accessToken, nil
+	}
+	token, err := requestClientCredentialsToken(client, p.now())
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	p.tokens[client] = token
+	return token.accessToken, nil
+}
+
+func (p *clientCredentialsTokenProvider) Invalidate(client OAuth2ClientCredentials) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	delete(p.tokens, client)
+}
+
+// requestClientCredentialsToken requests the token with the client credentials grant, see
+// https://www.rfc-editor.org/rfc/rfc6749#section-4.4
+func requestClientCredentialsToken(client OAuth2ClientCredentials, now time.Time) (cachedToken, errors.EdgeX) {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if client.Scope != "" {
+		form.Set("scope", client.Scope)
+	}
+	req, err := http.NewRequest(http.MethodPost, client.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return cachedToken{}, errors.NewCommonEdgeX(errors.KindServerError, "fail to create the OAuth2 token request", err)
+	}
+	req.Header.Set(common.ContentType, "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(client.ClientId), url.QueryEscape(client.ClientSecret))
+
+	res, edgexErr := utils.SendRequestAndGetResponse(&http.Client{Timeout: tokenRequestTimeout}, req)
+	if edgexErr != nil {
+		return cachedToken{}, errors.NewCommonEdgeX(errors.Kind(edgexErr), "fail to request the OAuth2 token", edgexErr)
+	}
+	var tokenResponse struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err = json.Unmarshal([]byte(res), &tokenResponse); err != nil {
+		return cachedToken{}, errors.NewCommonEdgeX(errors.KindServerError, "fail to decode the OAuth2 token response", err)
+	}
+	if tokenResponse.AccessToken == "" {
+		return cachedToken{}, errors.NewCommonEdgeX(errors.KindServerError, "the OAuth2 token response has no access_token", nil)
+	}
+	token := cachedToken{accessToken: tokenResponse.AccessToken}
+	if tokenResponse.ExpiresIn > 0 {
+		token.expiry = now.Add(time.Duration(tokenResponse.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// xoauth2Auth implements the SASL XOAUTH2 mechanism, see
+// https://developers.google.com/gmail/imap/xoauth2-protocol#the_sasl_xoauth2_mechanism
+type xoauth2Auth struct {
+	username string
+	token    string
+}
+
+func (a *xoauth2Auth) Start(server *mail.ServerInfo) (string, []byte, error) {
+	// Like mail.PlainAuth, the token is only sent over TLS or to localhost
+	if !server.TLS && server.Name != "localhost" && server.Name != "127.0.0.1" && server.Name != "::1" {
+		return "", nil, fmt.Errorf("unencrypted connection")
+	}
+	return "XOAUTH2", []byte("user=" + a.username + "\x01auth=Bearer " + a.token + "\x01\x01"), nil
+}
+
+func (a *xoauth2Auth) Next(_ []byte, more bool) ([]byte, error) {
+	if more {
+		// the server challenges with the error details of the rejected token, the empty response completes the
+		// exchange with the authentication failure
+		return []byte{}, nil
+	}
+	return nil, nil
+}
+
+// oauth2ClientCredentials reads the XOAUTH2 username and the OAuth2 client credentials from the secret
+func oauth2ClientCredentials(dic *di.Container, s config.SmtpInfo) (string, OAuth2ClientCredentials, errors.EdgeX) {
+	if s.TokenEndpoint == "" {
+		return "", OAuth2ClientCredentials{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "TokenEndpoint is required by the oauth2 SMTP AuthMode", nil)
+	}
+	secretProvider := container.SecretProviderFrom(dic.Get)
+	if secretProvider == nil {
+		return "", OAuth2ClientCredentials{}, errors.NewCommonEdgeX(errors.KindServerError, "secret provider is missing. Make sure it is specified to be used in bootstrap.Run()", nil)
+	}
+	secrets, err := secretProvider.GetSecret(s.SecretName, secretKeyUsername, secretKeyClientId, secretKeyClientSecret)
+	if err != nil {
+		return "", OAuth2ClientCredentials{}, errors.NewCommonEdgeX(errors.Kind(err), "fail to retrieve the secrets from the secret store", err)
+	}
+	for _, key := range []string{secretKeyUsername, secretKeyClientId, secretKeyClientSecret} {
+		if secrets[key] == "" {
+			return "", OAuth2ClientCredentials{}, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("%s doesn't exist for SMTP OAuth2 auth", key), nil)
+		}
+	}
+	return secrets[secretKeyUsername], OAuth2ClientCredentials{
+		TokenEndpoint: s.TokenEndpoint,
+		ClientId:      secrets[secretKeyClientId],
+		ClientSecret:  secrets[secretKeyClientSecret],
+		Scope:         s.Scope,
+	}, nil
+}
+
+// ValidateSmtpAuthMode validates the TokenEndpoint of the 'oauth2' AuthMode, the other AuthModes authenticate with the
+// username and password
+func ValidateSmtpAuthMode(s config.SmtpInfo) error {
+	if !strings.EqualFold(s.AuthMode, AuthModeOAuth2) {
+		return nil
+	}
+	endpoint, err := url.Parse(s.TokenEndpoint)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return fmt.Errorf("Smtp TokenEndpoint '%s' of the oauth2 AuthMode must be an absolute http or https URL", s.TokenEndpoint)
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	bootstrapMocks "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTokenProvider hands out the stale token until it is invalidated, then the fresh token
+type mockTokenProvider struct {
+	invalidated int
+}
+
+func (p *mockTokenProvider) Token(_ OAuth2ClientCredentials) (string, errors.EdgeX) {
+	if p.invalidated > 0 {
+		return "fresh-token", nil
+	}
+	return "stale-token", nil
+}
+
+func (p *mockTokenProvider) Invalidate(_ OAuth2ClientCredentials) {
+	p.invalidated++
+}
+
+// startXOAuth2SmtpServer starts an SMTP server on localhost which only accepts the XOAUTH2 authentication with the
+// accepted token, and returns its port and the channel of the received messages
+func startXOAuth2SmtpServer(t *testing.T, acceptedToken string) (int, <-chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+	messages := make(chan string, 10)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			serveXOAuth2SmtpConn(conn, acceptedToken, messages)
+		}
+	}()
+	return listener.Addr().(*net.TCPAddr).Port, messages
+}
+
+func serveXOAuth2SmtpConn(conn net.Conn, acceptedToken string, messages chan<- string) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = fmt.Fprintf(conn, "%s\r\n", line) }
+	reply("220 localhost ESMTP")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimSpace(line)
+		switch command := strings.ToUpper(strings.SplitN(line, " ", 2)[0]); command {
+		case "EHLO":
+			reply("250-localhost")
+			reply("250 AUTH XOAUTH2")
+		case "AUTH":
+			initialResponse, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(line, "AUTH XOAUTH2 "))
+			if string(initialResponse) == "user=user@example.com\x01auth=Bearer "+acceptedToken+"\x01\x01" {
+				reply("235 2.7.0 Accepted")
+				continue
+			}
+			reply("334 " + base64.StdEncoding.EncodeToString([]byte(`{"status":"401"}`)))
+			_, _ = reader.ReadString('\n')
+			reply("535 5.7.8 Username and Password not accepted")
+		case "MAIL", "RCPT":
+			reply("250 OK")
+		case "DATA":
+			reply("354 Go ahead")
+			var message strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil || dataLine == ".\r\n" {
+					break
+				}
+				message.WriteString(dataLine)
+			}
+			messages <- message.String()
+			reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
+
+func TestEmailSenderSendWithOAuth2(t *testing.T) {
+	port, messages := startXOAuth2SmtpServer(t, "fresh-token")
+	secretProvider := &bootstrapMocks.SecretProvider{}
+	secretProvider.On("GetSecret", "smtp", secretKeyUsername, secretKeyClientId, secretKeyClientSecret).
+		Return(map[string]string{secretKeyUsername: "user@example.com", secretKeyClientId: "client", secretKeyClientSecret: "secret"}, nil)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+			return secretProvider
+		},
+		notificationContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{Smtp: config.SmtpInfo{Host: "127.0.0.1", Port: port, Sender: "edgex@example.com",
+				Subject: "EdgeX Notification", SecretName: "smtp", AuthMode: AuthModeOAuth2, TokenEndpoint: "https://login.example.com/token"}}
+		},
+	})
+	tokenProvider := &mockTokenProvider{}
+	sender := &EmailSender{dic: dic, tokenProvider: tokenProvider}
+	address := models.EmailAddress{BaseAddress: models.BaseAddress{Type: common.EMAIL}, Recipients: []string{"ops@example.com"}}
+
+	// the stale token is rejected by the SMTP server, so it is refreshed and the email is sent again
+	_, err := sender.Send(models.Notification{Sender: "edgex", Content: "the device is down"}, address)
+	require.NoError(t, err)
+	assert.Equal(t, 1, tokenProvider.invalidated)
+	assert.Contains(t, <-messages, "the device is down")
+
+	// the refreshed token is used by the next send without another refresh
+	_, err = sender.Send(models.Notification{Sender: "edgex", Content: "the device is up"}, address)
+	require.NoError(t, err)
+	assert.Equal(t, 1, tokenProvider.invalidated)
+	assert.Contains(t, <-messages, "the device is up")
+}
+
+func TestEmailSenderSendWithOAuth2_Rejected(t *testing.T) {
+	port, _ := startXOAuth2SmtpServer(t, "another-token")
+	secretProvider := &bootstrapMocks.SecretProvider{}
+	secretProvider.On("GetSecret", "smtp", secretKeyUsername, secretKeyClientId, secretKeyClientSecret).
+		Return(map[string]string{secretKeyUsername: "user@example.com", secretKeyClientId: "client", secretKeyClientSecret: "secret"}, nil)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+			return secretProvider
+		},
+		notificationContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{Smtp: config.SmtpInfo{Host: "127.0.0.1", Port: port, Sender: "edgex@example.com",
+				SecretName: "smtp", AuthMode: AuthModeOAuth2, TokenEndpoint: "https://login.example.com/token"}}
+		},
+	})
+	tokenProvider := &mockTokenProvider{}
+	sender := &EmailSender{dic: dic, tokenProvider: tokenProvider}
+	address := models.EmailAddress{BaseAddress: models.BaseAddress{Type: common.EMAIL}, Recipients: []string{"ops@example.com"}}
+
+	// the refreshed token is rejected as well, so the send fails after one refresh
+	_, err := sender.Send(models.Notification{Sender: "edgex", Content: "the device is down"}, address)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindUnauthorized, errors.Kind(err))
+	assert.Equal(t, 1, tokenProvider.invalidated)
+}
+
+func TestClientCredentialsTokenProvider(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		clientId, clientSecret, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "client", clientId)
+		assert.Equal(t, "secret", clientSecret)
+		assert.Equal(t, "client_credentials", r.FormValue("grant_type"))
+		assert.Equal(t, "https://outlook.office365.com/.default", r.FormValue("scope"))
+		w.Header().Set(common.ContentType, common.ContentTypeJSON)
+		_, _ = fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "Bearer", "expires_in": 3600}`, requests)
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	provider := &clientCredentialsTokenProvider{tokens: make(map[OAuth2ClientCredentials]cachedToken), now: func() time.Time { return now }}
+	client := OAuth2ClientCredentials{TokenEndpoint: server.URL, ClientId: "client", ClientSecret: "secret", Scope: "https://outlook.office365.com/.default"}
+
+	token, err := provider.Token(client)
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	// the cached token is used until it is about to expire
+	now = now.Add(time.Hour - tokenExpiryDelta - time.Second)
+	token, err = provider.Token(client)
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	now = now.Add(time.Second)
+	token, err = provider.Token(client)
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token)
+
+	provider.Invalidate(client)
+	token, err = provider.Token(client)
+	require.NoError(t, err)
+	assert.Equal(t, "token-3", token)
+	assert.Equal(t, 3, requests)
+}
+
+func TestClientCredentialsTokenProvider_Failed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/empty" {
+			_, _ = w.Write([]byte(`{"token_type": "Bearer"}`))
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error": "invalid_client"}`))
+	}))
+	defer server.Close()
+
+	provider := NewOAuth2TokenProvider()
+	_, err := provider.Token(OAuth2ClientCredentials{TokenEndpoint: server.URL, ClientId: "client", ClientSecret: "wrong"})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindUnauthorized, errors.Kind(err))
+	_, err = provider.Token(OAuth2ClientCredentials{TokenEndpoint: server.URL + "/empty", ClientId: "client", ClientSecret: "secret"})
+	require.Error(t, err)
+}
+
+func TestValidateSmtpAuthMode(t *testing.T) {
+	assert.NoError(t, ValidateSmtpAuthMode(config.SmtpInfo{AuthMode: "usernamepassword"}))
+	assert.NoError(t, ValidateSmtpAuthMode(config.SmtpInfo{AuthMode: "OAuth2", TokenEndpoint: "https://login.example.com/token"}))
+	assert.Error(t, ValidateSmtpAuthMode(config.SmtpInfo{AuthMode: AuthModeOAuth2}))
+	assert.Error(t, ValidateSmtpAuthMode(config.SmtpInfo{AuthMode: AuthModeOAuth2, TokenEndpoint: "/token"}))
+}
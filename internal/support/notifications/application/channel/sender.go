@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/json"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	zmq "github.com/pebbe/zmq4"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
@@ -64,17 +66,20 @@ func (sender *RESTSender) Send(notification models.Notification, address models.
 
 // EmailSender is the implementation of the interfaces.ChannelSender, which is used to send the notifications via email
 type EmailSender struct {
-	dic *di.Container
+	dic           *di.Container
+	tokenProvider OAuth2TokenProvider
 }
 
 // NewEmailSender creates the EmailSender instance
 func NewEmailSender(dic *di.Container) Sender {
-	return &EmailSender{dic: dic}
+	return &EmailSender{dic: dic, tokenProvider: NewOAuth2TokenProvider()}
 }
 
 // Send sends the email to the specified address
 func (sender *EmailSender) Send(notification models.Notification, address models.Address) (res string, err errors.EdgeX) {
-	smtpInfo := notificationContainer.ConfigurationFrom(sender.dic.Get).Smtp
+	// Resolve the SMTP settings and the credential on every send, so the changes of Writable.Smtp and
+	// the rotated secret take effect without restarting the service
+	smtpInfo := notificationContainer.ConfigurationFrom(sender.dic.Get).EffectiveSmtpInfo()
 
 	emailAddress, ok := address.(models.EmailAddress)
 	if !ok {
@@ -82,6 +87,9 @@ func (sender *EmailSender) Send(notification models.Notification, address models
 	}
 
 	msg := buildSmtpMessage(notification.Sender, smtpInfo.Subject, emailAddress.Recipients, notification.ContentType, notification.Content)
+	if strings.EqualFold(smtpInfo.AuthMode, AuthModeOAuth2) {
+		return "", sender.sendWithOAuth2(smtpInfo, emailAddress.Recipients, msg)
+	}
 	auth, err := deduceAuth(sender.dic, smtpInfo)
 	if err != nil {
 		return "", errors.NewCommonEdgeXWrapper(err)
@@ -93,6 +101,30 @@ func (sender *EmailSender) Send(notification models.Notification, address models
 	return "", nil
 }
 
+// sendWithOAuth2 sends the email with the XOAUTH2 authentication. The token rejected by the SMTP server, e.g. revoked
+// or expired earlier than told by the token endpoint, is refreshed and the email is sent again once.
+func (sender *EmailSender) sendWithOAuth2(smtpInfo config.SmtpInfo, recipients []string, msg []byte) errors.EdgeX {
+	username, client, err := oauth2ClientCredentials(sender.dic, smtpInfo)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	for attempt := 0; ; attempt++ {
+		token, err := sender.tokenProvider.Token(client)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		err = sendEmail(smtpInfo, &xoauth2Auth{username: username, token: token}, recipients, msg)
+		if err == nil {
+			return nil
+		}
+		if errors.Kind(err) != errors.KindUnauthorized || attempt > 0 {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		container.LoggingClientFrom(sender.dic.Get).Debugf("the SMTP server rejected the OAuth2 token, refresh the token and send the email again, %v", err)
+		sender.tokenProvider.Invalidate(client)
+	}
+}
+
 // MQTTSender is the implementation of the interfaces.ChannelSender, which is used to send the notifications via MQTT broker
 type MQTTSender struct {
 	dic   *di.Container
@@ -0,0 +1,157 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// SlackScheme is the scheme of the REST channels which are posted to the configured Slack incoming webhook, whose host,
+// port and path are ignored
+const SlackScheme = "slack"
+
+const secretKeyURL = "url"
+
+var slackSeverityColors = map[models.NotificationSeverity]string{
+	models.Critical: "danger",
+	models.Minor:    "warning",
+	models.Normal:   "good",
+}
+
+// slackEscaper escapes the control characters of the Slack message text, see
+// https://api.slack.com/reference/surfaces/formatting#escaping
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+type slackField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+type slackAttachment struct {
+	Color    string       `json:"color,omitempty"`
+	Fallback string       `json:"fallback"`
+	Text     string       `json:"text"`
+	Fields   []slackField `json:"fields"`
+}
+
+type slackMessage struct {
+	Username    string            `json:"username,omitempty"`
+	Text        string            `json:"text"`
+	Attachments []slackAttachment `json:"attachments"`
+}
+
+// IsSlackAddress returns whether the address is a REST channel posted to the configured Slack incoming webhook
+func IsSlackAddress(address models.Address) bool {
+	base := address.GetBaseAddress()
+	return base.Type == common.REST && strings.EqualFold(base.Scheme, SlackScheme)
+}
+
+// ValidateSlackInfo validates the Slack configuration
+func ValidateSlackInfo(slack config.SlackInfo) error {
+	if slack.Timeout != "" {
+		timeout, err := time.ParseDuration(slack.Timeout)
+		if err != nil {
+			return fmt.Errorf("Slack has the invalid Timeout '%s': %w", slack.Timeout, err)
+		}
+		if timeout < 0 {
+			return fmt.Errorf("Slack Timeout must not be negative")
+		}
+	}
+	return nil
+}
+
+// buildSlackMessage formats the notification as a Slack message, whose attachment is colored by the severity
+func buildSlackMessage(slack config.SlackInfo, notification models.Notification) ([]byte, errors.EdgeX) {
+	title := fmt.Sprintf("[%s] %s", notification.Severity, notification.Category)
+	if slack.Subject != "" {
+		title = fmt.Sprintf("%s: %s", slack.Subject, title)
+	}
+	message := slackMessage{
+		Username: slack.Sender,
+		Text:     fmt.Sprintf("*%s*", slackEscaper.Replace(title)),
+		Attachments: []slackAttachment{{
+			Color:    slackSeverityColors[notification.Severity],
+			Fallback: slackEscaper.Replace(fmt.Sprintf("%s %s", title, notification.Content)),
+			Text:     slackEscaper.Replace(notification.Content),
+			Fields: []slackField{
+				{Title: "Category", Value: slackEscaper.Replace(notification.Category), Short: true},
+				{Title: "Severity", Value: string(notification.Severity), Short: true},
+			},
+		}},
+	}
+	data, err := json.Marshal(message)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "fail to encode the Slack message", err)
+	}
+	return data, nil
+}
+
+// SlackSender is the implementation of the interfaces.ChannelSender, which is used to post the notifications to the
+// configured Slack incoming webhook
+type SlackSender struct {
+	dic *di.Container
+}
+
+// NewSlackSender creates the SlackSender instance
+func NewSlackSender(dic *di.Container) Sender {
+	return &SlackSender{dic: dic}
+}
+
+// Send posts the notification as a Slack message to the incoming webhook
+func (sender *SlackSender) Send(notification models.Notification, address models.Address) (res string, err errors.EdgeX) {
+	lc := container.LoggingClientFrom(sender.dic.Get)
+	// Resolve the incoming webhook URL on every send, so the rotated secret takes effect without restarting the service
+	slack := notificationContainer.ConfigurationFrom(sender.dic.Get).Slack
+	webhookURL, err := secretURL(sender.dic, slack.SecretName, "Slack")
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	body, err := buildSlackMessage(slack, notification)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	res, err = sendJSONRequest(http.MethodPost, webhookURL, body, nil, slack.Timeout)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Debugf("success to send the notification %s to Slack", notification.Id)
+	return res, nil
+}
+
+// secretURL reads the incoming webhook URL of the service from the 'url' key of the secret
+func secretURL(dic *di.Container, secretName string, service string) (string, errors.EdgeX) {
+	if secretName == "" {
+		return "", errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("the %s SecretName is not configured", service), nil)
+	}
+	secretProvider := container.SecretProviderFrom(dic.Get)
+	if secretProvider == nil {
+		return "", errors.NewCommonEdgeX(errors.KindServerError, "secret provider is missing. Make sure it is specified to be used in bootstrap.Run()", nil)
+	}
+	secrets, err := secretProvider.GetSecret(secretName, secretKeyURL)
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to retrieve the %s URL from the secret store", service), err)
+	}
+	webhookURL := secrets[secretKeyURL]
+	if webhookURL == "" {
+		return "", errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("url doesn't exist for %s", service), nil)
+	}
+	return webhookURL, nil
+}
@@ -0,0 +1,111 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	bootstrapMocks "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func slackTestDic(slack config.SlackInfo, secretProvider *bootstrapMocks.SecretProvider) *di.Container {
+	return di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+			return secretProvider
+		},
+		notificationContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{Slack: slack}
+		},
+	})
+}
+
+func TestSlackSenderSend(t *testing.T) {
+	var received slackMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/services/T000/B000", r.URL.Path)
+		assert.Equal(t, common.ContentTypeJSON, r.Header.Get(common.ContentType))
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &received)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	slack := config.SlackInfo{Sender: "EdgeX", Subject: "EdgeX Notification", SecretName: "slack", Timeout: "5s"}
+	secretProvider := &bootstrapMocks.SecretProvider{}
+	secretProvider.On("GetSecret", slack.SecretName, secretKeyURL).
+		Return(map[string]string{secretKeyURL: server.URL + "/services/T000/B000"}, nil)
+	n := models.Notification{Id: "notification-id", Category: "health-check", Severity: models.Critical, Content: "device <thermostat> & gateway are down"}
+
+	res, err := NewSlackSender(slackTestDic(slack, secretProvider)).Send(n, webhookAddress)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", res)
+	assert.Equal(t, "EdgeX", received.Username)
+	assert.Equal(t, "*EdgeX Notification: [CRITICAL] health-check*", received.Text)
+	require.Len(t, received.Attachments, 1)
+	assert.Equal(t, "danger", received.Attachments[0].Color)
+	assert.Equal(t, "device &lt;thermostat&gt; &amp; gateway are down", received.Attachments[0].Text)
+	assert.Equal(t, []slackField{
+		{Title: "Category", Value: "health-check", Short: true},
+		{Title: "Severity", Value: "CRITICAL", Short: true},
+	}, received.Attachments[0].Fields)
+	secretProvider.AssertExpectations(t)
+}
+
+func TestSlackSenderSend_Failed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	secretProvider := &bootstrapMocks.SecretProvider{}
+	secretProvider.On("GetSecret", "slack", secretKeyURL).Return(map[string]string{secretKeyURL: server.URL}, nil)
+	secretProvider.On("GetSecret", "empty", secretKeyURL).Return(map[string]string{}, nil)
+	secretProvider.On("GetSecret", "missing", secretKeyURL).Return(nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	n := models.Notification{Category: "health-check", Severity: models.Normal, Content: "test"}
+
+	_, err := NewSlackSender(slackTestDic(config.SlackInfo{SecretName: "slack"}, secretProvider)).Send(n, webhookAddress)
+	require.Error(t, err)
+	assert.Equal(t, FailureClassTooManyRequests, ClassifyFailure(err))
+
+	for _, secretName := range []string{"", "empty", "missing"} {
+		_, err = NewSlackSender(slackTestDic(config.SlackInfo{SecretName: secretName}, secretProvider)).Send(n, webhookAddress)
+		assert.Error(t, err, "the incoming webhook URL of the secret %s is not available", secretName)
+	}
+}
+
+func TestIsSlackAddress(t *testing.T) {
+	slackAddress := webhookAddress
+	slackAddress.Scheme = "Slack"
+	assert.True(t, IsSlackAddress(slackAddress))
+	assert.False(t, IsSlackAddress(webhookAddress))
+}
+
+func TestValidateSlackInfo(t *testing.T) {
+	assert.NoError(t, ValidateSlackInfo(config.SlackInfo{}))
+	assert.NoError(t, ValidateSlackInfo(config.SlackInfo{Timeout: "10s"}))
+	assert.Error(t, ValidateSlackInfo(config.SlackInfo{Timeout: "soon"}))
+	assert.Error(t, ValidateSlackInfo(config.SlackInfo{Timeout: "-1s"}))
+}
@@ -0,0 +1,171 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// TeamsScheme is the scheme of the REST channels which are posted to the configured Microsoft Teams incoming webhook,
+// whose host, port and path are ignored
+const TeamsScheme = "teams"
+
+const (
+	teamsCardContentType = "application/vnd.microsoft.card.adaptive"
+	teamsCardSchema      = "http://adaptivecards.io/schemas/adaptive-card.json"
+	teamsCardVersion     = "1.4"
+)
+
+// teamsSeverityColors maps the severity to the color of the card title and the style of the card container, see
+// https://adaptivecards.io/explorer/TextBlock.html
+var teamsSeverityColors = map[models.NotificationSeverity]string{
+	models.Critical: "attention",
+	models.Minor:    "warning",
+	models.Normal:   "good",
+}
+
+type teamsFact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+// teamsCardElement is an element of the adaptive card body, i.e. a Container, a TextBlock or a FactSet
+type teamsCardElement struct {
+	Type   string             `json:"type"`
+	Style  string             `json:"style,omitempty"`
+	Items  []teamsCardElement `json:"items,omitempty"`
+	Text   string             `json:"text,omitempty"`
+	Weight string             `json:"weight,omitempty"`
+	Size   string             `json:"size,omitempty"`
+	Color  string             `json:"color,omitempty"`
+	Wrap   bool               `json:"wrap,omitempty"`
+	Facts  []teamsFact        `json:"facts,omitempty"`
+}
+
+type teamsCard struct {
+	Schema  string             `json:"$schema"`
+	Type    string             `json:"type"`
+	Version string             `json:"version"`
+	Body    []teamsCardElement `json:"body"`
+}
+
+type teamsAttachment struct {
+	ContentType string    `json:"contentType"`
+	Content     teamsCard `json:"content"`
+}
+
+type teamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []teamsAttachment `json:"attachments"`
+}
+
+// IsTeamsAddress returns whether the address is a REST channel posted to the configured Microsoft Teams incoming webhook
+func IsTeamsAddress(address models.Address) bool {
+	base := address.GetBaseAddress()
+	return base.Type == common.REST && strings.EqualFold(base.Scheme, TeamsScheme)
+}
+
+// ValidateTeamsInfo validates the Microsoft Teams configuration
+func ValidateTeamsInfo(teams config.TeamsInfo) error {
+	if teams.Timeout != "" {
+		timeout, err := time.ParseDuration(teams.Timeout)
+		if err != nil {
+			return fmt.Errorf("Teams has the invalid Timeout '%s': %w", teams.Timeout, err)
+		}
+		if timeout < 0 {
+			return fmt.Errorf("Teams Timeout must not be negative")
+		}
+	}
+	return nil
+}
+
+// buildTeamsMessage formats the notification as a message with an adaptive card, whose title is colored by the severity
+func buildTeamsMessage(teams config.TeamsInfo, notification models.Notification) ([]byte, errors.EdgeX) {
+	title := fmt.Sprintf("[%s] %s", notification.Severity, notification.Category)
+	if teams.Subject != "" {
+		title = fmt.Sprintf("%s: %s", teams.Subject, title)
+	}
+	color := teamsSeverityColors[notification.Severity]
+	facts := []teamsFact{
+		{Title: "Category", Value: notification.Category},
+		{Title: "Severity", Value: string(notification.Severity)},
+		{Title: "Sender", Value: notification.Sender},
+	}
+	if notification.Description != "" {
+		facts = append(facts, teamsFact{Title: "Description", Value: notification.Description})
+	}
+	message := teamsMessage{
+		Type: "message",
+		Attachments: []teamsAttachment{{
+			ContentType: teamsCardContentType,
+			Content: teamsCard{
+				Schema:  teamsCardSchema,
+				Type:    "AdaptiveCard",
+				Version: teamsCardVersion,
+				Body: []teamsCardElement{
+					{
+						Type:  "Container",
+						Style: color,
+						Items: []teamsCardElement{{Type: "TextBlock", Text: title, Weight: "bolder", Size: "medium", Color: color, Wrap: true}},
+					},
+					{Type: "TextBlock", Text: notification.Content, Wrap: true},
+					{Type: "FactSet", Facts: facts},
+				},
+			},
+		}},
+	}
+	data, err := json.Marshal(message)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "fail to encode the Teams message", err)
+	}
+	return data, nil
+}
+
+// TeamsSender is the implementation of the interfaces.ChannelSender, which is used to post the notifications to the
+// configured Microsoft Teams incoming webhook
+type TeamsSender struct {
+	dic *di.Container
+}
+
+// NewTeamsSender creates the TeamsSender instance
+func NewTeamsSender(dic *di.Container) Sender {
+	return &TeamsSender{dic: dic}
+}
+
+// Send posts the notification as an adaptive card to the incoming webhook
+func (sender *TeamsSender) Send(notification models.Notification, address models.Address) (res string, err errors.EdgeX) {
+	lc := container.LoggingClientFrom(sender.dic.Get)
+	// Resolve the incoming webhook URL on every send, so the rotated secret takes effect without restarting the service
+	teams := notificationContainer.ConfigurationFrom(sender.dic.Get).Teams
+	webhookURL, err := secretURL(sender.dic, teams.SecretName, "Teams")
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	body, err := buildTeamsMessage(teams, notification)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	res, err = sendJSONRequest(http.MethodPost, webhookURL, body, nil, teams.Timeout)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Debugf("success to send the notification %s to Teams", notification.Id)
+	return res, nil
+}
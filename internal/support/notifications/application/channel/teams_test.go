@@ -0,0 +1,155 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	bootstrapMocks "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func teamsTestDic(teams config.TeamsInfo, secretProvider *bootstrapMocks.SecretProvider) *di.Container {
+	return di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+			return secretProvider
+		},
+		notificationContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{Teams: teams}
+		},
+	})
+}
+
+func TestTeamsSenderSend(t *testing.T) {
+	var received teamsMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/webhookb2/incoming", r.URL.Path)
+		assert.Equal(t, common.ContentTypeJSON, r.Header.Get(common.ContentType))
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &received)
+		_, _ = w.Write([]byte("1"))
+	}))
+	defer server.Close()
+
+	teams := config.TeamsInfo{Subject: "EdgeX Notification", SecretName: "teams", Timeout: "5s"}
+	secretProvider := &bootstrapMocks.SecretProvider{}
+	secretProvider.On("GetSecret", teams.SecretName, secretKeyURL).
+		Return(map[string]string{secretKeyURL: server.URL + "/webhookb2/incoming"}, nil)
+	n := models.Notification{
+		Id:          "notification-id",
+		Category:    "health-check",
+		Severity:    models.Critical,
+		Sender:      "core-metadata",
+		Description: "device health",
+		Content:     "device thermostat is down",
+	}
+
+	res, err := NewTeamsSender(teamsTestDic(teams, secretProvider)).Send(n, webhookAddress)
+	require.NoError(t, err)
+	assert.Equal(t, "1", res)
+	assert.Equal(t, "message", received.Type)
+	require.Len(t, received.Attachments, 1)
+	assert.Equal(t, teamsCardContentType, received.Attachments[0].ContentType)
+	card := received.Attachments[0].Content
+	assert.Equal(t, "AdaptiveCard", card.Type)
+	assert.Equal(t, teamsCardSchema, card.Schema)
+	require.Len(t, card.Body, 3)
+	assert.Equal(t, "attention", card.Body[0].Style)
+	require.Len(t, card.Body[0].Items, 1)
+	assert.Equal(t, "EdgeX Notification: [CRITICAL] health-check", card.Body[0].Items[0].Text)
+	assert.Equal(t, "attention", card.Body[0].Items[0].Color)
+	assert.Equal(t, "device thermostat is down", card.Body[1].Text)
+	assert.Equal(t, []teamsFact{
+		{Title: "Category", Value: "health-check"},
+		{Title: "Severity", Value: "CRITICAL"},
+		{Title: "Sender", Value: "core-metadata"},
+		{Title: "Description", Value: "device health"},
+	}, card.Body[2].Facts)
+	secretProvider.AssertExpectations(t)
+}
+
+func TestBuildTeamsMessage(t *testing.T) {
+	tests := []struct {
+		name          string
+		severity      models.NotificationSeverity
+		description   string
+		expectedColor string
+		expectedFacts int
+	}{
+		{"critical", models.Critical, "device health", "attention", 4},
+		{"minor", models.Minor, "device health", "warning", 4},
+		{"normal without description", models.Normal, "", "good", 3},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			n := models.Notification{Category: "health-check", Severity: testCase.severity, Sender: "core-metadata", Description: testCase.description, Content: "test"}
+			data, err := buildTeamsMessage(config.TeamsInfo{}, n)
+			require.NoError(t, err)
+
+			var message teamsMessage
+			require.NoError(t, json.Unmarshal(data, &message))
+			card := message.Attachments[0].Content
+			assert.Equal(t, testCase.expectedColor, card.Body[0].Style)
+			assert.Equal(t, "["+string(testCase.severity)+"] health-check", card.Body[0].Items[0].Text)
+			assert.Len(t, card.Body[2].Facts, testCase.expectedFacts)
+		})
+	}
+}
+
+func TestTeamsSenderSend_Failed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	secretProvider := &bootstrapMocks.SecretProvider{}
+	secretProvider.On("GetSecret", "teams", secretKeyURL).Return(map[string]string{secretKeyURL: server.URL}, nil)
+	secretProvider.On("GetSecret", "empty", secretKeyURL).Return(map[string]string{}, nil)
+	secretProvider.On("GetSecret", "missing", secretKeyURL).Return(nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	n := models.Notification{Category: "health-check", Severity: models.Normal, Content: "test"}
+
+	_, err := NewTeamsSender(teamsTestDic(config.TeamsInfo{SecretName: "teams"}, secretProvider)).Send(n, webhookAddress)
+	require.Error(t, err)
+	assert.Equal(t, FailureClassTooManyRequests, ClassifyFailure(err))
+
+	for _, secretName := range []string{"", "empty", "missing"} {
+		_, err = NewTeamsSender(teamsTestDic(config.TeamsInfo{SecretName: secretName}, secretProvider)).Send(n, webhookAddress)
+		assert.Error(t, err, "the incoming webhook URL of the secret %s is not available", secretName)
+	}
+}
+
+func TestIsTeamsAddress(t *testing.T) {
+	teamsAddress := webhookAddress
+	teamsAddress.Scheme = "Teams"
+	assert.True(t, IsTeamsAddress(teamsAddress))
+	assert.False(t, IsTeamsAddress(webhookAddress))
+}
+
+func TestValidateTeamsInfo(t *testing.T) {
+	assert.NoError(t, ValidateTeamsInfo(config.TeamsInfo{}))
+	assert.NoError(t, ValidateTeamsInfo(config.TeamsInfo{Timeout: "10s"}))
+	assert.Error(t, ValidateTeamsInfo(config.TeamsInfo{Timeout: "soon"}))
+	assert.Error(t, ValidateTeamsInfo(config.TeamsInfo{Timeout: "-1s"}))
+}
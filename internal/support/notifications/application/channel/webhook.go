@@ -0,0 +1,183 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// WebhookScheme is the scheme of the REST channels which are delivered to the configured Webhook, whose host, port and
+// path are ignored
+const WebhookScheme = "webhook"
+
+// DefaultWebhookBodyTemplate is the body of the webhook request when the Webhook has no BodyTemplate
+const DefaultWebhookBodyTemplate = `{"content": {{json .Content}}, "category": {{json .Category}}, "severity": {{json .Severity}}, "sender": {{json .Sender}}}`
+
+var webhookTemplateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+// IsWebhookAddress returns whether the address is a REST channel delivered to the configured Webhook
+func IsWebhookAddress(address models.Address) bool {
+	base := address.GetBaseAddress()
+	return base.Type == common.REST && strings.EqualFold(base.Scheme, WebhookScheme)
+}
+
+// ValidateWebhookInfo validates the Webhook configuration, the empty URL disables the webhook and is always valid
+func ValidateWebhookInfo(webhook config.WebhookInfo) error {
+	if webhook.URL == "" {
+		return nil
+	}
+	webhookURL, err := url.Parse(webhook.URL)
+	if err != nil {
+		return fmt.Errorf("Webhook has the invalid URL '%s': %w", webhook.URL, err)
+	}
+	if (webhookURL.Scheme != "http" && webhookURL.Scheme != "https") || webhookURL.Host == "" {
+		return fmt.Errorf("Webhook URL '%s' must be an absolute http or https URL", webhook.URL)
+	}
+	if webhook.Method != "" && !utils.ValidMethod(webhook.Method) {
+		return fmt.Errorf("Webhook has the invalid Method '%s'", webhook.Method)
+	}
+	if webhook.Timeout != "" {
+		timeout, err := time.ParseDuration(webhook.Timeout)
+		if err != nil {
+			return fmt.Errorf("Webhook has the invalid Timeout '%s': %w", webhook.Timeout, err)
+		}
+		if timeout < 0 {
+			return fmt.Errorf("Webhook Timeout must not be negative")
+		}
+	}
+	if _, err := parseWebhookBodyTemplate(webhook.BodyTemplate); err != nil {
+		return fmt.Errorf("Webhook has the invalid BodyTemplate: %w", err)
+	}
+	return nil
+}
+
+func parseWebhookBodyTemplate(bodyTemplate string) (*template.Template, error) {
+	if bodyTemplate == "" {
+		bodyTemplate = DefaultWebhookBodyTemplate
+	}
+	return template.New("webhook").Funcs(webhookTemplateFuncs).Option("missingkey=error").Parse(bodyTemplate)
+}
+
+// renderWebhookBody executes the body template with the notification, and the rendered body must be valid JSON
+func renderWebhookBody(bodyTemplate string, notification models.Notification) ([]byte, errors.EdgeX) {
+	tmpl, err := parseWebhookBodyTemplate(bodyTemplate)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to parse the webhook body template", err)
+	}
+	var body bytes.Buffer
+	if err = tmpl.Execute(&body, notification); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to render the webhook body", err)
+	}
+	if !json.Valid(body.Bytes()) {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "the rendered webhook body is not valid JSON", nil)
+	}
+	return body.Bytes(), nil
+}
+
+// WebhookSender is the implementation of the interfaces.ChannelSender, which is used to post the notifications to the
+// configured Webhook
+type WebhookSender struct {
+	dic *di.Container
+}
+
+// NewWebhookSender creates the WebhookSender instance
+func NewWebhookSender(dic *di.Container) Sender {
+	return &WebhookSender{dic: dic}
+}
+
+// Send posts the notification rendered with the body template to the webhook
+func (sender *WebhookSender) Send(notification models.Notification, address models.Address) (res string, err errors.EdgeX) {
+	lc := container.LoggingClientFrom(sender.dic.Get)
+	// Resolve the webhook and the secret headers on every send, so the rotated secret takes effect without restarting
+	// the service
+	webhook := notificationContainer.ConfigurationFrom(sender.dic.Get).Webhook
+	if webhook.URL == "" {
+		return "", errors.NewCommonEdgeX(errors.KindServiceUnavailable, "the webhook is not configured", nil)
+	}
+
+	body, err := renderWebhookBody(webhook.BodyTemplate, notification)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	method := webhook.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+	var headers map[string]string
+	if webhook.SecretName != "" {
+		headers, err = webhookSecretHeaders(sender.dic, webhook.SecretName)
+		if err != nil {
+			return "", errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	res, err = sendJSONRequest(method, webhook.URL, body, headers, webhook.Timeout)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Debugf("success to send the notification %s to the webhook", notification.Id)
+	return res, nil
+}
+
+// sendJSONRequest sends the JSON body to the URL with the headers, the empty timeout never times out
+func sendJSONRequest(method string, targetURL string, body []byte, headers map[string]string, timeout string) (string, errors.EdgeX) {
+	req, err := http.NewRequest(strings.ToUpper(method), targetURL, bytes.NewReader(body))
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindServerError, "fail to create the http request", err)
+	}
+	req.Header.Set(common.ContentType, common.ContentTypeJSON)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	client := &http.Client{}
+	if timeout != "" {
+		duration, err := time.ParseDuration(timeout)
+		if err != nil {
+			return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid request timeout '%s'", timeout), err)
+		}
+		client.Timeout = duration
+	}
+	res, edgexErr := utils.SendRequestAndGetResponse(client, req)
+	if edgexErr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	return res, nil
+}
+
+func webhookSecretHeaders(dic *di.Container, secretName string) (map[string]string, errors.EdgeX) {
+	secretProvider := container.SecretProviderFrom(dic.Get)
+	if secretProvider == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "secret provider is missing. Make sure it is specified to be used in bootstrap.Run()", nil)
+	}
+	secrets, err := secretProvider.GetSecret(secretName)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), "fail to retrieve the webhook headers from the secret store", err)
+	}
+	return secrets, nil
+}
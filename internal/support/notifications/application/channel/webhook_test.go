@@ -0,0 +1,168 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	bootstrapMocks "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var webhookAddress = models.RESTAddress{
+	BaseAddress: models.BaseAddress{Type: common.REST, Scheme: WebhookScheme, Host: "localhost", Port: 443},
+	HTTPMethod:  http.MethodPost,
+}
+
+var webhookNotification = models.Notification{
+	Id:       "notification-id",
+	Sender:   "core-metadata",
+	Category: "health-check",
+	Severity: models.Critical,
+	Content:  `the "device" is down`,
+}
+
+func webhookTestDic(webhook config.WebhookInfo, secretProvider *bootstrapMocks.SecretProvider) *di.Container {
+	return di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+			return secretProvider
+		},
+		notificationContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{Webhook: webhook}
+		},
+	})
+}
+
+func TestWebhookSenderSend(t *testing.T) {
+	var received map[string]any
+	var receivedHeader http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedHeader = r.Header
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &received)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	secretProvider := &bootstrapMocks.SecretProvider{}
+	secretProvider.On("GetSecret", "webhook").Return(map[string]string{"Authorization": "Bearer token"}, nil)
+	dic := webhookTestDic(config.WebhookInfo{URL: server.URL, SecretName: "webhook", Timeout: "5s"}, secretProvider)
+
+	res, err := NewWebhookSender(dic).Send(webhookNotification, webhookAddress)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", res)
+	assert.Equal(t, "Bearer token", receivedHeader.Get("Authorization"))
+	assert.Equal(t, common.ContentTypeJSON, receivedHeader.Get(common.ContentType))
+	assert.Equal(t, map[string]any{
+		"content":  webhookNotification.Content,
+		"category": webhookNotification.Category,
+		"severity": string(webhookNotification.Severity),
+		"sender":   webhookNotification.Sender,
+	}, received)
+	secretProvider.AssertExpectations(t)
+}
+
+func TestWebhookSenderSend_BodyTemplate(t *testing.T) {
+	var received map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &received)
+	}))
+	defer server.Close()
+
+	webhook := config.WebhookInfo{URL: server.URL, Method: "put", BodyTemplate: `{"text": {{json .Content}}, "level": "{{.Severity}}"}`}
+	_, err := NewWebhookSender(webhookTestDic(webhook, nil)).Send(webhookNotification, webhookAddress)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"text": webhookNotification.Content, "level": "CRITICAL"}, received)
+}
+
+func TestWebhookSenderSend_Failed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(200 * time.Millisecond)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name          string
+		webhook       config.WebhookInfo
+		expectedClass FailureClass
+	}{
+		{"server error", config.WebhookInfo{URL: server.URL}, FailureClassServerError},
+		{"timeout", config.WebhookInfo{URL: server.URL + "/slow", Timeout: "50ms"}, FailureClassTimeout},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := NewWebhookSender(webhookTestDic(testCase.webhook, nil)).Send(webhookNotification, webhookAddress)
+			require.Error(t, err)
+			assert.Equal(t, testCase.expectedClass, ClassifyFailure(err))
+		})
+	}
+
+	_, err := NewWebhookSender(webhookTestDic(config.WebhookInfo{URL: server.URL, BodyTemplate: `{{.Content}}`}, nil)).
+		Send(webhookNotification, webhookAddress)
+	require.Error(t, err, "the rendered body is not valid JSON")
+	_, err = NewWebhookSender(webhookTestDic(config.WebhookInfo{}, nil)).Send(webhookNotification, webhookAddress)
+	require.Error(t, err, "the webhook is not configured")
+}
+
+func TestIsWebhookAddress(t *testing.T) {
+	assert.True(t, IsWebhookAddress(webhookAddress))
+	restAddress := webhookAddress
+	restAddress.Scheme = "https"
+	assert.False(t, IsWebhookAddress(restAddress))
+	assert.False(t, IsWebhookAddress(models.EmailAddress{BaseAddress: models.BaseAddress{Type: common.EMAIL, Scheme: WebhookScheme}}))
+}
+
+func TestValidateWebhookInfo(t *testing.T) {
+	tests := []struct {
+		name          string
+		webhook       config.WebhookInfo
+		expectedError bool
+	}{
+		{"disabled", config.WebhookInfo{}, false},
+		{"valid", config.WebhookInfo{URL: "https://hooks.example.com/notify", Method: "POST", Timeout: "10s",
+			BodyTemplate: `{"text": {{json .Content}}}`}, false},
+		{"relative URL", config.WebhookInfo{URL: "/notify"}, true},
+		{"unsupported scheme", config.WebhookInfo{URL: "ftp://hooks.example.com"}, true},
+		{"invalid method", config.WebhookInfo{URL: "https://hooks.example.com", Method: "SEND"}, true},
+		{"invalid timeout", config.WebhookInfo{URL: "https://hooks.example.com", Timeout: "soon"}, true},
+		{"negative timeout", config.WebhookInfo{URL: "https://hooks.example.com", Timeout: "-1s"}, true},
+		{"invalid template", config.WebhookInfo{URL: "https://hooks.example.com", BodyTemplate: `{{json .Content}`}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateWebhookInfo(testCase.webhook)
+			if testCase.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
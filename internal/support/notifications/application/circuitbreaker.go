@@ -0,0 +1,168 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	gometrics "github.com/rcrowley/go-metrics"
+)
+
+const (
+	notificationCircuitsOpenMetricName        = "NotificationCircuitsOpen"
+	notificationCircuitStateChangesMetricName = "NotificationCircuitStateChanges"
+
+	// CircuitOpenResponse prefixes the response of the transmission record short-circuited by the open circuit
+	CircuitOpenResponse = "circuit-open"
+)
+
+type circuitState string
+
+const (
+	circuitClosed   circuitState = "closed"
+	circuitOpen     circuitState = "open"
+	circuitHalfOpen circuitState = "half-open"
+)
+
+// channelCircuitBreaker breaks the circuit of each channel endpoint after the consecutive failures, so the sends to a
+// chronically dead endpoint are short-circuited instead of wasting the retry budget
+type channelCircuitBreaker struct {
+	mutex        sync.Mutex
+	circuits     map[string]*circuit
+	openGauge    gometrics.Gauge
+	stateChanges gometrics.Counter
+}
+
+type circuit struct {
+	state    circuitState
+	failures int
+	// openUntil is the end of the cooldown of the open circuit, after which a half-open trial send is allowed
+	openUntil time.Time
+	// trialInFlight indicates the half-open trial send is not finished yet
+	trialInFlight bool
+}
+
+func newChannelCircuitBreaker() *channelCircuitBreaker {
+	return &channelCircuitBreaker{
+		circuits:     make(map[string]*circuit),
+		openGauge:    gometrics.NewGauge(),
+		stateChanges: gometrics.NewCounter(),
+	}
+}
+
+// circuitKey identifies the channel endpoint of the address
+func circuitKey(address models.Address) string {
+	return fmt.Sprintf("%s %+v", address.GetBaseAddress().Type, address)
+}
+
+// allow returns whether the send to the endpoint is allowed. When the cooldown of the open circuit has passed, only one
+// trial send is allowed until its result is recorded.
+func (b *channelCircuitBreaker) allow(key string, limit config.ChannelLimit, lc logger.LoggingClient) bool {
+	if limit.CircuitBreakerThreshold <= 0 {
+		return true
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	c, ok := b.circuits[key]
+	if !ok {
+		return true
+	}
+	switch c.state {
+	case circuitOpen:
+		if time.Now().Before(c.openUntil) {
+			return false
+		}
+		b.changeState(key, c, circuitHalfOpen, lc)
+		c.trialInFlight = true
+		return true
+	case circuitHalfOpen:
+		if c.trialInFlight {
+			return false
+		}
+		c.trialInFlight = true
+		return true
+	}
+	return true
+}
+
+// record records the result of the send to the endpoint, and opens the circuit after CircuitBreakerThreshold
+// consecutive failures or a failed half-open trial
+func (b *channelCircuitBreaker) record(key string, limit config.ChannelLimit, success bool, lc logger.LoggingClient) {
+	if limit.CircuitBreakerThreshold <= 0 {
+		return
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	c, ok := b.circuits[key]
+	if success {
+		if ok {
+			if c.state != circuitClosed {
+				b.changeState(key, c, circuitClosed, lc)
+			}
+			delete(b.circuits, key)
+		}
+		return
+	}
+	if !ok {
+		c = &circuit{state: circuitClosed}
+		b.circuits[key] = c
+	}
+	c.failures++
+	c.trialInFlight = false
+	if c.state == circuitHalfOpen || (c.state == circuitClosed && c.failures >= limit.CircuitBreakerThreshold) {
+		cooldown, err := time.ParseDuration(limit.CircuitBreakerCooldown)
+		if err != nil {
+			lc.Errorf("invalid CircuitBreakerCooldown %s, use the default 30s: %v", limit.CircuitBreakerCooldown, err)
+			cooldown = 30 * time.Second
+		}
+		c.openUntil = time.Now().Add(cooldown)
+		b.changeState(key, c, circuitOpen, lc)
+	}
+}
+
+// changeState must be called with the mutex held
+func (b *channelCircuitBreaker) changeState(key string, c *circuit, state circuitState, lc logger.LoggingClient) {
+	if c.state == circuitOpen {
+		b.openGauge.Update(b.openGauge.Value() - 1)
+	}
+	if state == circuitOpen {
+		b.openGauge.Update(b.openGauge.Value() + 1)
+	}
+	lc.Warnf("notification channel circuit %s changed from %s to %s after %d consecutive failures", key, c.state, state, c.failures)
+	c.state = state
+	b.stateChanges.Inc(1)
+}
+
+// registerCircuitBreakerMetrics registers the circuit breaker metrics with the metrics manager
+func registerCircuitBreakerMetrics(dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
+	if metricsManager == nil {
+		lc.Error("Metric Manager not available. Circuit breaker metrics will not be collected.")
+		return
+	}
+	circuitBreaker := channelCircuitBreakerFrom(dic.Get)
+	metrics := map[string]any{
+		notificationCircuitsOpenMetricName:        circuitBreaker.openGauge,
+		notificationCircuitStateChangesMetricName: circuitBreaker.stateChanges,
+	}
+	for name, metric := range metrics {
+		if err := metricsManager.Register(name, metric, nil); err != nil {
+			lc.Errorf("%s metrics will not be collected: %s", name, err.Error())
+			continue
+		}
+		lc.Infof("Registered metrics %s", name)
+	}
+}
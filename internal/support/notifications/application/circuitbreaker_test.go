@@ -0,0 +1,52 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+)
+
+func TestChannelCircuitBreaker(t *testing.T) {
+	lc := logger.NewMockClient()
+	breaker := newChannelCircuitBreaker()
+	limit := config.ChannelLimit{CircuitBreakerThreshold: 2, CircuitBreakerCooldown: "50ms"}
+	key := "REST endpoint"
+
+	assert.True(t, breaker.allow(key, limit, lc))
+	breaker.record(key, limit, false, lc)
+	assert.True(t, breaker.allow(key, limit, lc), "the circuit should stay closed under the threshold")
+	breaker.record(key, limit, false, lc)
+	assert.False(t, breaker.allow(key, limit, lc), "the circuit should be opened at the threshold")
+	assert.True(t, breaker.allow("another endpoint", limit, lc), "the circuit should be applied per endpoint")
+	assert.Equal(t, int64(1), breaker.openGauge.Value())
+
+	time.Sleep(60 * time.Millisecond)
+	assert.True(t, breaker.allow(key, limit, lc), "a trial send should be allowed after the cooldown")
+	assert.False(t, breaker.allow(key, limit, lc), "only one trial send should be allowed")
+	assert.Equal(t, int64(0), breaker.openGauge.Value())
+	breaker.record(key, limit, false, lc)
+	assert.False(t, breaker.allow(key, limit, lc), "the failed trial should reopen the circuit")
+
+	time.Sleep(60 * time.Millisecond)
+	assert.True(t, breaker.allow(key, limit, lc))
+	breaker.record(key, limit, true, lc)
+	assert.True(t, breaker.allow(key, limit, lc), "the successful trial should close the circuit")
+	assert.True(t, breaker.allow(key, limit, lc))
+	assert.Equal(t, int64(0), breaker.openGauge.Value())
+	assert.Equal(t, int64(5), breaker.stateChanges.Count())
+
+	disabled := config.ChannelLimit{}
+	for i := 0; i < 5; i++ {
+		breaker.record(key, disabled, false, lc)
+	}
+	assert.True(t, breaker.allow(key, disabled, lc), "zero threshold disables the circuit breaker")
+}
@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+)
+
+// subscriptionIndexName contains the name of the subscription routing index in the DIC.
+var subscriptionIndexName = di.TypeInstanceToName(subscriptionIndex{})
+
+// dispatchDrainerName contains the name of the notification dispatch drainer in the DIC.
+var dispatchDrainerName = di.TypeInstanceToName(dispatchDrainer{})
+
+// inFlightLimiterName contains the name of the channel in-flight limiter in the DIC.
+var inFlightLimiterName = di.TypeInstanceToName(inFlightLimiter{})
+
+// channelCircuitBreakerName contains the name of the channel circuit breaker in the DIC.
+var channelCircuitBreakerName = di.TypeInstanceToName(channelCircuitBreaker{})
+
+// dispatchSequencerName contains the name of the dispatch sequencer in the DIC.
+var dispatchSequencerName = di.TypeInstanceToName(dispatchSequencer{})
+
+// categoryRateLimiterName contains the name of the notification category rate limiter in the DIC.
+var categoryRateLimiterName = di.TypeInstanceToName(categoryRateLimiter{})
+
+// deliveryLimiterName contains the name of the subscription delivery limiter in the DIC.
+var deliveryLimiterName = di.TypeInstanceToName(deliveryLimiter{})
+
+// failureRateMonitorName contains the name of the subscription failure rate monitor in the DIC.
+var failureRateMonitorName = di.TypeInstanceToName(failureRateMonitor{})
+
+// sendRateLimiterName contains the name of the subscription send rate limiter in the DIC.
+var sendRateLimiterName = di.TypeInstanceToName(sendRateLimiter{})
+
+// RegisterDispatchState adds the in-memory state shared by the notification dispatches, such as the subscription routing
+// index, the dispatch drainer and the limiters, to the DIC. It must be called before any notification is dispatched.
+func RegisterDispatchState(dic *di.Container) {
+	dic.Update(di.ServiceConstructorMap{
+		subscriptionIndexName: func(get di.Get) interface{} {
+			return &subscriptionIndex{}
+		},
+		dispatchDrainerName: func(get di.Get) interface{} {
+			return newDispatchDrainer()
+		},
+		inFlightLimiterName: func(get di.Get) interface{} {
+			return newInFlightLimiter()
+		},
+		channelCircuitBreakerName: func(get di.Get) interface{} {
+			return newChannelCircuitBreaker()
+		},
+		dispatchSequencerName: func(get di.Get) interface{} {
+			return newDispatchSequencer()
+		},
+		categoryRateLimiterName: func(get di.Get) interface{} {
+			return newCategoryRateLimiter()
+		},
+		deliveryLimiterName: func(get di.Get) interface{} {
+			return newDeliveryLimiter()
+		},
+		failureRateMonitorName: func(get di.Get) interface{} {
+			return newFailureRateMonitor()
+		},
+		sendRateLimiterName: func(get di.Get) interface{} {
+			return newSendRateLimiter()
+		},
+	})
+}
+
+// subscriptionIndexFrom helper function queries the DIC and returns the subscription routing index.
+func subscriptionIndexFrom(get di.Get) *subscriptionIndex {
+	return get(subscriptionIndexName).(*subscriptionIndex)
+}
+
+// dispatchDrainerFrom helper function queries the DIC and returns the notification dispatch drainer.
+func dispatchDrainerFrom(get di.Get) *dispatchDrainer {
+	return get(dispatchDrainerName).(*dispatchDrainer)
+}
+
+// inFlightLimiterFrom helper function queries the DIC and returns the channel in-flight limiter.
+func inFlightLimiterFrom(get di.Get) *inFlightLimiter {
+	return get(inFlightLimiterName).(*inFlightLimiter)
+}
+
+// channelCircuitBreakerFrom helper function queries the DIC and returns the channel circuit breaker.
+func channelCircuitBreakerFrom(get di.Get) *channelCircuitBreaker {
+	return get(channelCircuitBreakerName).(*channelCircuitBreaker)
+}
+
+// dispatchSequencerFrom helper function queries the DIC and returns the dispatch sequencer.
+func dispatchSequencerFrom(get di.Get) *dispatchSequencer {
+	return get(dispatchSequencerName).(*dispatchSequencer)
+}
+
+// categoryRateLimiterFrom helper function queries the DIC and returns the notification category rate limiter.
+func categoryRateLimiterFrom(get di.Get) *categoryRateLimiter {
+	return get(categoryRateLimiterName).(*categoryRateLimiter)
+}
+
+// deliveryLimiterFrom helper function queries the DIC and returns the subscription delivery limiter.
+func deliveryLimiterFrom(get di.Get) *deliveryLimiter {
+	return get(deliveryLimiterName).(*deliveryLimiter)
+}
+
+// failureRateMonitorFrom helper function queries the DIC and returns the subscription failure rate monitor.
+func failureRateMonitorFrom(get di.Get) *failureRateMonitor {
+	return get(failureRateMonitorName).(*failureRateMonitor)
+}
+
+// sendRateLimiterFrom helper function queries the DIC and returns the subscription send rate limiter.
+func sendRateLimiterFrom(get di.Get) *sendRateLimiter {
+	return get(sendRateLimiterName).(*sendRateLimiter)
+}
@@ -0,0 +1,151 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/google/uuid"
+)
+
+// recordDeadLetter stores the dead letter of the transmission failed permanently, i.e. the failed transmission isn't
+// resent, or it is escalated after exhausting the resend limit. The failure to store is logged without failing the
+// dispatch.
+func recordDeadLetter(dic *di.Container, n models.Notification, trans models.Transmission) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	deadLetter := notificationModels.DeadLetter{
+		Notification:     dtos.FromNotificationModelToDTO(n),
+		SubscriptionName: trans.SubscriptionName,
+		Channel:          dtos.FromAddressModelToDTO(trans.Channel),
+		TransmissionId:   trans.Id,
+		Status:           string(trans.Status),
+		AttemptCount:     trans.ResendCount + 1,
+	}
+	for i := len(trans.Records) - 1; i >= 0; i-- {
+		if trans.Records[i].Status == models.Failed {
+			deadLetter.LastError = trans.Records[i].Response
+			break
+		}
+	}
+	if _, err := container.DBClientFrom(dic.Get).AddDeadLetter(deadLetter); err != nil {
+		lc.Errorf("fail to record the dead letter of the notification %s for the subscription %s, err: %v", n.Id, trans.SubscriptionName, err)
+		return
+	}
+	lc.Debugf("recorded the dead letter of the notification %s for the subscription %s after %d attempts", n.Id, trans.SubscriptionName, deadLetter.AttemptCount)
+}
+
+// ExhaustedNotifications queries the dead letters of the notifications failed permanently from the latest one by
+// offset and limit
+func ExhaustedNotifications(offset, limit int, dic *di.Container) (deadLetters []notificationModels.DeadLetter, totalCount uint32, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+
+	totalCount, err = dbClient.DeadLetterTotalCount()
+	if err != nil {
+		return deadLetters, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []notificationModels.DeadLetter{}, totalCount, err
+	}
+
+	deadLetters, err = dbClient.AllDeadLetters(offset, limit)
+	if err != nil {
+		return deadLetters, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return deadLetters, totalCount, nil
+}
+
+// ReEnqueueExhaustedNotification transmits the notification of the dead letter to its channel again, as if it was
+// distributed to the subscription for the first time, and deletes the dead letter. The notification is restored from
+// the dead letter if it has been purged. A new dead letter is recorded if the transmission fails permanently again.
+// The transmission keeps the ordering of the subscription channel, and no dead letter is re-enqueued while draining.
+func ReEnqueueExhaustedNotification(id string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if id == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "ID is empty", nil)
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "ID is not a valid UUID", err)
+	}
+	if !dispatchDrainerFrom(dic.Get).accepting() {
+		return errors.NewCommonEdgeX(errors.KindServiceUnavailable, "notification service is shutting down, the dead letter is not re-enqueued", nil)
+	}
+	deadLetter, err := dbClient.DeadLetterById(id)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	sub, err := dbClient.SubscriptionByName(deadLetter.SubscriptionName)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to query the subscription %s of the dead letter %s", deadLetter.SubscriptionName, id), err)
+	}
+	if sub.AdminState == models.Locked {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("fail to re-enqueue the dead letter %s, the subscription %s is locked", id, sub.Name), nil)
+	}
+
+	n, err := dbClient.NotificationById(deadLetter.Notification.Id)
+	if errors.Kind(err) == errors.KindEntityDoesNotExist {
+		// the transmissions belong to the notification, so the purged notification is restored first
+		n, err = dbClient.AddNotification(dtos.ToNotificationModel(deadLetter.Notification))
+	}
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to load the notification %s of the dead letter %s", deadLetter.Notification.Id, id), err)
+	}
+	if err = dbClient.DeleteDeadLetterById(id); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	address := dtos.ToAddressModel(deadLetter.Channel)
+	slot := transmissionSlot(dic, n, sub, address)
+	dispatchDrainerFrom(dic.Get).join(n, true, func() { slot.run(func() { transmit(dic, n, sub, address) }) }) // nolint:errcheck
+	lc.Debugf("Re-enqueued the dead letter %s of the notification %s for the subscription %s. Correlation-ID: %s",
+		id, n.Id, sub.Name, correlation.FromContext(ctx))
+	return nil
+}
+
+// DeleteDeadLetterById deletes the dead letter by id, e.g. after the failure is resolved without re-enqueuing it
+func DeleteDeadLetterById(id string, dic *di.Container) errors.EdgeX {
+	if id == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "ID is empty", nil)
+	}
+	if err := container.DBClientFrom(dic.Get).DeleteDeadLetterById(id); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// purgeDeadLetter purges the dead letters older than the dead letter retention MaxAge
+func purgeDeadLetter(dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	retention := container.ConfigurationFrom(dic.Get).Retention.DeadLetter
+
+	if retention.MaxAge == "" {
+		return nil
+	}
+	maxAge, err := time.ParseDuration(retention.MaxAge)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse dead letter retention MaxAge '%s'", retention.MaxAge), err)
+	}
+	lc.Debugf("Purging the dead letters older than %s", retention.MaxAge)
+	if err := container.DBClientFrom(dic.Get).CleanupDeadLettersByAge(maxAge.Milliseconds()); err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete dead letters by age '%s'", retention.MaxAge), err)
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func deadLetterTestDic(dbClient *dbMock.DBClient, restSender *senderMock.Sender) *di.Container {
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClient
+		},
+		channel.RESTSenderName: func(get di.Get) interface{} {
+			return restSender
+		},
+	})
+	return dic
+}
+
+func TestTransmit_DeadLetter(t *testing.T) {
+	n := notification
+	n.Id = exampleUUID
+	failed := n
+	failed.Content = "failed"
+	sendErr := errors.NewCommonEdgeX(errors.KindServerError, "fail to send the request", nil)
+
+	var deadLetters []notificationModels.DeadLetter
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddTransmission", mock.Anything).Return(func(trans models.Transmission) (models.Transmission, errors.EdgeX) {
+		trans.Id = exampleUUID
+		return trans, nil
+	})
+	dbClientMock.On("AddDeadLetter", mock.Anything).Return(notificationModels.DeadLetter{}, nil).Run(func(args mock.Arguments) {
+		deadLetters = append(deadLetters, args.Get(0).(notificationModels.DeadLetter))
+	})
+	restSender := &senderMock.Sender{}
+	restSender.On("Send", n, testRestAddress).Return("", nil)
+	restSender.On("Send", failed, testRestAddress).Return("", sendErr)
+	dic := deadLetterTestDic(dbClientMock, restSender)
+
+	// the sent notification isn't a dead letter
+	trans, err := transmit(dic, n, sub, testRestAddress)
+	require.NoError(t, err)
+	assert.EqualValues(t, models.Sent, trans.Status)
+	assert.Empty(t, deadLetters)
+
+	// the failed notification below the critical severity isn't resent, so it's a dead letter after the first send
+	trans, err = transmit(dic, failed, sub, testRestAddress)
+	require.NoError(t, err)
+	assert.EqualValues(t, models.Failed, trans.Status)
+	require.Len(t, deadLetters, 1)
+	assert.Equal(t, dtos.FromNotificationModelToDTO(failed), deadLetters[0].Notification)
+	assert.Equal(t, sub.Name, deadLetters[0].SubscriptionName)
+	assert.Equal(t, dtos.FromAddressModelToDTO(testRestAddress), deadLetters[0].Channel)
+	assert.Equal(t, exampleUUID, deadLetters[0].TransmissionId)
+	assert.EqualValues(t, models.Failed, deadLetters[0].Status)
+	assert.Equal(t, 1, deadLetters[0].AttemptCount)
+	assert.Equal(t, trans.Records[len(trans.Records)-1].Response, deadLetters[0].LastError)
+	assert.NotEmpty(t, deadLetters[0].LastError)
+}
+
+func TestExhaustedNotifications(t *testing.T) {
+	deadLetters := []notificationModels.DeadLetter{{Id: exampleUUID, SubscriptionName: sub.Name, AttemptCount: 3}}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeadLetterTotalCount").Return(uint32(1), nil)
+	dbClientMock.On("AllDeadLetters", 0, 10).Return(deadLetters, nil)
+	dic := deadLetterTestDic(dbClientMock, nil)
+
+	result, totalCount, err := ExhaustedNotifications(0, 10, dic)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), totalCount)
+	assert.Equal(t, deadLetters, result)
+
+	_, _, err = ExhaustedNotifications(2, 10, dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindRangeNotSatisfiable, errors.Kind(err))
+}
+
+func TestReEnqueueExhaustedNotification(t *testing.T) {
+	n := notification
+	n.Id = exampleUUID
+	n.Status = models.Processed
+	deadLetter := notificationModels.DeadLetter{
+		Id:               "a0b9f6c1-2a2d-4e25-9a8e-2c1c3ad4d2e1",
+		Notification:     dtos.FromNotificationModelToDTO(n),
+		SubscriptionName: sub.Name,
+		Channel:          dtos.FromAddressModelToDTO(testRestAddress),
+		Status:           string(models.Escalated),
+		AttemptCount:     3,
+	}
+	lockedSub := sub
+	lockedSub.Name = "locked"
+	lockedSub.AdminState = models.Locked
+	lockedDeadLetter := deadLetter
+	lockedDeadLetter.Id = "d6c2cf1f-58c3-4b69-8b79-2f7f8e0f1b7a"
+	lockedDeadLetter.SubscriptionName = lockedSub.Name
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+
+	tests := []struct {
+		name              string
+		id                string
+		notificationErr   errors.EdgeX
+		ordered           bool
+		expectedErrorKind errors.ErrKind
+	}{
+		{"valid", deadLetter.Id, nil, false, ""},
+		{"valid - notification purged", deadLetter.Id, notFound, false, ""},
+		{"valid - subscription ordered", deadLetter.Id, nil, true, ""},
+		{"invalid - subscription locked", lockedDeadLetter.Id, nil, false, errors.KindStatusConflict},
+		{"invalid - dead letter not found", "8a41b5ec-3e4d-4d6f-a3a2-0f4b0c2d8e55", nil, false, errors.KindEntityDoesNotExist},
+		{"invalid - id is not UUID", "dead-letter", nil, false, errors.KindContractInvalid},
+		{"invalid - id is empty", "", nil, false, errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			transmitted := make(chan models.Transmission, 1)
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("DeadLetterById", deadLetter.Id).Return(deadLetter, nil)
+			dbClientMock.On("DeadLetterById", lockedDeadLetter.Id).Return(lockedDeadLetter, nil)
+			dbClientMock.On("DeadLetterById", mock.Anything).Return(notificationModels.DeadLetter{}, notFound)
+			dbClientMock.On("SubscriptionByName", sub.Name).Return(sub, nil)
+			dbClientMock.On("SubscriptionByName", lockedSub.Name).Return(lockedSub, nil)
+			dbClientMock.On("NotificationById", n.Id).Return(n, testCase.notificationErr)
+			dbClientMock.On("AddNotification", n).Return(n, nil)
+			dbClientMock.On("DeleteDeadLetterById", mock.Anything).Return(nil)
+			dbClientMock.On("AddTransmission", mock.Anything).Return(func(trans models.Transmission) (models.Transmission, errors.EdgeX) {
+				transmitted <- trans
+				return trans, nil
+			})
+			restSender := &senderMock.Sender{}
+			restSender.On("Send", n, testRestAddress).Return("", nil)
+			dic := deadLetterTestDic(dbClientMock, restSender)
+			if testCase.ordered {
+				container.ConfigurationFrom(dic.Get).Writable.Ordering.Subscriptions = []string{sub.Name}
+			}
+
+			err := ReEnqueueExhaustedNotification(testCase.id, context.Background(), dic)
+			if testCase.expectedErrorKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrorKind, errors.Kind(err))
+				dbClientMock.AssertNotCalled(t, "DeleteDeadLetterById", mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			select {
+			case trans := <-transmitted:
+				assert.Equal(t, sub.Name, trans.SubscriptionName)
+				assert.Equal(t, testRestAddress, trans.Channel)
+				assert.EqualValues(t, models.Sent, trans.Status)
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "the notification of the dead letter should be transmitted again")
+			}
+			dbClientMock.AssertCalled(t, "DeleteDeadLetterById", deadLetter.Id)
+			if testCase.notificationErr != nil {
+				dbClientMock.AssertCalled(t, "AddNotification", n)
+			} else {
+				dbClientMock.AssertNotCalled(t, "AddNotification", mock.Anything)
+			}
+		})
+	}
+}
+
+func TestReEnqueueExhaustedNotification_Draining(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dic := deadLetterTestDic(dbClientMock, nil)
+	dispatchDrainerFrom(dic.Get).draining = true
+
+	err := ReEnqueueExhaustedNotification("a0b9f6c1-2a2d-4e25-9a8e-2c1c3ad4d2e1", context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	dbClientMock.AssertNotCalled(t, "DeadLetterById", mock.Anything)
+}
+
+func TestPurgeDeadLetter(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxAge        string
+		expectedPurge bool
+		expectedError bool
+	}{
+		{"disabled", "", false, false},
+		{"purge by age", "2160h", true, false},
+		{"invalid max age", "90 days", false, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("CleanupDeadLettersByAge", (2160 * time.Hour).Milliseconds()).Return(nil)
+			dic := deadLetterTestDic(dbClientMock, nil)
+			container.ConfigurationFrom(dic.Get).Retention = config.NotificationRetention{DeadLetter: config.DeadLetterRetention{MaxAge: testCase.maxAge}}
+
+			err := purgeDeadLetter(dic)
+			if testCase.expectedError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			if testCase.expectedPurge {
+				dbClientMock.AssertNumberOfCalls(t, "CleanupDeadLettersByAge", 1)
+			} else {
+				dbClientMock.AssertNotCalled(t, "CleanupDeadLettersByAge", mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	gometrics "github.com/rcrowley/go-metrics"
+)
+
+const subscriptionDeliveriesInFlightMetricName = "SubscriptionDeliveriesInFlight"
+
+// deliveryLimiter limits the concurrent sends to the channels of each subscription
+type deliveryLimiter struct {
+	mutex    sync.Mutex
+	inFlight map[string]int
+	// released is closed and replaced whenever a send is released, to wake up the waiting sends
+	released chan struct{}
+	gauges   map[string]gometrics.Gauge
+}
+
+func newDeliveryLimiter() *deliveryLimiter {
+	return &deliveryLimiter{inFlight: make(map[string]int), released: make(chan struct{}), gauges: make(map[string]gometrics.Gauge)}
+}
+
+// deliveryConcurrency returns the maximum number of the concurrent sends of the subscription, zero means unlimited. The
+// ordered subscription sends one at a time regardless of Writable.DeliveryConcurrency.
+func deliveryConcurrency(writable config.WritableInfo, subscriptionName string) int {
+	if writable.Ordering.Enabled(subscriptionName) {
+		return 1
+	}
+	limit, ok := writable.DeliveryConcurrency[subscriptionName]
+	if !ok {
+		limit = writable.DeliveryConcurrency[allSubscriptionsKey]
+	}
+	return max(limit, 0)
+}
+
+// acquire waits until the in-flight sends of the subscription are under its DeliveryConcurrency. The caller must call
+// release after the send.
+func (l *deliveryLimiter) acquire(dic *di.Container, subscriptionName string) {
+	for {
+		limit := deliveryConcurrency(container.ConfigurationFrom(dic.Get).Writable, subscriptionName)
+		l.mutex.Lock()
+		if limit <= 0 || l.inFlight[subscriptionName] < limit {
+			l.inFlight[subscriptionName]++
+			l.updateGauge(dic, subscriptionName)
+			l.mutex.Unlock()
+			return
+		}
+		released := l.released
+		l.mutex.Unlock()
+		<-released
+	}
+}
+
+func (l *deliveryLimiter) release(dic *di.Container, subscriptionName string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.inFlight[subscriptionName]--
+	l.updateGauge(dic, subscriptionName)
+	if l.inFlight[subscriptionName] == 0 {
+		delete(l.inFlight, subscriptionName)
+	}
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+// updateGauge must be called with the mutex held. The gauge of the subscription is registered with the metrics manager
+// on its first send.
+func (l *deliveryLimiter) updateGauge(dic *di.Container, subscriptionName string) {
+	gauge, ok := l.gauges[subscriptionName]
+	if !ok {
+		gauge = gometrics.NewGauge()
+		l.gauges[subscriptionName] = gauge
+		registerSubscriptionDeliveryMetric(dic, subscriptionName, gauge)
+	}
+	gauge.Update(int64(l.inFlight[subscriptionName]))
+}
+
+func registerSubscriptionDeliveryMetric(dic *di.Container, subscriptionName string, gauge gometrics.Gauge) {
+	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
+	if metricsManager == nil {
+		return
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	name := subscriptionDeliveriesInFlightMetricName + subscriptionName
+	if err := metricsManager.Register(name, gauge, map[string]string{"subscription": subscriptionName}); err != nil {
+		lc.Errorf("%s metrics will not be collected: %s", name, err.Error())
+		return
+	}
+	lc.Debugf("Registered metrics gauge %s", name)
+}
+
+// sendForSubscription sends the notification to the address of the subscription within its DeliveryConcurrency. Only
+// the send itself is limited, the wait between the resends doesn't hold the place of the subscription. The send is
+// throttled to the send rate of the subscription, see Writable.SubscriptionSendRates, while the callers defer the
+// sends outside the delivery windows beforehand, see deferTransmission. The outcome of the send is monitored for the
+// failure rate of the subscription, see Writable.FailureAlert.
+func sendForSubscription(dic *di.Container, n models.Notification, subscriptionName string, address models.Address) sendResult {
+	waitForSendRate(dic, subscriptionName)
+	limiter := deliveryLimiterFrom(dic.Get)
+	limiter.acquire(dic, subscriptionName)
+	result := sendNotificationViaChannel(dic, n, address)
+	limiter.release(dic, subscriptionName)
+	monitorSendOutcome(dic, n, subscriptionName, result)
+	return result
+}
@@ -0,0 +1,71 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeliveryConcurrency(t *testing.T) {
+	writable := config.WritableInfo{
+		DeliveryConcurrency: map[string]int{"wide": 4, "ordered": 8, "negative": -1},
+		Ordering:            config.OrderingInfo{Subscriptions: []string{"ordered"}},
+	}
+	assert.Equal(t, 4, deliveryConcurrency(writable, "wide"))
+	assert.Equal(t, 1, deliveryConcurrency(writable, "ordered"), "the ordered subscription should send one at a time")
+	assert.Equal(t, 0, deliveryConcurrency(writable, "negative"))
+	assert.Equal(t, 0, deliveryConcurrency(writable, "unlisted"), "the unlisted subscription should be unlimited")
+
+	writable.DeliveryConcurrency[allSubscriptionsKey] = 2
+	assert.Equal(t, 2, deliveryConcurrency(writable, "unlisted"))
+	assert.Equal(t, 4, deliveryConcurrency(writable, "wide"))
+}
+
+func TestDeliveryLimiter(t *testing.T) {
+	dic := mockDic()
+	container.ConfigurationFrom(dic.Get).Writable.DeliveryConcurrency = map[string]int{"wide": 2}
+	limiter := newDeliveryLimiter()
+
+	var inFlight, maxInFlight atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			limiter.acquire(dic, "wide")
+			defer limiter.release(dic, "wide")
+			current := inFlight.Add(1)
+			for {
+				peak := maxInFlight.Load()
+				if current <= peak || maxInFlight.CompareAndSwap(peak, current) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			inFlight.Add(-1)
+		}()
+	}
+	wg.Wait()
+	assert.EqualValues(t, 2, maxInFlight.Load())
+
+	// the unlimited subscription never waits
+	for i := 0; i < 6; i++ {
+		limiter.acquire(dic, "unlimited")
+	}
+	assert.EqualValues(t, 6, limiter.gauges["unlimited"].Value())
+	for i := 0; i < 6; i++ {
+		limiter.release(dic, "unlimited")
+	}
+	assert.EqualValues(t, 0, limiter.gauges["unlimited"].Value())
+}
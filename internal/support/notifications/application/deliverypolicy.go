@@ -0,0 +1,135 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// ChannelDeliveryStatus is the delivery status of the notification to a channel of a subscription. Each channel is
+// transmitted and resent independently, so the status of one channel doesn't wait for the others.
+type ChannelDeliveryStatus struct {
+	TransmissionId string       `json:"transmissionId"`
+	Channel        dtos.Address `json:"channel"`
+	Status         string       `json:"status"`
+	ResendCount    int          `json:"resendCount"`
+	// Attempts is the number of the send attempts, including the first send and the resends
+	Attempts int `json:"attempts"`
+	// LastAttempt is the time in milliseconds of the last send attempt, which is 0 if the channel is not attempted yet
+	LastAttempt int64 `json:"lastAttempt,omitempty"`
+	// Latency is the time in milliseconds from the notification creation to the first successful send, which is 0 if the
+	// channel is not delivered yet
+	Latency int64 `json:"latency,omitempty"`
+}
+
+// SubscriptionDeliveryStatus is the delivery status of the notification to a subscription, which is delivered when the
+// channels satisfy the delivery policy of the subscription
+type SubscriptionDeliveryStatus struct {
+	SubscriptionName string                  `json:"subscriptionName"`
+	Policy           string                  `json:"policy"`
+	Delivered        bool                    `json:"delivered"`
+	Channels         []ChannelDeliveryStatus `json:"channels"`
+}
+
+// NotificationDeliveryStatus is the delivery status of the notification, which is delivered when it is delivered to
+// every subscription it is transmitted to
+type NotificationDeliveryStatus struct {
+	NotificationId string                       `json:"notificationId"`
+	Delivered      bool                         `json:"delivered"`
+	Subscriptions  []SubscriptionDeliveryStatus `json:"subscriptions"`
+}
+
+// deliveryPolicy returns the delivery policy of the subscription, "*" sets the policy of the subscriptions not listed
+func deliveryPolicy(writable config.WritableInfo, subscriptionName string) string {
+	policy, ok := writable.DeliveryPolicy[subscriptionName]
+	if !ok {
+		policy = writable.DeliveryPolicy[allSubscriptionsKey]
+	}
+	if policy == "" {
+		return config.DeliveryPolicyAll
+	}
+	return policy
+}
+
+// isDelivered returns whether the transmission status means the notification is delivered to the channel
+func isDelivered(status models.TransmissionStatus) bool {
+	return status == models.Sent || status == models.Acknowledged
+}
+
+// NotificationDeliveryStatusById returns the delivery status of the notification to each channel of the subscriptions
+// it is transmitted to, in the order of the transmissions, along with the attempts and the delivery latency of each
+// channel. The channels still being dispatched are not yet reported, and keep the subscription undelivered.
+func NotificationDeliveryStatusById(id string, dic *di.Container) (status NotificationDeliveryStatus, err errors.EdgeX) {
+	// validate the id and the existence of the notification
+	n, err := NotificationById(id, dic)
+	if err != nil {
+		return status, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	transmissions, err := dbClient.TransmissionsByNotificationId(0, -1, id)
+	if err != nil {
+		return status, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	writable := container.ConfigurationFrom(dic.Get).Writable
+	status = NotificationDeliveryStatus{NotificationId: id, Subscriptions: []SubscriptionDeliveryStatus{}}
+	indexes := make(map[string]int)
+	for _, trans := range transmissions {
+		i, ok := indexes[trans.SubscriptionName]
+		if !ok {
+			i = len(status.Subscriptions)
+			indexes[trans.SubscriptionName] = i
+			status.Subscriptions = append(status.Subscriptions, SubscriptionDeliveryStatus{
+				SubscriptionName: trans.SubscriptionName,
+				Policy:           deliveryPolicy(writable, trans.SubscriptionName),
+			})
+		}
+		attempts, last := sendAttempts(trans)
+		status.Subscriptions[i].Channels = append(status.Subscriptions[i].Channels, ChannelDeliveryStatus{
+			TransmissionId: trans.Id,
+			Channel:        dtos.FromAddressModelToDTO(trans.Channel),
+			Status:         string(trans.Status),
+			ResendCount:    trans.ResendCount,
+			Attempts:       attempts,
+			LastAttempt:    last,
+			Latency:        transmissionLatency(n.Created, trans),
+		})
+	}
+
+	status.Delivered = len(status.Subscriptions) > 0
+	for i := range status.Subscriptions {
+		// the channels of the subscription still being dispatched have no transmission yet
+		channelCount := len(status.Subscriptions[i].Channels)
+		if sub, err := dbClient.SubscriptionByName(status.Subscriptions[i].SubscriptionName); err == nil {
+			channelCount = max(channelCount, len(sub.Channels))
+		}
+		status.Subscriptions[i].Delivered = policySatisfied(status.Subscriptions[i], channelCount)
+		status.Delivered = status.Delivered && status.Subscriptions[i].Delivered
+	}
+	return status, nil
+}
+
+// policySatisfied returns whether the channels of the subscription satisfy its delivery policy, where channelCount is
+// the number of the channels the notification is dispatched to
+func policySatisfied(sub SubscriptionDeliveryStatus, channelCount int) bool {
+	delivered := 0
+	for _, ch := range sub.Channels {
+		if isDelivered(models.TransmissionStatus(ch.Status)) {
+			delivered++
+		}
+	}
+	if sub.Policy == config.DeliveryPolicyAny {
+		return delivered > 0
+	}
+	return delivered == channelCount
+}
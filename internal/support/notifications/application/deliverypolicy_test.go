@@ -0,0 +1,103 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationDeliveryStatusById(t *testing.T) {
+	notFoundId := "1208bbca-8521-434a-a923-66255a68ba00"
+	multiChannel := models.Subscription{Name: "multiChannel", Channels: []models.Address{testEmailAddress, testRestAddress}}
+	pending := models.Subscription{Name: "pending", Channels: []models.Address{testEmailAddress, testRestAddress}}
+	transmissions := []models.Transmission{
+		{Id: "1", SubscriptionName: multiChannel.Name, Channel: testEmailAddress, Status: models.RESENDING, ResendCount: 1, Records: []models.TransmissionRecord{
+			droppedRecipientsRecord(1, []string{"test2@gamil.com"}),
+			{Status: models.Failed, Sent: 1500},
+			{Status: models.Failed, Sent: 2500},
+		}},
+		{Id: "2", SubscriptionName: multiChannel.Name, Channel: testRestAddress, Status: models.Sent, Records: []models.TransmissionRecord{
+			{Status: models.Failed, Sent: 1200},
+			{Status: models.Sent, Sent: 1800},
+		}},
+		{Id: "3", SubscriptionName: pending.Name, Channel: testRestAddress, Status: models.Acknowledged},
+	}
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("NotificationById", exampleUUID).Return(models.Notification{Id: exampleUUID, DBTimestamp: models.DBTimestamp{Created: 1000}}, nil)
+	dbClientMock.On("NotificationById", notFoundId).Return(models.Notification{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "notification doesn't exist in the database", nil))
+	dbClientMock.On("TransmissionsByNotificationId", 0, -1, exampleUUID).Return(transmissions, nil)
+	dbClientMock.On("SubscriptionByName", multiChannel.Name).Return(multiChannel, nil)
+	dbClientMock.On("SubscriptionByName", pending.Name).Return(pending, nil)
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	configuration := container.ConfigurationFrom(dic.Get)
+
+	tests := []struct {
+		name                      string
+		policy                    map[string]string
+		expectedMultiChannel      bool
+		expectedPending           bool
+		expectedNotificationState bool
+	}{
+		{"all channels by default", nil, false, false, false},
+		{"any channel of the multi-channel subscription", map[string]string{multiChannel.Name: config.DeliveryPolicyAny}, true, false, false},
+		{"any channel of all the subscriptions", map[string]string{"*": config.DeliveryPolicyAny}, true, true, true},
+		{"listed policy overrides the default", map[string]string{"*": config.DeliveryPolicyAny, pending.Name: config.DeliveryPolicyAll}, true, false, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			configuration.Writable.DeliveryPolicy = testCase.policy
+			status, err := NotificationDeliveryStatusById(exampleUUID, dic)
+			require.NoError(t, err)
+			require.Len(t, status.Subscriptions, 2)
+			assert.Equal(t, exampleUUID, status.NotificationId)
+			assert.Equal(t, testCase.expectedNotificationState, status.Delivered)
+
+			multiChannelStatus := status.Subscriptions[0]
+			assert.Equal(t, multiChannel.Name, multiChannelStatus.SubscriptionName)
+			assert.Equal(t, testCase.expectedMultiChannel, multiChannelStatus.Delivered)
+			require.Len(t, multiChannelStatus.Channels, 2)
+			assert.Equal(t, string(models.RESENDING), multiChannelStatus.Channels[0].Status)
+			assert.Equal(t, 1, multiChannelStatus.Channels[0].ResendCount)
+			assert.Equal(t, 2, multiChannelStatus.Channels[0].Attempts, "the dropped recipients should not count as an attempt")
+			assert.Equal(t, int64(2500), multiChannelStatus.Channels[0].LastAttempt)
+			assert.Zero(t, multiChannelStatus.Channels[0].Latency, "the undelivered channel should have no latency")
+			assert.Equal(t, string(models.Sent), multiChannelStatus.Channels[1].Status)
+			assert.Equal(t, 2, multiChannelStatus.Channels[1].Attempts)
+			assert.Equal(t, int64(800), multiChannelStatus.Channels[1].Latency)
+
+			// the email channel of the pending subscription has no transmission yet
+			pendingStatus := status.Subscriptions[1]
+			assert.Equal(t, pending.Name, pendingStatus.SubscriptionName)
+			assert.Equal(t, testCase.expectedPending, pendingStatus.Delivered)
+			assert.Len(t, pendingStatus.Channels, 1)
+		})
+	}
+
+	_, err := NotificationDeliveryStatusById(notFoundId, dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+
+	_, err = NotificationDeliveryStatusById("invalidId", dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
@@ -0,0 +1,203 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"slices"
+	"time"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// deliveryWindowRecheckInterval bounds the wait for a delivery window, so the changes of Writable.DeliveryWindows
+// apply to the deferred sends
+const deliveryWindowRecheckInterval = time.Minute
+
+// TransmissionStatusDeferred is the status of the transmission whose first send is deferred until a delivery window of
+// its channel opens, see Writable.DeliveryWindows
+const TransmissionStatusDeferred models.TransmissionStatus = "DEFERRED"
+
+// deferredResponse is the response of the transmission record of the send deferred to a delivery window
+const deferredResponse = "deferred to the delivery window"
+
+// deliveryWindow returns the delivery window of the channel, which is looked up by the host of the channel and then
+// by the channel type
+func deliveryWindow(writable config.WritableInfo, address models.Address) (config.DeliveryWindow, bool) {
+	baseAddress := address.GetBaseAddress()
+	if window, ok := writable.DeliveryWindows[baseAddress.Host]; ok && baseAddress.Host != "" {
+		return window, true
+	}
+	window, ok := writable.DeliveryWindows[baseAddress.Type]
+	return window, ok
+}
+
+// nextWindowOpen returns the earliest time at or after now when one of the allowed windows is open, which is now if a
+// window is already open. The invalid windows are ignored, and now is returned if no window ever opens.
+func nextWindowOpen(window config.DeliveryWindow, now time.Time) time.Time {
+	location, err := window.Location()
+	if err != nil {
+		return now
+	}
+	local := now.In(location)
+	var next time.Time
+	for _, allowed := range window.Allowed {
+		days, err := allowed.Weekdays()
+		if err != nil {
+			continue
+		}
+		start, end, err := allowed.Minutes()
+		if err != nil {
+			continue
+		}
+		if end < start {
+			// the window crossing midnight ends on the next day
+			end += 24 * 60
+		}
+		// start from the day before, whose window crossing midnight may still be open
+		for offset := -1; offset <= 7; offset++ {
+			day := time.Date(local.Year(), local.Month(), local.Day()+offset, 0, 0, 0, 0, location)
+			if !slices.Contains(days, day.Weekday()) {
+				continue
+			}
+			opens := time.Date(day.Year(), day.Month(), day.Day(), 0, start, 0, 0, location)
+			closes := time.Date(day.Year(), day.Month(), day.Day(), 0, end, 0, 0, location)
+			if !now.Before(opens) && now.Before(closes) {
+				return now
+			}
+			if opens.After(now) && (next.IsZero() || opens.Before(next)) {
+				next = opens
+			}
+		}
+	}
+	if next.IsZero() {
+		return now
+	}
+	return next
+}
+
+// deliveryWindowOpening returns the opening of the next delivery window of the channel, and whether the send of the
+// notification is deferred to it because no window of the channel is open now. The notifications at least as severe as
+// CRITICAL are not deferred if the CriticalOverride is enabled.
+func deliveryWindowOpening(dic *di.Container, n models.Notification, address models.Address) (time.Time, bool) {
+	window, ok := deliveryWindow(container.ConfigurationFrom(dic.Get).Writable, address)
+	if !ok || (window.CriticalOverride && severityAtLeast(dic, n.Severity, models.Critical)) {
+		return time.Time{}, false
+	}
+	now := time.Now()
+	opens := nextWindowOpen(window, now)
+	return opens, opens.After(now)
+}
+
+// deferTransmission stores the transmission deferred to the delivery window opening at opens, and schedules the send
+// when the window opens. The new transmission is stored with the DEFERRED status, while the resending transmission keeps
+// its status. The opening is stored as the next attempt of a DEFERRED record, along with the failure class of the last
+// send, so the deferred transmission is resumed on schedule if the service restarts before then.
+func deferTransmission(dic *di.Container, n models.Notification, trans models.Transmission, opens time.Time, failureClass channel.FailureClass) (models.Transmission, errors.EdgeX) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+
+	record := models.TransmissionRecord{Status: TransmissionStatusDeferred, Response: deferredResponse, Sent: pkgCommon.MakeTimestamp()}
+	annotateNextAttempt(&record, opens, failureClass)
+	trans.Records = append(trans.Records, record)
+	var err errors.EdgeX
+	if trans.Id == "" {
+		trans.Status = TransmissionStatusDeferred
+		trans, err = dbClient.AddTransmission(trans)
+	} else {
+		err = dbClient.UpdateTransmission(trans)
+	}
+	if err != nil {
+		return trans, errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Infof("the notification %s to the subscription %s with the %s channel is deferred to the delivery window opening at %s",
+		n.Id, trans.SubscriptionName, trans.Channel.GetBaseAddress().Type, opens.Format(time.RFC3339))
+	scheduleDeferredTransmission(dic, trans, opens)
+	return trans, nil
+}
+
+// scheduleDeferredTransmission resumes the deferred transmission when the delivery window opens. The wait holds neither
+// the ordering slot of the subscription nor the drain of the notification, and is bounded by
+// deliveryWindowRecheckInterval so the changes of Writable.DeliveryWindows apply to the deferred transmission.
+func scheduleDeferredTransmission(dic *di.Container, trans models.Transmission, opens time.Time) {
+	time.AfterFunc(min(time.Until(opens), deliveryWindowRecheckInterval), func() {
+		resumeDeferredTransmission(dic, trans)
+	})
+}
+
+// resumeDeferredTransmission sends the deferred transmission if the delivery window is open, or schedules it again. The
+// notification and the subscription are read again, as they may be changed or removed while the transmission is
+// deferred. The transmission stays deferred in the database if the service is shutting down, and is resumed on the next
+// start.
+func resumeDeferredTransmission(dic *di.Container, trans models.Transmission) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+
+	drainer := dispatchDrainerFrom(dic.Get)
+	if !drainer.accepting() {
+		return
+	}
+	n, sub, err := resendTarget(dbClient, trans)
+	if errors.Kind(err) == errors.KindEntityDoesNotExist {
+		lc.Errorf("Failed to resume the deferred transmission %s, %v", trans.Id, err)
+		trans.Status = models.Failed
+		if err = dbClient.UpdateTransmission(trans); err != nil {
+			lc.Errorf("Failed to update the status of the transmission %s, %v", trans.Id, err)
+		}
+		return
+	}
+	if err != nil {
+		lc.Errorf("Failed to resume the deferred transmission %s, it is resumed on the next start, %v", trans.Id, err)
+		return
+	}
+	if opens, deferred := deliveryWindowOpening(dic, n, trans.Channel); deferred {
+		scheduleDeferredTransmission(dic, trans, opens)
+		return
+	}
+	slot := transmissionSlot(dic, n, sub, trans.Channel)
+	drainer.join(n, true, func() {
+		slot.run(func() {
+			if trans.Status == models.RESENDING {
+				resumeResend(dic, n, sub, trans)
+				return
+			}
+			sendTransmission(dic, n, sub, trans) // nolint:errcheck
+		})
+	})
+}
+
+// deferredTransmission returns whether the last record of the transmission is the deferral to a delivery window
+func deferredTransmission(trans models.Transmission) bool {
+	return len(trans.Records) > 0 && trans.Records[len(trans.Records)-1].Status == TransmissionStatusDeferred
+}
+
+// deferWithoutPersistence sends the non-persisted notification when the delivery window opens. The deferred send is
+// only kept in memory, so it is lost if the service stops before the window opens.
+func deferWithoutPersistence(dic *di.Container, n models.Notification, sub models.Subscription, address models.Address, opens time.Time) {
+	time.AfterFunc(min(time.Until(opens), deliveryWindowRecheckInterval), func() {
+		drainer := dispatchDrainerFrom(dic.Get)
+		if !drainer.accepting() {
+			return
+		}
+		if opens, deferred := deliveryWindowOpening(dic, n, address); deferred {
+			deferWithoutPersistence(dic, n, sub, address, opens)
+			return
+		}
+		slot := transmissionSlot(dic, n, sub, address)
+		drainer.join(n, false, func() {
+			slot.run(func() {
+				firstSend(dic, renderNotification(dic, n, sub), models.NewTransmission(sub.Name, address, n.Id))
+			})
+		})
+	})
+}
@@ -0,0 +1,222 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextWindowOpen(t *testing.T) {
+	weekdays := config.TimeWindow{Days: []string{"Mon", "Tue", "Wed", "Thu", "Fri"}, Start: "08:00", End: "20:00"}
+	overnight := config.TimeWindow{Days: []string{"Sat"}, Start: "22:00", End: "02:00"}
+	window := config.DeliveryWindow{Allowed: []config.TimeWindow{weekdays, overnight}}
+	// 2025-01-06 is a Monday
+	monday := func(hour, minute int) time.Time { return time.Date(2025, 1, 6, hour, minute, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		expected time.Time
+	}{
+		{"within the window", monday(12, 0), monday(12, 0)},
+		{"before the window", monday(7, 30), monday(8, 0)},
+		{"after the window", monday(20, 0), monday(8, 0).AddDate(0, 0, 1)},
+		{"after the last window of the week", monday(20, 0).AddDate(0, 0, 4), monday(22, 0).AddDate(0, 0, 5)},
+		{"within the window crossing midnight", monday(1, 0).AddDate(0, 0, 6), monday(1, 0).AddDate(0, 0, 6)},
+		{"after the window crossing midnight", monday(2, 0).AddDate(0, 0, 6), monday(8, 0).AddDate(0, 0, 7)},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.True(t, testCase.expected.Equal(nextWindowOpen(window, testCase.now)), "expected %s, got %s", testCase.expected, nextWindowOpen(window, testCase.now))
+		})
+	}
+
+	window.TimeZone = "Asia/Tokyo"
+	// 12:00 in Tokyo is 03:00 in UTC
+	assert.True(t, monday(3, 0).Equal(nextWindowOpen(window, monday(3, 0))))
+	assert.True(t, monday(23, 0).Equal(nextWindowOpen(window, monday(12, 0))), "the window should reopen at 08:00 in Tokyo")
+}
+
+func TestDeliveryWindow(t *testing.T) {
+	hostWindow := config.DeliveryWindow{Allowed: []config.TimeWindow{{Start: "08:00", End: "20:00"}}}
+	typeWindow := config.DeliveryWindow{Allowed: []config.TimeWindow{{Start: "00:00", End: "24:00"}}}
+	writable := config.WritableInfo{DeliveryWindows: map[string]config.DeliveryWindow{testHost: hostWindow, common.EMAIL: typeWindow}}
+
+	window, ok := deliveryWindow(writable, testEmailAddress)
+	require.True(t, ok)
+	assert.Equal(t, hostWindow, window, "the host should take precedence over the channel type")
+
+	writable.DeliveryWindows = map[string]config.DeliveryWindow{common.EMAIL: typeWindow}
+	window, ok = deliveryWindow(writable, testEmailAddress)
+	require.True(t, ok)
+	assert.Equal(t, typeWindow, window)
+	_, ok = deliveryWindow(writable, testRestAddress)
+	assert.False(t, ok)
+}
+
+// closedDeliveryWindow configures the delivery window of the REST channel which opens in an hour, and returns the
+// opening
+func closedDeliveryWindow(dic *di.Container, criticalOverride bool) time.Time {
+	opens := time.Now().UTC().Add(time.Hour).Truncate(time.Minute)
+	container.ConfigurationFrom(dic.Get).Writable.DeliveryWindows = map[string]config.DeliveryWindow{common.REST: {
+		Allowed:          []config.TimeWindow{{Start: opens.Format("15:04"), End: opens.Add(time.Minute).Format("15:04")}},
+		CriticalOverride: criticalOverride,
+	}}
+	return opens
+}
+
+func TestDeliveryWindowOpening(t *testing.T) {
+	dic := mockDic()
+	n := notification
+	n.Severity = models.Critical
+
+	_, deferred := deliveryWindowOpening(dic, n, testRestAddress)
+	assert.False(t, deferred, "the channel without a delivery window should not be deferred")
+
+	opens := closedDeliveryWindow(dic, false)
+	next, deferred := deliveryWindowOpening(dic, n, testRestAddress)
+	require.True(t, deferred)
+	assert.True(t, opens.Equal(next), "expected %s, got %s", opens, next)
+
+	closedDeliveryWindow(dic, true)
+	_, deferred = deliveryWindowOpening(dic, n, testRestAddress)
+	assert.False(t, deferred, "the critical notification should not be deferred")
+}
+
+func TestTransmit_DeferredToDeliveryWindow(t *testing.T) {
+	n := notification
+	n.Id = exampleUUID
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddTransmission", mock.Anything).Return(func(trans models.Transmission) (models.Transmission, errors.EdgeX) {
+		trans.Id = exampleUUID
+		return trans, nil
+	})
+	restSender := &senderMock.Sender{}
+	dic := deadLetterTestDic(dbClientMock, restSender)
+	opens := closedDeliveryWindow(dic, false)
+	// stop the scheduled send, the transmission stays deferred in the database
+	t.Cleanup(func() { dispatchDrainerFrom(dic.Get).drain(0) })
+
+	trans, err := transmit(dic, n, sub, testRestAddress)
+	require.NoError(t, err)
+	assert.EqualValues(t, TransmissionStatusDeferred, trans.Status)
+	require.True(t, deferredTransmission(trans))
+	assert.True(t, opens.Equal(storedNextAttempt(trans, 0)), "the window opening should be stored as the next attempt")
+	dbClientMock.AssertCalled(t, "AddTransmission", mock.Anything)
+	restSender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+func TestResendFrom_DeferredToDeliveryWindow(t *testing.T) {
+	n := notification
+	n.Id = exampleUUID
+	n.Severity = models.Critical
+	trans := models.NewTransmission(sub.Name, testRestAddress, n.Id)
+	trans.Id = exampleUUID
+	trans.Status = models.RESENDING
+	trans.Records = []models.TransmissionRecord{{Status: models.Failed, Sent: time.Now().UnixMilli()}}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil)
+	restSender := &senderMock.Sender{}
+	dic := deadLetterTestDic(dbClientMock, restSender)
+	opens := closedDeliveryWindow(dic, false)
+	t.Cleanup(func() { dispatchDrainerFrom(dic.Get).drain(0) })
+
+	done := make(chan models.Transmission)
+	go func() {
+		deferredTrans, err := resendFrom(dic, n, sub, trans, testRestAddress, sendResult{failureClass: channel.FailureClassNetwork}, time.Now())
+		assert.NoError(t, err)
+		done <- deferredTrans
+	}()
+	select {
+	case deferredTrans := <-done:
+		// the resend is deferred without waiting for the window, and resumed from the stored transmission
+		assert.EqualValues(t, models.RESENDING, deferredTrans.Status)
+		assert.Equal(t, 0, deferredTrans.ResendCount)
+		require.True(t, deferredTransmission(deferredTrans))
+		assert.True(t, opens.Equal(storedNextAttempt(deferredTrans, 0)))
+		assert.Equal(t, channel.FailureClassNetwork, storedFailureClass(deferredTrans))
+	case <-time.After(time.Second):
+		require.Fail(t, "the resend should not wait for the delivery window")
+	}
+	dbClientMock.AssertCalled(t, "UpdateTransmission", mock.Anything)
+	restSender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+func TestRedistributePendingNotifications_ResumeDeferred(t *testing.T) {
+	n := notification
+	n.Id = exampleUUID
+	stored := models.NewTransmission(sub.Name, testRestAddress, n.Id)
+	stored.Id = exampleUUID
+	stored.Status = TransmissionStatusDeferred
+	record := models.TransmissionRecord{Status: TransmissionStatusDeferred, Response: deferredResponse, Sent: time.Now().Add(-time.Hour).UnixMilli()}
+	annotateNextAttempt(&record, time.Now().Add(-time.Minute), "")
+	stored.Records = []models.TransmissionRecord{record}
+
+	after := &transmissionRecorder{}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("TransmissionsByStatus", 0, -1, models.RESENDING).Return([]models.Transmission{}, nil)
+	dbClientMock.On("TransmissionsByStatus", 0, -1, string(TransmissionStatusDeferred)).Return([]models.Transmission{stored}, nil)
+	dbClientMock.On("NotificationById", n.Id).Return(n, nil)
+	dbClientMock.On("SubscriptionByName", sub.Name).Return(sub, nil)
+	dbClientMock.On("NotificationsByStatus", 0, -1, "", models.New).Return([]models.Notification{}, nil)
+	dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil).Run(after.record)
+	restSender := &senderMock.Sender{}
+	restSender.On("Send", n, testRestAddress).Return("", nil)
+	dic := resumeTestDic(dbClientMock, restSender)
+
+	require.NoError(t, RedistributePendingNotifications(dic))
+	require.Eventually(t, func() bool { return after.last().Status == models.Sent }, 5*time.Second, 10*time.Millisecond)
+
+	resumed := after.last()
+	restSender.AssertNumberOfCalls(t, "Send", 1)
+	require.Len(t, resumed.Records, 2)
+	assert.Equal(t, stored.Records[0], resumed.Records[0], "the deferral should be kept in the records")
+	dbClientMock.AssertNotCalled(t, "AddTransmission", mock.Anything)
+}
+
+func TestValidateDeliveryWindows(t *testing.T) {
+	valid := config.DeliveryWindow{TimeZone: "Europe/Berlin", Allowed: []config.TimeWindow{{Days: []string{"mon", "Sat"}, Start: "22:00", End: "06:00"}}}
+	tests := []struct {
+		name          string
+		window        config.DeliveryWindow
+		errorExpected bool
+	}{
+		{"valid", valid, false},
+		{"whole day", config.DeliveryWindow{Allowed: []config.TimeWindow{{Start: "00:00", End: "24:00"}}}, false},
+		{"no allowed window", config.DeliveryWindow{}, true},
+		{"invalid time zone", config.DeliveryWindow{TimeZone: "Mars/Olympus", Allowed: valid.Allowed}, true},
+		{"invalid day", config.DeliveryWindow{Allowed: []config.TimeWindow{{Days: []string{"Funday"}, Start: "08:00", End: "20:00"}}}, true},
+		{"invalid time", config.DeliveryWindow{Allowed: []config.TimeWindow{{Start: "8am", End: "20:00"}}}, true},
+		{"empty window", config.DeliveryWindow{Allowed: []config.TimeWindow{{Start: "08:00", End: "08:00"}}}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			writable := config.WritableInfo{DeliveryWindows: map[string]config.DeliveryWindow{common.REST: testCase.window}}
+			err := writable.ValidateDeliveryWindows()
+			if testCase.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
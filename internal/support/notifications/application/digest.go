@@ -0,0 +1,126 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// DigestTemplateLabelPrefix is the prefix of the subscription label referencing the notification template which
+// formats the digests of the subscription by name, e.g. "digestTemplate:daily". The template is rendered with the
+// NotificationDigest.
+const DigestTemplateLabelPrefix = "digestTemplate:"
+
+// NotificationDigest is the data the digest template is rendered with, e.g. {{.Count}} or {{range .Items}}
+type NotificationDigest struct {
+	Count int
+	// Severities counts the notifications by severity
+	Severities map[string]int
+	// Start and End are the creation times of the earliest and the latest notifications
+	Start time.Time
+	End   time.Time
+	Items []models.Notification
+}
+
+// defaultDigestTemplate is the digest layout of the subscriptions referencing no digest template
+var defaultDigestTemplate = notificationModels.NotificationTemplate{
+	Name: "default-digest",
+	Content: `{{.Count}} notifications from {{.Start.UTC.Format "2006-01-02T15:04:05Z07:00"}} to {{.End.UTC.Format "2006-01-02T15:04:05Z07:00"}}
+{{range $severity, $count := .Severities}}{{$severity}}: {{$count}}
+{{end}}{{range .Items}}
+- [{{.Severity}}] {{if .Category}}{{.Category}} {{end}}from {{.Sender}}: {{.Content}}{{end}}
+`,
+	ContentType: common.ContentTypeText,
+}
+
+// subscriptionDigestTemplateName returns the name of the digest template referenced by the subscription, or empty if
+// there is none
+func subscriptionDigestTemplateName(sub models.Subscription) string {
+	return subscriptionLabelValue(sub, DigestTemplateLabelPrefix)
+}
+
+func newNotificationDigest(notifications []models.Notification) NotificationDigest {
+	digest := NotificationDigest{Count: len(notifications), Severities: make(map[string]int), Items: notifications}
+	for i, n := range notifications {
+		digest.Severities[string(n.Severity)]++
+		created := time.UnixMilli(n.Created)
+		if i == 0 || created.Before(digest.Start) {
+			digest.Start = created
+		}
+		if i == 0 || created.After(digest.End) {
+			digest.End = created
+		}
+	}
+	return digest
+}
+
+// RenderNotificationDigest combines the batched notifications of the subscription into one digest notification, whose
+// content is rendered with the digest template referenced by the subscription. The default digest layout is used if
+// the subscription references no digest template, or the template is missing or fails to render. The digest has the
+// highest severity of the notifications, and the category and the sender they share. The localized notifications are
+// digested in the preferred language of the subscription.
+func RenderNotificationDigest(notifications []models.Notification, sub models.Subscription, dic *di.Container) (models.Notification, errors.EdgeX) {
+	if len(notifications) == 0 {
+		return models.Notification{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "no notifications to digest", nil)
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	localized := make([]models.Notification, len(notifications))
+	for i, n := range notifications {
+		localized[i] = localizeNotification(n, sub)
+	}
+	digest := newNotificationDigest(localized)
+
+	t := defaultDigestTemplate
+	if name := subscriptionDigestTemplateName(sub); name != "" {
+		stored, err := container.DBClientFrom(dic.Get).NotificationTemplateByName(name)
+		if err != nil {
+			lc.Errorf("fail to query the digest template %s of the subscription %s, use the default digest layout, err: %v", name, sub.Name, err)
+		} else {
+			t = stored
+		}
+	}
+	content, err := renderTemplateContent(t, digest)
+	if err != nil && t.Name != defaultDigestTemplate.Name {
+		lc.Errorf("fail to render the digest of the subscription %s with the template %s, use the default digest layout, err: %v", sub.Name, t.Name, err)
+		t = defaultDigestTemplate
+		content, err = renderTemplateContent(t, digest)
+	}
+	if err != nil {
+		return models.Notification{}, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	result := models.Notification{
+		Category:    notifications[0].Category,
+		Content:     content,
+		ContentType: t.ContentType,
+		Sender:      notifications[0].Sender,
+		Severity:    notifications[0].Severity,
+	}
+	if result.ContentType == "" {
+		result.ContentType = common.ContentTypeText
+	}
+	for _, n := range notifications[1:] {
+		if n.Category != result.Category {
+			result.Category = ""
+		}
+		if n.Sender != result.Sender {
+			result.Sender = common.SupportNotificationsServiceKey
+		}
+		if severityAtLeast(dic, n.Severity, result.Severity) {
+			result.Severity = n.Severity
+		}
+	}
+	return result, nil
+}
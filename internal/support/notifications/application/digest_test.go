@@ -0,0 +1,81 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderNotificationDigest(t *testing.T) {
+	summary := notificationModels.NotificationTemplate{
+		Name:        "summary",
+		Content:     "{{.Count}} alerts, {{index .Severities \"CRITICAL\"}} critical:{{range .Items}} {{.Content}};{{end}}",
+		ContentType: "text/html",
+	}
+	broken := notificationModels.NotificationTemplate{Name: "broken", Content: "{{.Missing}}"}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("NotificationTemplateByName", summary.Name).Return(summary, nil)
+	dbClientMock.On("NotificationTemplateByName", broken.Name).Return(broken, nil)
+	dbClientMock.On("NotificationTemplateByName", "deleted").Return(notificationModels.NotificationTemplate{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	start := time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC)
+	notifications := []models.Notification{
+		{Category: "hvac", Content: "filter dirty", Sender: "device-1", Severity: models.Minor},
+		{Category: "hvac", Content: "compressor down", Sender: "device-2", Severity: models.Critical},
+		{Category: "hvac", Content: "fan slow", Sender: "device-1", Severity: models.Normal},
+	}
+	for i, offset := range []time.Duration{time.Hour, 0, 30 * time.Minute} {
+		notifications[i].Created = start.Add(offset).UnixMilli()
+	}
+
+	digest, err := RenderNotificationDigest(notifications, models.Subscription{Name: "ops", Labels: []string{DigestTemplateLabelPrefix + summary.Name}}, dic)
+	require.NoError(t, err)
+	assert.Equal(t, "3 alerts, 1 critical: filter dirty; compressor down; fan slow;", digest.Content)
+	assert.Equal(t, "text/html", digest.ContentType)
+	assert.Equal(t, models.NotificationSeverity(models.Critical), digest.Severity, "the digest should have the highest severity")
+	assert.Equal(t, "hvac", digest.Category)
+	assert.Equal(t, common.SupportNotificationsServiceKey, digest.Sender, "the notifications have different senders")
+
+	expectedDefault := "3 notifications from 2025-01-01T08:00:00Z to 2025-01-01T09:00:00Z\n" +
+		"CRITICAL: 1\nMINOR: 1\nNORMAL: 1\n\n" +
+		"- [MINOR] hvac from device-1: filter dirty\n" +
+		"- [CRITICAL] hvac from device-2: compressor down\n" +
+		"- [NORMAL] hvac from device-1: fan slow\n"
+	for _, sub := range []models.Subscription{
+		{Name: "no template"},
+		{Name: "missing template", Labels: []string{DigestTemplateLabelPrefix + "deleted"}},
+		{Name: "broken template", Labels: []string{DigestTemplateLabelPrefix + broken.Name}},
+	} {
+		digest, err = RenderNotificationDigest(notifications, sub, dic)
+		require.NoError(t, err, sub.Name)
+		assert.Equal(t, expectedDefault, digest.Content, "%s should fall back to the default digest layout", sub.Name)
+		assert.Equal(t, common.ContentTypeText, digest.ContentType)
+	}
+
+	_, err = RenderNotificationDigest(nil, models.Subscription{Name: "ops"}, dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
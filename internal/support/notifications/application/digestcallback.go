@@ -0,0 +1,138 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/google/uuid"
+)
+
+// DigestItemOutcome is the outcome of a notification included in the digest, which is SENT if the digest satisfies the
+// delivery policy of the subscription, otherwise FAILED
+type DigestItemOutcome struct {
+	NotificationId string `json:"notificationId"`
+	Status         string `json:"status"`
+}
+
+// DigestChannelOutcome is the outcome of sending the digest to a channel of the subscription
+type DigestChannelOutcome struct {
+	Channel  dtos.Address `json:"channel"`
+	Status   string       `json:"status"`
+	Response string       `json:"response,omitempty"`
+}
+
+// DigestBatchReport is the outcome of a dispatched digest, which is posted to the DigestCallback URL
+type DigestBatchReport struct {
+	BatchId          string                 `json:"batchId"`
+	SubscriptionName string                 `json:"subscriptionName"`
+	Policy           string                 `json:"policy"`
+	Delivered        bool                   `json:"delivered"`
+	NotificationIds  []string               `json:"notificationIds"`
+	Items            []DigestItemOutcome    `json:"items"`
+	Channels         []DigestChannelOutcome `json:"channels"`
+	Dispatched       int64                  `json:"dispatched"`
+}
+
+// DispatchNotificationDigest renders the digest of the batched notifications of the subscription, sends it to the
+// channels of the subscription, and reports the outcome to the DigestCallback URL if it is configured. The report is
+// returned regardless of the callback, which never changes the outcome.
+func DispatchNotificationDigest(notifications []models.Notification, sub models.Subscription, dic *di.Container) (DigestBatchReport, errors.EdgeX) {
+	digest, err := RenderNotificationDigest(notifications, sub, dic)
+	if err != nil {
+		return DigestBatchReport{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	digest.Id = uuid.NewString()
+	digest.Created = time.Now().UnixMilli()
+
+	writable := container.ConfigurationFrom(dic.Get).Writable
+	report := DigestBatchReport{
+		BatchId:          digest.Id,
+		SubscriptionName: sub.Name,
+		Policy:           deliveryPolicy(writable, sub.Name),
+		NotificationIds:  make([]string, len(notifications)),
+		Items:            make([]DigestItemOutcome, len(notifications)),
+		Channels:         make([]DigestChannelOutcome, 0, len(sub.Channels)),
+	}
+	status := SubscriptionDeliveryStatus{SubscriptionName: sub.Name, Policy: report.Policy}
+	for _, address := range sub.Channels {
+		address, _ = limitRecipients(dic, sub.Name, address)
+		result := sendForSubscription(dic, digest, sub.Name, address)
+		report.Channels = append(report.Channels, DigestChannelOutcome{
+			Channel:  dtos.FromAddressModelToDTO(address),
+			Status:   string(result.record.Status),
+			Response: result.record.Response,
+		})
+		status.Channels = append(status.Channels, ChannelDeliveryStatus{Status: string(result.record.Status)})
+	}
+	report.Delivered = policySatisfied(status, len(sub.Channels))
+	itemStatus := models.Failed
+	if report.Delivered {
+		itemStatus = models.Sent
+	}
+	for i, n := range notifications {
+		report.NotificationIds[i] = n.Id
+		report.Items[i] = DigestItemOutcome{NotificationId: n.Id, Status: string(itemStatus)}
+	}
+	report.Dispatched = time.Now().UnixMilli()
+
+	postDigestCallback(dic, writable.DigestCallback, report)
+	return report, nil
+}
+
+// postDigestCallback posts the report to the callback URL, and retries up to the RetryLimit times on failure. The
+// callback is best-effort, the failure is only logged.
+func postDigestCallback(dic *di.Container, callback config.DigestCallbackInfo, report DigestBatchReport) {
+	if callback.URL == "" {
+		return
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	body, err := json.Marshal(report)
+	if err != nil {
+		lc.Errorf("fail to encode the report of the digest %s, err: %v", report.BatchId, err)
+		return
+	}
+	client := &http.Client{}
+	if callback.Timeout != "" {
+		client.Timeout, _ = time.ParseDuration(callback.Timeout)
+	}
+	retryInterval, _ := time.ParseDuration(callback.RetryInterval)
+	retryLimit := min(max(callback.RetryLimit, 0), config.MaxDigestCallbackRetryLimit)
+
+	for attempt := 0; ; attempt++ {
+		req, reqErr := http.NewRequest(http.MethodPost, callback.URL, bytes.NewReader(body))
+		if reqErr != nil {
+			lc.Errorf("fail to create the callback request of the digest %s, err: %v", report.BatchId, reqErr)
+			return
+		}
+		req.Header.Set(common.ContentType, common.ContentTypeJSON)
+		_, sendErr := utils.SendRequestAndGetResponse(client, req)
+		if sendErr == nil {
+			lc.Debugf("reported the digest %s of the subscription %s to the callback", report.BatchId, report.SubscriptionName)
+			return
+		}
+		if attempt >= retryLimit {
+			lc.Errorf("fail to report the digest %s of the subscription %s to the callback after %d attempts, err: %v", report.BatchId, report.SubscriptionName, attempt+1, sendErr)
+			return
+		}
+		lc.Warnf("fail to report the digest %s of the subscription %s to the callback, retry in %s, err: %v", report.BatchId, report.SubscriptionName, retryInterval, sendErr)
+		time.Sleep(retryInterval)
+	}
+}
@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatchNotificationDigest(t *testing.T) {
+	var reports []DigestBatchReport
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// fail the first attempt to verify the retry
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var report DigestBatchReport
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&report))
+		reports = append(reports, report)
+	}))
+	defer server.Close()
+
+	restSender := &senderMock.Sender{}
+	restSender.On("Send", mock.Anything, testRestAddress).Return("", nil)
+	restSender.On("Send", mock.Anything, testRestAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "unreachable", nil))
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		channel.RESTSenderName: func(get di.Get) interface{} {
+			return restSender
+		},
+	})
+	container.ConfigurationFrom(dic.Get).Writable.DigestCallback = config.DigestCallbackInfo{URL: server.URL, RetryLimit: 1, RetryInterval: "1ms"}
+	first, second := notification, notification
+	first.Id, second.Id = exampleUUID, "5f8d1c3a-4a8e-4b8f-9a3d-2c1f0e7b6a59"
+	subscription := models.Subscription{Name: sub.Name, Channels: []models.Address{testRestAddress, testRestAddress2}}
+
+	report, err := DispatchNotificationDigest([]models.Notification{first, second}, subscription, dic)
+	require.NoError(t, err)
+	assert.NotEmpty(t, report.BatchId)
+	assert.False(t, report.Delivered, "the default all policy requires every channel to be delivered")
+	assert.Equal(t, []string{first.Id, second.Id}, report.NotificationIds)
+	assert.Equal(t, []DigestItemOutcome{{first.Id, string(models.Failed)}, {second.Id, string(models.Failed)}}, report.Items)
+	require.Len(t, report.Channels, 2)
+	assert.Equal(t, string(models.Sent), report.Channels[0].Status)
+	assert.Equal(t, string(models.Failed), report.Channels[1].Status)
+	assert.EqualValues(t, 2, attempts.Load())
+	require.Len(t, reports, 1, "one callback should be posted per digest")
+	assert.Equal(t, report.BatchId, reports[0].BatchId)
+	assert.Equal(t, report.Items, reports[0].Items)
+
+	container.ConfigurationFrom(dic.Get).Writable.DeliveryPolicy = map[string]string{sub.Name: config.DeliveryPolicyAny}
+	report, err = DispatchNotificationDigest([]models.Notification{first, second}, subscription, dic)
+	require.NoError(t, err)
+	assert.True(t, report.Delivered)
+	assert.Equal(t, string(models.Sent), report.Items[0].Status)
+	require.Len(t, reports, 2)
+}
+
+func TestPostDigestCallback_BoundedRetry(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	postDigestCallback(mockDic(), config.DigestCallbackInfo{URL: server.URL, RetryLimit: 100, RetryInterval: "1ms"}, DigestBatchReport{BatchId: exampleUUID})
+	assert.EqualValues(t, config.MaxDigestCallbackRetryLimit+1, attempts.Load())
+}
+
+func TestValidateDigestCallback(t *testing.T) {
+	tests := []struct {
+		name          string
+		callback      config.DigestCallbackInfo
+		errorExpected bool
+	}{
+		{"disabled", config.DigestCallbackInfo{RetryLimit: -1}, false},
+		{"valid", config.DigestCallbackInfo{URL: "https://orchestrator:8443/digests", RetryLimit: 2, RetryInterval: "5s", Timeout: "10s"}, false},
+		{"relative URL", config.DigestCallbackInfo{URL: "/digests"}, true},
+		{"unsupported scheme", config.DigestCallbackInfo{URL: "ftp://orchestrator/digests"}, true},
+		{"retry limit over the max", config.DigestCallbackInfo{URL: "http://orchestrator/digests", RetryLimit: config.MaxDigestCallbackRetryLimit + 1}, true},
+		{"invalid retry interval", config.DigestCallbackInfo{URL: "http://orchestrator/digests", RetryInterval: "soon"}, true},
+		{"negative timeout", config.DigestCallbackInfo{URL: "http://orchestrator/digests", Timeout: "-1s"}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := config.WritableInfo{DigestCallback: testCase.callback}.ValidateDigestCallback()
+			if testCase.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
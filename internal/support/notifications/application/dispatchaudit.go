@@ -0,0 +1,152 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/google/uuid"
+)
+
+// dispatchAuditLocks serialize the appends to the dispatch audit of each notification, which is picked by the hash of
+// the notification id, so the records of a notification are numbered and chained without gaps
+var dispatchAuditLocks [64]sync.Mutex
+
+// DispatchAudit is the dispatch audit trail of a notification, Intact is false if the hash chain of the records is
+// broken, i.e. a record is modified, removed or reordered after it was appended, and BrokenAt is the sequence of the
+// first record failing the verification
+type DispatchAudit struct {
+	NotificationId string                                   `json:"notificationId"`
+	Intact         bool                                     `json:"intact"`
+	BrokenAt       int                                      `json:"brokenAt,omitempty"`
+	Records        []notificationModels.DispatchAuditRecord `json:"records"`
+}
+
+// NotificationDispatchAudit returns the dispatch audit trail of the notification along with the verification of its
+// hash chain. The trail is kept after the notification is deleted.
+func NotificationDispatchAudit(notificationId string, dic *di.Container) (DispatchAudit, errors.EdgeX) {
+	if notificationId == "" {
+		return DispatchAudit{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "ID is empty", nil)
+	}
+	if _, err := uuid.Parse(notificationId); err != nil {
+		return DispatchAudit{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "ID is not a valid UUID", err)
+	}
+	records, err := container.DBClientFrom(dic.Get).DispatchAuditRecordsByNotificationId(notificationId)
+	if err != nil {
+		return DispatchAudit{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(records) == 0 {
+		return DispatchAudit{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no dispatch audit record of the notification %s", notificationId), nil)
+	}
+
+	audit := DispatchAudit{NotificationId: notificationId, Intact: true, Records: records}
+	previousHash := ""
+	for i, record := range records {
+		if record.Sequence != i+1 || record.PreviousHash != previousHash || record.Hash != dispatchAuditHash(record) {
+			audit.Intact = false
+			audit.BrokenAt = i + 1
+			break
+		}
+		previousHash = record.Hash
+	}
+	return audit, nil
+}
+
+// dispatchAuditHash returns the hex-encoded SHA-256 hash of the record without its Hash, which covers the PreviousHash
+func dispatchAuditHash(record notificationModels.DispatchAuditRecord) string {
+	record.Hash = ""
+	// the record only consists of the JSON-encodable fields
+	data, _ := json.Marshal(record)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// recordDispatchAudit appends the record to the dispatch audit of its notification if Writable.DispatchAudit is
+// enabled. The record is numbered and chained to the latest record of the notification, and inherits the correlation
+// id of the notification. The failure to append is logged without failing the dispatch.
+func recordDispatchAudit(dic *di.Container, record notificationModels.DispatchAuditRecord) {
+	if !container.ConfigurationFrom(dic.Get).Writable.DispatchAudit {
+		return
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(record.NotificationId))
+	lock := &dispatchAuditLocks[h.Sum32()%uint32(len(dispatchAuditLocks))]
+	lock.Lock()
+	defer lock.Unlock()
+
+	record.Sequence = 1
+	latest, err := dbClient.LatestDispatchAuditRecordByNotificationId(record.NotificationId)
+	if err == nil {
+		record.Sequence = latest.Sequence + 1
+		record.PreviousHash = latest.Hash
+		if record.CorrelationId == "" {
+			record.CorrelationId = latest.CorrelationId
+		}
+	} else if errors.Kind(err) != errors.KindEntityDoesNotExist {
+		lc.Errorf("fail to append the %s dispatch audit record of the notification %s, err: %v", record.Event, record.NotificationId, err)
+		return
+	}
+	record.Id = uuid.NewString()
+	record.Timestamp = time.Now().UnixMilli()
+	record.Hash = dispatchAuditHash(record)
+	if err = dbClient.AddDispatchAuditRecord(record); err != nil {
+		lc.Errorf("fail to append the %s dispatch audit record of the notification %s, err: %v", record.Event, record.NotificationId, err)
+	}
+}
+
+// routingMatches returns the subscriptions along with their categories and labels matching the notification
+func routingMatches(n models.Notification, subs []models.Subscription) []notificationModels.SubscriptionMatch {
+	matches := make([]notificationModels.SubscriptionMatch, 0, len(subs))
+	for _, sub := range subs {
+		match := notificationModels.SubscriptionMatch{SubscriptionName: sub.Name}
+		if n.Category != "" && slices.Contains(sub.Categories, n.Category) {
+			match.Categories = []string{n.Category}
+		}
+		for _, label := range n.Labels {
+			if slices.Contains(sub.Labels, label) {
+				match.Labels = append(match.Labels, label)
+			}
+		}
+		matches = append(matches, match)
+	}
+	return matches
+}
+
+// attemptAuditRecord returns the dispatch audit record of the latest send attempt of the transmission
+func attemptAuditRecord(event string, trans models.Transmission) notificationModels.DispatchAuditRecord {
+	channel := dtos.FromAddressModelToDTO(trans.Channel)
+	record := notificationModels.DispatchAuditRecord{
+		NotificationId:   trans.NotificationId,
+		Event:            event,
+		SubscriptionName: trans.SubscriptionName,
+		Channel:          &channel,
+		TransmissionId:   trans.Id,
+		Status:           string(trans.Status),
+		ResendCount:      trans.ResendCount,
+	}
+	if len(trans.Records) > 0 {
+		record.Response = trans.Records[len(trans.Records)-1].Response
+	}
+	return record
+}
@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// dispatchAuditTestDic returns the dic with the DB client storing the dispatch audit records as JSON, like the database
+func dispatchAuditTestDic(t *testing.T) (*di.Container, *[]notificationModels.DispatchAuditRecord) {
+	var stored []notificationModels.DispatchAuditRecord
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddDispatchAuditRecord", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		data, err := json.Marshal(args.Get(0))
+		require.NoError(t, err)
+		var record notificationModels.DispatchAuditRecord
+		require.NoError(t, json.Unmarshal(data, &record))
+		stored = append(stored, record)
+	})
+	dbClientMock.On("LatestDispatchAuditRecordByNotificationId", exampleUUID).Return(func(string) (notificationModels.DispatchAuditRecord, errors.EdgeX) {
+		if len(stored) == 0 {
+			return notificationModels.DispatchAuditRecord{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "no record", nil)
+		}
+		return stored[len(stored)-1], nil
+	})
+	dbClientMock.On("DispatchAuditRecordsByNotificationId", exampleUUID).Return(func(string) ([]notificationModels.DispatchAuditRecord, errors.EdgeX) {
+		return stored, nil
+	})
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	return dic, &stored
+}
+
+func TestRecordDispatchAudit(t *testing.T) {
+	dic, stored := dispatchAuditTestDic(t)
+	n := models.Notification{Id: exampleUUID, Category: "health-check", Labels: []string{"floor-1", "hvac"}}
+	subs := []models.Subscription{{Name: sub.Name, Categories: []string{"health-check"}, Labels: []string{"hvac", "floor-1", "floor-2"}}}
+	trans := models.Transmission{Id: "1", NotificationId: exampleUUID, SubscriptionName: sub.Name, Channel: testRestAddress,
+		Status: models.Failed, Records: []models.TransmissionRecord{{Status: models.Failed, Response: "503 Service Unavailable"}}}
+
+	recordDispatchAudit(dic, notificationModels.DispatchAuditRecord{NotificationId: exampleUUID, Event: notificationModels.DispatchAuditAccepted})
+	assert.Empty(t, *stored, "the audit should not be recorded if DispatchAudit is disabled")
+
+	container.ConfigurationFrom(dic.Get).Writable.DispatchAudit = true
+	recordDispatchAudit(dic, notificationModels.DispatchAuditRecord{NotificationId: exampleUUID, Event: notificationModels.DispatchAuditAccepted, CorrelationId: "correlation"})
+	recordDispatchAudit(dic, notificationModels.DispatchAuditRecord{NotificationId: exampleUUID, Event: notificationModels.DispatchAuditRouted, Matches: routingMatches(n, subs)})
+	recordDispatchAudit(dic, attemptAuditRecord(notificationModels.DispatchAuditAttempted, trans))
+
+	audit, err := NotificationDispatchAudit(exampleUUID, dic)
+	require.NoError(t, err)
+	assert.True(t, audit.Intact)
+	require.Len(t, audit.Records, 3)
+	for i, record := range audit.Records {
+		assert.Equal(t, i+1, record.Sequence)
+		assert.Equal(t, "correlation", record.CorrelationId, "the records should inherit the correlation id of the notification")
+	}
+	assert.Equal(t, []notificationModels.SubscriptionMatch{{SubscriptionName: sub.Name, Categories: []string{"health-check"}, Labels: []string{"floor-1", "hvac"}}}, audit.Records[1].Matches)
+	assert.Equal(t, "503 Service Unavailable", audit.Records[2].Response)
+	assert.Equal(t, testRestAddress.Host, audit.Records[2].Channel.Host)
+
+	(*stored)[1].Status = string(models.Sent)
+	audit, err = NotificationDispatchAudit(exampleUUID, dic)
+	require.NoError(t, err)
+	assert.False(t, audit.Intact, "the modified record should break the chain")
+	assert.Equal(t, 2, audit.BrokenAt)
+
+	(*stored)[1].Status = ""
+	*stored = append((*stored)[:1], (*stored)[2:]...)
+	audit, err = NotificationDispatchAudit(exampleUUID, dic)
+	require.NoError(t, err)
+	assert.False(t, audit.Intact, "the removed record should break the chain")
+	assert.Equal(t, 2, audit.BrokenAt)
+}
+
+func TestNotificationDispatchAudit_Invalid(t *testing.T) {
+	dic, _ := dispatchAuditTestDic(t)
+
+	_, err := NotificationDispatchAudit("invalidId", dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	_, err = NotificationDispatchAudit(exampleUUID, dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+}
@@ -6,7 +6,10 @@
 package application
 
 import (
+	"time"
+
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
@@ -14,29 +17,64 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
-// distribute distributes notification to associate subscriptions
-func distribute(dic *di.Container, n models.Notification) errors.EdgeX {
-	dbClient := container.DBClientFrom(dic.Get)
+// distributableSubscriptions returns the unlocked and unexpired subscriptions associated with the notification. The
+// expired subscriptions are recorded in the dispatch audit of the notification.
+func distributableSubscriptions(dic *di.Container, n models.Notification) ([]models.Subscription, errors.EdgeX) {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
-	var categories []string
-	if n.Category != "" {
-		categories = append(categories, n.Category)
-	}
-	subs, err := dbClient.SubscriptionsByCategoriesAndLabels(0, -1, categories, n.Labels)
+	subs, err := subscriptionIndexFrom(dic.Get).match(dic, n)
 	if err != nil {
 		lc.Errorf("fail to query subscriptions to distribute notification", err)
-		return errors.NewCommonEdgeXWrapper(err)
+		return nil, errors.NewCommonEdgeXWrapper(err)
 	}
 
+	var unlocked []models.Subscription
+	now := time.Now()
 	for _, sub := range subs {
 		if sub.AdminState == models.Locked {
 			lc.Debugf("subscription %s is locked, skip the notification transmission", sub.Name)
 			continue
 		}
+		if subscriptionExpired(sub, now) {
+			lc.Debugf("subscription %s is expired, skip the notification transmission", sub.Name)
+			recordDispatchAudit(dic, notificationModels.DispatchAuditRecord{NotificationId: n.Id, Event: notificationModels.DispatchAuditExpired, SubscriptionName: sub.Name})
+			continue
+		}
+		unlocked = append(unlocked, sub)
+	}
+	return unlocked, nil
+}
+
+// distribute distributes notification to associate subscriptions, except the channels of the resumed transmissions of
+// the notification, which are being resent. The notification exceeding the rate limit of its category is not
+// distributed and is recorded with the THROTTLED status.
+func distribute(dic *di.Container, n models.Notification, resumed []models.Transmission) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if categoryThrottled(dic, n) {
+		n.Status = NotificationStatusThrottled
+		if err := dbClient.UpdateNotification(n); err != nil {
+			lc.Errorf("fail to update notification status to throttled", err)
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		recordDispatchAudit(dic, notificationModels.DispatchAuditRecord{NotificationId: n.Id, Event: notificationModels.DispatchAuditThrottled})
+		return nil
+	}
+
+	subs, err := distributableSubscriptions(dic, n)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	recordDispatchAudit(dic, notificationModels.DispatchAuditRecord{NotificationId: n.Id, Event: notificationModels.DispatchAuditRouted, Matches: routingMatches(n, subs)})
+	for _, sub := range subs {
 		for _, address := range sub.Channels {
-			// Async transmit the notification to improve the performance
-			go transmit(dic, n, sub, address) // nolint:errcheck
+			if isResumedTransmission(resumed, sub, address) {
+				continue
+			}
+			// Async transmit the notification to improve the performance, the ordered subscriptions transmit in sequence
+			slot := transmissionSlot(dic, n, sub, address)
+			dispatchDrainerFrom(dic.Get).join(n, true, func() { slot.run(func() { transmit(dic, n, sub, address) }) }) // nolint:errcheck
 		}
 	}
 
@@ -49,26 +87,84 @@ func distribute(dic *di.Container, n models.Notification) errors.EdgeX {
 	return nil
 }
 
-// transmit transmits the notification with specified subscription and address
+// isResumedTransmission returns whether one of the resumed transmissions is of the subscription and the address
+func isResumedTransmission(resumed []models.Transmission, sub models.Subscription, address models.Address) bool {
+	for _, trans := range resumed {
+		if trans.SubscriptionName == sub.Name && circuitKey(trans.Channel) == circuitKey(address) {
+			return true
+		}
+	}
+	return false
+}
+
+// distributeWithoutPersistence sends the non-persisted notification once to each channel of the associated
+// subscriptions, the notification status and the transmissions are not stored, so the notification is never resent
+func distributeWithoutPersistence(dic *di.Container, n models.Notification) errors.EdgeX {
+	if categoryThrottled(dic, n) {
+		return nil
+	}
+	subs, err := distributableSubscriptions(dic, n)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, sub := range subs {
+		for _, address := range sub.Channels {
+			if opens, deferred := deliveryWindowOpening(dic, n, address); deferred {
+				deferWithoutPersistence(dic, n, sub, address, opens)
+				continue
+			}
+			slot := transmissionSlot(dic, n, sub, address)
+			dispatchDrainerFrom(dic.Get).join(n, false, func() {
+				slot.run(func() {
+					firstSend(dic, renderNotification(dic, n, sub), models.NewTransmission(sub.Name, address, n.Id))
+				})
+			})
+		}
+	}
+	return nil
+}
+
+// transmit transmits the notification with specified subscription and address. The transmission is deferred if the
+// delivery window of the channel is closed, see deferTransmission.
 func transmit(dic *di.Container, n models.Notification, sub models.Subscription, address models.Address) (models.Transmission, errors.EdgeX) {
+	trans := models.NewTransmission(sub.Name, address, n.Id)
+	if opens, deferred := deliveryWindowOpening(dic, n, address); deferred {
+		return deferTransmission(dic, n, trans, opens, "")
+	}
+	return sendTransmission(dic, n, sub, trans)
+}
+
+// sendTransmission sends the notification of the new or deferred transmission, and stores the transmission. The failed
+// transmission is resent or escalated according to the severity of the notification.
+func sendTransmission(dic *di.Container, n models.Notification, sub models.Subscription, trans models.Transmission) (models.Transmission, errors.EdgeX) {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	dbClient := container.DBClientFrom(dic.Get)
 
-	trans := models.NewTransmission(sub.Name, address, n.Id)
-	trans = firstSend(dic, n, trans)
-	trans, err := dbClient.AddTransmission(trans)
+	// The escalated notification keeps the original content, only the sent content is rendered with the template
+	rendered := renderNotification(dic, n, sub)
+	trans, result := firstSend(dic, rendered, trans)
+	var err errors.EdgeX
+	if trans.Id == "" {
+		trans, err = dbClient.AddTransmission(trans)
+	} else {
+		err = dbClient.UpdateTransmission(trans)
+	}
 	if err != nil {
 		lc.Error(err.Message())
 		return trans, errors.NewCommonEdgeXWrapper(err)
 	}
+	recordDispatchAudit(dic, attemptAuditRecord(notificationModels.DispatchAuditAttempted, trans))
 
 	if n.Status == models.Escalated {
 		// Do not resend if the notification status is Escalated
+		if trans.Status == models.Failed {
+			recordDeadLetter(dic, n, trans)
+		}
 		return trans, nil
 	}
 
-	// Resend the critical notification if the transmission is failed.
-	if n.Severity == models.Critical && trans.Status == models.Failed {
+	// Resend the notification at least as severe as critical if the transmission is failed.
+	if severityAtLeast(dic, n.Severity, models.Critical) && trans.Status == models.Failed {
 		// Change the transmission status to RESENDING which means this transmission process is resending the notification and should not be removed.
 		trans.Status = models.RESENDING
 		err = dbClient.UpdateTransmission(trans)
@@ -76,12 +172,16 @@ func transmit(dic *di.Container, n models.Notification, sub models.Subscription,
 			lc.Error(err.Message())
 			return trans, errors.NewCommonEdgeXWrapper(err)
 		}
-		trans, err = reSend(dic, n, sub, trans)
+		trans, err = reSend(dic, rendered, sub, trans, result)
 		if err != nil {
-			lc.Errorf("fail to handle the critical notification sending for the subscription %s with address %v, err: %v", sub.Name, address.GetBaseAddress(), err)
+			lc.Errorf("fail to handle the critical notification sending for the subscription %s with address %v, err: %v", sub.Name, trans.Channel.GetBaseAddress(), err)
 			return trans, errors.NewCommonEdgeXWrapper(err)
 		}
 	}
+	// Record the transmission failed permanently as a dead letter, which isn't resent or is escalated
+	if trans.Status == models.Failed || trans.Status == models.Escalated {
+		recordDeadLetter(dic, n, trans)
+	}
 	// Trigger a escalated notification if the transmission is Escalated
 	if trans.Status == models.Escalated {
 		err = escalatedSend(dic, n, trans)
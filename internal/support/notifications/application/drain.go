@@ -0,0 +1,191 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// dispatchDrainer tracks the in-flight notification dispatches, so they can be drained on shutdown
+type dispatchDrainer struct {
+	mutex    sync.Mutex
+	draining bool
+	wg       sync.WaitGroup
+	// pending holds the notifications with in-flight dispatches, keyed by the notification id
+	pending map[string]*pendingDispatch
+}
+
+type pendingDispatch struct {
+	notification models.Notification
+	// persisted indicates whether the notification is stored in the database
+	persisted bool
+	count     int
+}
+
+func newDispatchDrainer() *dispatchDrainer {
+	return &dispatchDrainer{pending: make(map[string]*pendingDispatch)}
+}
+
+// start runs the dispatch of a new notification in a goroutine, and returns false without running it once the drainer
+// is draining
+func (d *dispatchDrainer) start(n models.Notification, persisted bool, dispatch func()) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.draining {
+		return false
+	}
+	d.run(n, persisted, dispatch)
+	return true
+}
+
+// accepting returns whether the drainer accepts new dispatches
+func (d *dispatchDrainer) accepting() bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return !d.draining
+}
+
+// join runs a further dispatch of the notification already being dispatched, e.g. the transmission to one of the
+// subscription channels, in a goroutine. The dispatch is run even if the drainer is draining.
+func (d *dispatchDrainer) join(n models.Notification, persisted bool, dispatch func()) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.run(n, persisted, dispatch)
+}
+
+// run must be called with the mutex held
+func (d *dispatchDrainer) run(n models.Notification, persisted bool, dispatch func()) {
+	p, ok := d.pending[n.Id]
+	if !ok {
+		p = &pendingDispatch{notification: n, persisted: persisted}
+		d.pending[n.Id] = p
+	}
+	p.count++
+	d.wg.Add(1)
+	go func() {
+		defer d.done(n.Id)
+		dispatch()
+	}()
+}
+
+func (d *dispatchDrainer) done(id string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if p, ok := d.pending[id]; ok {
+		p.count--
+		if p.count <= 0 {
+			delete(d.pending, id)
+		}
+	}
+	d.wg.Done()
+}
+
+// drain stops accepting new dispatches and waits for the in-flight dispatches to finish within the timeout. The
+// notifications whose dispatches are still in flight when the timeout expires are returned.
+func (d *dispatchDrainer) drain(timeout time.Duration) []pendingDispatch {
+	d.mutex.Lock()
+	d.draining = true
+	d.mutex.Unlock()
+
+	finished := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(finished)
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-finished:
+		return nil
+	case <-timer.C:
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	pending := make([]pendingDispatch, 0, len(d.pending))
+	for _, p := range d.pending {
+		pending = append(pending, *p)
+	}
+	return pending
+}
+
+// DrainNotificationDispatch stops accepting new notifications and waits for the in-flight notification dispatches to
+// finish within the configured Writable.DrainTimeout. The notifications still being dispatched when the timeout expires
+// are stored with the NEW status, so they are distributed again by RedistributePendingNotifications on the next start.
+func DrainNotificationDispatch(dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+
+	timeout, err := time.ParseDuration(config.Writable.DrainTimeout)
+	if err != nil {
+		lc.Errorf("Failed to parse notification drain timeout %s, persisting the in-flight notifications without waiting, %v", config.Writable.DrainTimeout, err)
+		timeout = 0
+	}
+
+	lc.Infof("Draining the notification dispatch within %s", timeout)
+	pending := dispatchDrainerFrom(dic.Get).drain(timeout)
+	if len(pending) == 0 {
+		lc.Info("Notification dispatch drained")
+		return
+	}
+	lc.Warnf("%d notifications are still being dispatched after the drain timeout, persisting them for retrying on restart", len(pending))
+	persistPendingDispatches(pending, dic)
+}
+
+func persistPendingDispatches(pending []pendingDispatch, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+
+	for _, p := range pending {
+		n := p.notification
+		n.Status = models.New
+		var err errors.EdgeX
+		if p.persisted {
+			err = dbClient.UpdateNotification(n)
+		} else {
+			_, err = dbClient.AddNotification(n)
+		}
+		if err != nil {
+			lc.Errorf("Failed to persist the undispatched notification %s, %v", n.Id, err)
+		}
+	}
+}
+
+// RedistributePendingNotifications resumes the resends of the transmissions left with the RESENDING status and the
+// transmissions deferred to a delivery window, and distributes the notifications left with the NEW status, e.g. the
+// notifications persisted by DrainNotificationDispatch on the last shutdown, to the associated subscriptions again,
+// except the channels being resent or deferred.
+func RedistributePendingNotifications(dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+
+	resumed, err := resumeResendingTransmissions(dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	notifications, err := dbClient.NotificationsByStatus(0, -1, "", models.New)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, n := range notifications {
+		slot := routingSlot(dic)
+		if !dispatchDrainerFrom(dic.Get).start(n, true, func() { slot.run(func() { distribute(dic, n, resumed[n.Id]) }) }) { // nolint:errcheck
+			slot.release()
+		}
+	}
+	if len(notifications) > 0 {
+		lc.Infof("Redistributing %d pending notifications", len(notifications))
+	}
+	return nil
+}
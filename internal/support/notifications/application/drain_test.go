@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+func TestDispatchDrainer_Drained(t *testing.T) {
+	drainer := newDispatchDrainer()
+	n := models.Notification{Id: "notification1", Status: models.New}
+	sent := make(chan struct{}, 2)
+
+	started := drainer.start(n, true, func() {
+		drainer.join(n, true, func() {
+			time.Sleep(10 * time.Millisecond)
+			sent <- struct{}{}
+		})
+		sent <- struct{}{}
+	})
+	require.True(t, started)
+
+	pending := drainer.drain(time.Second)
+	assert.Empty(t, pending)
+	assert.Len(t, sent, 2)
+	assert.False(t, drainer.accepting())
+	assert.False(t, drainer.start(n, true, func() {}), "new dispatches should be rejected once draining")
+}
+
+func TestDispatchDrainer_Timeout(t *testing.T) {
+	drainer := newDispatchDrainer()
+	blocked := models.Notification{Id: "blocked", Status: models.New}
+	finished := models.Notification{Id: "finished", Status: models.New}
+	release := make(chan struct{})
+	defer close(release)
+
+	require.True(t, drainer.start(blocked, false, func() { <-release }))
+	require.True(t, drainer.start(finished, true, func() {}))
+
+	pending := drainer.drain(50 * time.Millisecond)
+	require.Len(t, pending, 1)
+	assert.Equal(t, blocked.Id, pending[0].notification.Id)
+	assert.False(t, pending[0].persisted)
+}
+
+func TestPersistPendingDispatches(t *testing.T) {
+	persisted := models.Notification{Id: "persisted", Status: models.Processed}
+	notPersisted := models.Notification{Id: "notPersisted"}
+	expectedPersisted := persisted
+	expectedPersisted.Status = models.New
+	expectedNotPersisted := notPersisted
+	expectedNotPersisted.Status = models.New
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("UpdateNotification", expectedPersisted).Return(nil)
+	dbClientMock.On("AddNotification", expectedNotPersisted).Return(expectedNotPersisted, nil)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+
+	persistPendingDispatches([]pendingDispatch{
+		{notification: persisted, persisted: true},
+		{notification: notPersisted, persisted: false},
+	}, dic)
+
+	dbClientMock.AssertExpectations(t)
+	dbClientMock.AssertNotCalled(t, "AddNotification", expectedPersisted)
+	dbClientMock.AssertNotCalled(t, "UpdateNotification", mock.MatchedBy(func(n models.Notification) bool { return n.Id == notPersisted.Id }))
+}
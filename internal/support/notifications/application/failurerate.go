@@ -0,0 +1,170 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	gometrics "github.com/rcrowley/go-metrics"
+)
+
+const subscriptionFailureRateMetricName = "SubscriptionFailureRate"
+
+// FailureRateAlertLabel labels the failure rate alert notifications, whose sends are not monitored
+const FailureRateAlertLabel = "failure-rate-alert"
+
+// maxSendOutcomes bounds the send outcomes kept per subscription, the oldest outcomes are dropped beyond it
+const maxSendOutcomes = 10000
+
+type sendOutcome struct {
+	sent   time.Time
+	failed bool
+}
+
+// subscriptionFailures is the sliding window of the send outcomes of a subscription
+type subscriptionFailures struct {
+	outcomes []sendOutcome
+	failures int
+	// alerting indicates the alert is raised and the failure rate hasn't dropped back to the threshold since then
+	alerting bool
+	gauge    gometrics.GaugeFloat64
+}
+
+// failureRateMonitor monitors the failure rate of the sends of each subscription
+type failureRateMonitor struct {
+	mutex         sync.Mutex
+	subscriptions map[string]*subscriptionFailures
+}
+
+func newFailureRateMonitor() *failureRateMonitor {
+	return &failureRateMonitor{subscriptions: make(map[string]*subscriptionFailures)}
+}
+
+// record adds the send outcome to the window of the subscription, and returns the failure rate over the window and
+// whether the alert is to be raised, which is only once until the rate drops back to the threshold. The gauge of the
+// subscription is registered with the register function on its first send.
+func (m *failureRateMonitor) record(subscriptionName string, failed bool, now time.Time, alert config.FailureAlertInfo, window time.Duration, register func(gometrics.GaugeFloat64)) (float64, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	s, ok := m.subscriptions[subscriptionName]
+	if !ok {
+		s = &subscriptionFailures{gauge: gometrics.NewGaugeFloat64()}
+		m.subscriptions[subscriptionName] = s
+		register(s.gauge)
+	}
+
+	s.outcomes = append(s.outcomes, sendOutcome{sent: now, failed: failed})
+	if failed {
+		s.failures++
+	}
+	expired := 0
+	for expired < len(s.outcomes) && (len(s.outcomes)-expired > maxSendOutcomes || now.Sub(s.outcomes[expired].sent) > window) {
+		if s.outcomes[expired].failed {
+			s.failures--
+		}
+		expired++
+	}
+	s.outcomes = slices.Delete(s.outcomes, 0, expired)
+
+	rate := float64(s.failures) / float64(len(s.outcomes))
+	s.gauge.Update(rate)
+	if len(s.outcomes) < max(alert.MinSends, 1) {
+		return rate, false
+	}
+	if rate <= alert.Threshold {
+		s.alerting = false
+		return rate, false
+	}
+	if s.alerting {
+		return rate, false
+	}
+	s.alerting = true
+	return rate, true
+}
+
+// monitorSendOutcome records the outcome of the send of the notification to the subscription when the FailureAlert is
+// enabled, and sends the alert to the ops subscription once the failure rate of the subscription exceeds the threshold.
+// The sends to the ops subscription and of the alerts are not monitored, so the alerts never raise further alerts.
+func monitorSendOutcome(dic *di.Container, n models.Notification, subscriptionName string, result sendResult) {
+	alert := container.ConfigurationFrom(dic.Get).Writable.FailureAlert
+	if alert.Threshold <= 0 || subscriptionName == alert.Subscription || slices.Contains(n.Labels, FailureRateAlertLabel) {
+		return
+	}
+	window, err := time.ParseDuration(alert.Window)
+	if err != nil || window <= 0 {
+		return
+	}
+
+	rate, raise := failureRateMonitorFrom(dic.Get).record(subscriptionName, result.record.Status == models.Failed, time.Now(), alert, window,
+		func(gauge gometrics.GaugeFloat64) {
+			registerSubscriptionFailureRateMetric(dic, subscriptionName, gauge)
+		})
+	if raise {
+		sendFailureRateAlert(dic, subscriptionName, rate, alert)
+	}
+}
+
+// sendFailureRateAlert adds the alert notification of the failure rate of the subscription and transmits it to the
+// channels of the ops subscription
+func sendFailureRateAlert(dic *di.Container, subscriptionName string, rate float64, alert config.FailureAlertInfo) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	lc.Warnf("the failure rate %.2f of the subscription %s exceeds the threshold %.2f", rate, subscriptionName, alert.Threshold)
+	ops, err := dbClient.SubscriptionByName(alert.Subscription)
+	if err != nil {
+		lc.Errorf("fail to query the ops subscription %s, skip the failure rate alert of the subscription %s: %v", alert.Subscription, subscriptionName, err)
+		return
+	}
+
+	severity := models.NotificationSeverity(alert.Severity)
+	if severity == "" {
+		severity = models.Critical
+	}
+	n := models.Notification{
+		Category: alert.Category,
+		Content: fmt.Sprintf("The failure rate of the notifications sent to the subscription %s is %.0f%% over the last %s, which exceeds the threshold %.0f%%",
+			subscriptionName, rate*100, alert.Window, alert.Threshold*100),
+		ContentType: common.ContentTypeText,
+		Labels:      []string{FailureRateAlertLabel},
+		Sender:      common.SupportNotificationsServiceKey,
+		Severity:    severity,
+		Status:      models.Processed,
+	}
+	n, err = dbClient.AddNotification(n)
+	if err != nil {
+		lc.Errorf("fail to create the failure rate alert of the subscription %s: %v", subscriptionName, err)
+		return
+	}
+	for _, address := range ops.Channels {
+		dispatchDrainerFrom(dic.Get).join(n, true, func() { transmit(dic, n, ops, address) }) // nolint:errcheck
+	}
+}
+
+func registerSubscriptionFailureRateMetric(dic *di.Container, subscriptionName string, gauge gometrics.GaugeFloat64) {
+	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
+	if metricsManager == nil {
+		return
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	name := subscriptionFailureRateMetricName + subscriptionName
+	if err := metricsManager.Register(name, gauge, map[string]string{"subscription": subscriptionName}); err != nil {
+		lc.Errorf("%s metrics will not be collected: %s", name, err.Error())
+		return
+	}
+	lc.Debugf("Registered metrics gauge %s", name)
+}
@@ -0,0 +1,121 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	gometrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailureRateMonitor(t *testing.T) {
+	monitor := newFailureRateMonitor()
+	alert := config.FailureAlertInfo{Threshold: 0.5, MinSends: 4}
+	window := time.Minute
+	now := time.Now()
+	var gauge gometrics.GaugeFloat64
+	record := func(failed bool, at time.Time) (float64, bool) {
+		return monitor.record(sub.Name, failed, at, alert, window, func(g gometrics.GaugeFloat64) { gauge = g })
+	}
+
+	for _, failed := range []bool{true, true, true} {
+		_, raise := record(failed, now)
+		assert.False(t, raise, "the rate should not be evaluated under the MinSends")
+	}
+	rate, raise := record(false, now)
+	assert.True(t, raise)
+	assert.Equal(t, 0.75, rate)
+	require.NotNil(t, gauge)
+	assert.Equal(t, 0.75, gauge.Value())
+
+	_, raise = record(true, now)
+	assert.False(t, raise, "the alert should be raised only once while the rate exceeds the threshold")
+
+	// the failures expire from the window, and the rate drops back to the threshold
+	later := now.Add(2 * window)
+	for range 4 {
+		_, raise = record(false, later)
+		assert.False(t, raise)
+	}
+	assert.Equal(t, float64(0), gauge.Value())
+	for range 4 {
+		_, raise = record(true, later)
+		assert.False(t, raise, "the rate reaching the threshold should not raise the alert")
+	}
+	rate, raise = record(true, later)
+	assert.True(t, raise, "the alert should be raised again after the rate dropped back to the threshold")
+	assert.InDelta(t, 5.0/9, rate, 0.001)
+}
+
+func TestMonitorSendOutcome(t *testing.T) {
+	ops := models.Subscription{Name: "ops"}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionByName", ops.Name).Return(ops, nil)
+	dbClientMock.On("AddNotification", mock.Anything).Return(models.Notification{Id: exampleUUID}, nil)
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	container.ConfigurationFrom(dic.Get).Writable.FailureAlert = config.FailureAlertInfo{
+		Threshold: 0.5, Window: "1m", MinSends: 1, Subscription: ops.Name, Category: "delivery-failure",
+	}
+	failed := sendResult{record: models.TransmissionRecord{Status: models.Failed}}
+
+	monitorSendOutcome(dic, notification, ops.Name, failed)
+	alertNotification := notification
+	alertNotification.Labels = []string{FailureRateAlertLabel}
+	monitorSendOutcome(dic, alertNotification, sub.Name, failed)
+	dbClientMock.AssertNotCalled(t, "AddNotification", mock.Anything)
+
+	monitorSendOutcome(dic, notification, sub.Name, failed)
+	monitorSendOutcome(dic, notification, sub.Name, failed)
+	dbClientMock.AssertNumberOfCalls(t, "AddNotification", 1)
+	alert := dbClientMock.Calls[len(dbClientMock.Calls)-1].Arguments.Get(0).(models.Notification)
+	assert.Equal(t, "delivery-failure", alert.Category)
+	assert.Equal(t, models.NotificationSeverity(models.Critical), alert.Severity)
+	assert.Contains(t, alert.Labels, FailureRateAlertLabel)
+	assert.Contains(t, alert.Content, sub.Name)
+}
+
+func TestValidateFailureAlert(t *testing.T) {
+	valid := config.FailureAlertInfo{Threshold: 0.5, Window: "10m", MinSends: 10, Subscription: "ops"}
+	tests := []struct {
+		name          string
+		alert         func(a config.FailureAlertInfo) config.FailureAlertInfo
+		errorExpected bool
+	}{
+		{"valid", func(a config.FailureAlertInfo) config.FailureAlertInfo { return a }, false},
+		{"disabled", func(a config.FailureAlertInfo) config.FailureAlertInfo { return config.FailureAlertInfo{} }, false},
+		{"threshold above 1", func(a config.FailureAlertInfo) config.FailureAlertInfo { a.Threshold = 1.5; return a }, true},
+		{"invalid window", func(a config.FailureAlertInfo) config.FailureAlertInfo { a.Window = "10"; return a }, true},
+		{"no subscription", func(a config.FailureAlertInfo) config.FailureAlertInfo { a.Subscription = ""; return a }, true},
+		{"unknown severity", func(a config.FailureAlertInfo) config.FailureAlertInfo { a.Severity = "URGENT"; return a }, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			writable := config.WritableInfo{FailureAlert: testCase.alert(valid)}
+			err := writable.ValidateFailureAlert()
+			if testCase.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
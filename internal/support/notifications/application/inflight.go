@@ -0,0 +1,110 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	gometrics "github.com/rcrowley/go-metrics"
+)
+
+const notificationsInFlightMetricName = "NotificationsInFlight"
+
+var channelTypes = []string{common.REST, common.EMAIL, common.MQTT, common.ZeroMQ}
+
+// inFlightLimiter limits the concurrent sends of each channel type
+type inFlightLimiter struct {
+	mutex    sync.Mutex
+	inFlight map[string]int
+	// released is closed and replaced whenever a send is released, to wake up the waiting sends
+	released chan struct{}
+	gauges   map[string]gometrics.Gauge
+}
+
+func newInFlightLimiter() *inFlightLimiter {
+	gauges := make(map[string]gometrics.Gauge, len(channelTypes))
+	for _, channelType := range channelTypes {
+		gauges[channelType] = gometrics.NewGauge()
+	}
+	return &inFlightLimiter{inFlight: make(map[string]int), released: make(chan struct{}), gauges: gauges}
+}
+
+// acquire waits until the in-flight sends of the channel type are under the limit, and returns an error if the limit is
+// still reached after the wait timeout. The caller must call release after the send once acquire succeeds.
+func (l *inFlightLimiter) acquire(channelType string, limit config.ChannelLimit) errors.EdgeX {
+	var timer *time.Timer
+	for {
+		l.mutex.Lock()
+		if limit.MaxInFlight <= 0 || l.inFlight[channelType] < limit.MaxInFlight {
+			l.inFlight[channelType]++
+			l.updateGauge(channelType)
+			l.mutex.Unlock()
+			if timer != nil {
+				timer.Stop()
+			}
+			return nil
+		}
+		released := l.released
+		l.mutex.Unlock()
+
+		if timer == nil {
+			timeout, err := time.ParseDuration(limit.InFlightWaitTimeout)
+			if err != nil || timeout <= 0 {
+				// no valid timeout to wait, fail the send immediately
+				return errors.NewCommonEdgeX(errors.KindServiceUnavailable, "the maximum in-flight sends of the channel "+channelType+" is reached", nil)
+			}
+			timer = time.NewTimer(timeout)
+		}
+		select {
+		case <-released:
+		case <-timer.C:
+			return errors.NewCommonEdgeX(errors.KindServiceUnavailable, "timed out waiting for the in-flight sends of the channel "+channelType, nil)
+		}
+	}
+}
+
+func (l *inFlightLimiter) release(channelType string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.inFlight[channelType]--
+	l.updateGauge(channelType)
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+// updateGauge must be called with the mutex held
+func (l *inFlightLimiter) updateGauge(channelType string) {
+	if gauge, ok := l.gauges[channelType]; ok {
+		gauge.Update(int64(l.inFlight[channelType]))
+	}
+}
+
+// registerInFlightMetrics registers the in-flight gauge of each channel type with the metrics manager
+func registerInFlightMetrics(dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
+	if metricsManager == nil {
+		lc.Error("Metric Manager not available. In-flight metrics will not be collected.")
+		return
+	}
+	limiter := inFlightLimiterFrom(dic.Get)
+	for _, channelType := range channelTypes {
+		name := notificationsInFlightMetricName + channelType
+		if err := metricsManager.Register(name, limiter.gauges[channelType], map[string]string{"channel": channelType}); err != nil {
+			lc.Errorf("%s metrics will not be collected: %s", name, err.Error())
+			continue
+		}
+		lc.Infof("Registered metrics gauge %s", name)
+	}
+}
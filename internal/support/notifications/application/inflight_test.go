@@ -0,0 +1,51 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+func TestInFlightLimiter(t *testing.T) {
+	limiter := newInFlightLimiter()
+	limit := config.ChannelLimit{MaxInFlight: 1, InFlightWaitTimeout: "50ms"}
+
+	require.NoError(t, limiter.acquire(common.REST, limit))
+	assert.Equal(t, int64(1), limiter.gauges[common.REST].Value())
+	require.NoError(t, limiter.acquire(common.EMAIL, limit), "the limit should be applied per channel type")
+
+	err := limiter.acquire(common.REST, limit)
+	require.Error(t, err, "the send should time out when the limit is reached")
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+
+	err = limiter.acquire(common.REST, config.ChannelLimit{MaxInFlight: 1})
+	require.Error(t, err, "the send should fail immediately without the wait timeout")
+
+	acquired := make(chan errors.EdgeX)
+	go func() {
+		acquired <- limiter.acquire(common.REST, config.ChannelLimit{MaxInFlight: 1, InFlightWaitTimeout: "5s"})
+	}()
+	time.Sleep(10 * time.Millisecond)
+	limiter.release(common.REST)
+	select {
+	case err = <-acquired:
+		require.NoError(t, err, "the waiting send should proceed once a send is released")
+	case <-time.After(time.Second):
+		require.Fail(t, "the waiting send is not woken up")
+	}
+	assert.Equal(t, int64(1), limiter.gauges[common.REST].Value())
+
+	require.NoError(t, limiter.acquire(common.REST, config.ChannelLimit{}), "zero MaxInFlight means unlimited")
+	assert.Equal(t, int64(2), limiter.gauges[common.REST].Value())
+}
@@ -0,0 +1,88 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"strings"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	gometrics "github.com/rcrowley/go-metrics"
+)
+
+const notificationDeliveryLatencyMetricName = "NotificationDeliveryLatency"
+
+// deliveryLatencyHistograms holds the histogram of the delivery latency in milliseconds of each channel type. The
+// exponentially decaying sample biases the percentiles towards the recent deliveries.
+var deliveryLatencyHistograms = newDeliveryLatencyHistograms()
+
+func newDeliveryLatencyHistograms() map[string]gometrics.Histogram {
+	histograms := make(map[string]gometrics.Histogram, len(channelTypes))
+	for _, channelType := range channelTypes {
+		histograms[channelType] = gometrics.NewHistogram(gometrics.NewExpDecaySample(1028, 0.015))
+	}
+	return histograms
+}
+
+// recordDeliveryLatency records the delivery latency of the transmission which reaches the SENT status by the record.
+// The latency is measured from the creation of the notification rather than the send attempt, so the queueing and the
+// resend delays are included. The non-persisted notifications are counted as well, since the dispatch sets their
+// creation time, and only a notification without the creation time is skipped.
+func recordDeliveryLatency(n models.Notification, trans models.Transmission, record models.TransmissionRecord) {
+	if trans.Status != models.Sent || n.Created <= 0 || record.Sent < n.Created {
+		return
+	}
+	if histogram, ok := deliveryLatencyHistograms[trans.Channel.GetBaseAddress().Type]; ok {
+		histogram.Update(record.Sent - n.Created)
+	}
+}
+
+// transmissionLatency returns the time in milliseconds from the creation of the notification to the first successful
+// send of the transmission, or 0 if the transmission is not delivered yet or the notification has no creation time
+func transmissionLatency(created int64, trans models.Transmission) int64 {
+	if created <= 0 {
+		return 0
+	}
+	for _, record := range trans.Records {
+		if isDelivered(record.Status) && record.Sent >= created {
+			return record.Sent - created
+		}
+	}
+	return 0
+}
+
+// sendAttempts returns the number of the send attempts of the transmission, and the time in milliseconds of the last
+// one. The record of the dropped recipients is not a send attempt.
+func sendAttempts(trans models.Transmission) (attempts int, last int64) {
+	for _, record := range trans.Records {
+		if strings.HasPrefix(record.Response, DroppedRecipientsResponsePrefix) {
+			continue
+		}
+		attempts++
+		last = max(last, record.Sent)
+	}
+	return attempts, last
+}
+
+// registerDeliveryLatencyMetrics registers the delivery latency histogram of each channel type with the metrics manager
+func registerDeliveryLatencyMetrics(dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
+	if metricsManager == nil {
+		lc.Error("Metric Manager not available. Delivery latency metrics will not be collected.")
+		return
+	}
+	for _, channelType := range channelTypes {
+		name := notificationDeliveryLatencyMetricName + channelType
+		if err := metricsManager.Register(name, deliveryLatencyHistograms[channelType], map[string]string{"channel": channelType}); err != nil {
+			lc.Errorf("%s metrics will not be collected: %s", name, err.Error())
+			continue
+		}
+		lc.Infof("Registered metrics histogram %s", name)
+	}
+}
@@ -0,0 +1,39 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordDeliveryLatency(t *testing.T) {
+	deliveryLatencyHistograms = newDeliveryLatencyHistograms()
+	restTrans := models.Transmission{Status: models.Sent, Channel: models.RESTAddress{BaseAddress: models.BaseAddress{Type: common.REST}}}
+	emailTrans := models.Transmission{Status: models.Sent, Channel: models.EmailAddress{BaseAddress: models.BaseAddress{Type: common.EMAIL}}}
+	n := models.Notification{DBTimestamp: models.DBTimestamp{Created: 1000}}
+
+	recordDeliveryLatency(n, restTrans, models.TransmissionRecord{Status: models.Sent, Sent: 1250})
+	recordDeliveryLatency(n, restTrans, models.TransmissionRecord{Status: models.Sent, Sent: 1750})
+	recordDeliveryLatency(n, emailTrans, models.TransmissionRecord{Status: models.Sent, Sent: 3000})
+	// the failed transmissions and the notifications without the creation time are not recorded
+	failed := restTrans
+	failed.Status = models.Failed
+	recordDeliveryLatency(n, failed, models.TransmissionRecord{Status: models.Failed, Sent: 1100})
+	recordDeliveryLatency(models.Notification{}, restTrans, models.TransmissionRecord{Status: models.Sent, Sent: 1100})
+
+	rest := deliveryLatencyHistograms[common.REST]
+	assert.Equal(t, int64(2), rest.Count())
+	assert.Equal(t, int64(250), rest.Min())
+	assert.Equal(t, int64(750), rest.Max())
+	assert.Equal(t, 500.0, rest.Mean())
+	assert.Equal(t, int64(2000), deliveryLatencyHistograms[common.EMAIL].Max())
+	assert.Zero(t, deliveryLatencyHistograms[common.MQTT].Count())
+}
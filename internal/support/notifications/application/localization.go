@@ -0,0 +1,125 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// LocalizedContentType is the content type of the notification whose content is a LocalizedContent in JSON. The
+// content in the preferred language of each subscription is sent instead of the JSON.
+const LocalizedContentType = "application/vnd.edgex.localized+json"
+
+// LanguageLabelPrefix is the prefix of the subscription label declaring the preferred language of the subscription,
+// e.g. "language:de". The subscriptions without the label receive the default language of the localized content.
+const LanguageLabelPrefix = "language:"
+
+// LocalizedContent is the content of the notification in multiple languages
+type LocalizedContent struct {
+	// DefaultLanguage is the language sent to the subscriptions whose preferred language is not in the Content, which
+	// must be in the Content
+	DefaultLanguage string `json:"defaultLanguage"`
+	// ContentType is the content type of the content in each language, "text/plain" if empty
+	ContentType string `json:"contentType,omitempty"`
+	// Content maps the language codes, e.g. "en" or "pt-BR", to the content in the language
+	Content map[string]string `json:"content"`
+}
+
+// languageCodePattern matches the BCP 47 style language codes, e.g. "en", "pt-BR" or "zh-Hant-TW"
+var languageCodePattern = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+func validateLanguageCode(code string) errors.EdgeX {
+	if !languageCodePattern.MatchString(code) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid language code '%s'", code), nil)
+	}
+	return nil
+}
+
+// parseLocalizedContent returns the localized content of the notification, and whether the notification is localized
+func parseLocalizedContent(n models.Notification) (LocalizedContent, bool, errors.EdgeX) {
+	var localized LocalizedContent
+	if n.ContentType != LocalizedContentType {
+		return localized, false, nil
+	}
+	if err := json.Unmarshal([]byte(n.Content), &localized); err != nil {
+		return localized, true, errors.NewCommonEdgeX(errors.KindContractInvalid, "the localized notification content is not valid JSON", err)
+	}
+	return localized, true, nil
+}
+
+// validateLocalizedContent validates the language codes of the localized notification content, and that the default
+// language is present
+func validateLocalizedContent(n models.Notification) errors.EdgeX {
+	localized, ok, err := parseLocalizedContent(n)
+	if !ok || err != nil {
+		return err
+	}
+	if len(localized.Content) == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the localized notification content has no language", nil)
+	}
+	for language := range localized.Content {
+		if err = validateLanguageCode(language); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid localized notification content", err)
+		}
+	}
+	if _, ok = localized.Content[localized.DefaultLanguage]; !ok {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the default language '%s' is missing in the localized notification content", localized.DefaultLanguage), nil)
+	}
+	return nil
+}
+
+// validateSubscriptionLanguage validates the preferred language of the subscription
+func validateSubscriptionLanguage(sub models.Subscription) errors.EdgeX {
+	language := subscriptionLabelValue(sub, LanguageLabelPrefix)
+	if language == "" {
+		return nil
+	}
+	if err := validateLanguageCode(language); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("subscription %s has an invalid preferred language", sub.Name), err)
+	}
+	return nil
+}
+
+// localizeNotification replaces the localized content of the notification with the content in the preferred language
+// of the subscription, falling back to the base language, e.g. "pt" for "pt-BR", and then to the default language.
+// The notification is returned unchanged if it is not localized.
+func localizeNotification(n models.Notification, sub models.Subscription) models.Notification {
+	localized, ok, err := parseLocalizedContent(n)
+	if !ok || err != nil {
+		return n
+	}
+	n.Content = localized.Content[localized.DefaultLanguage]
+	if preferred := subscriptionLabelValue(sub, LanguageLabelPrefix); preferred != "" {
+		base, _, _ := strings.Cut(preferred, "-")
+		if content, ok := localizedContentOf(localized, preferred); ok {
+			n.Content = content
+		} else if content, ok = localizedContentOf(localized, base); ok {
+			n.Content = content
+		}
+	}
+	n.ContentType = localized.ContentType
+	if n.ContentType == "" {
+		n.ContentType = common.ContentTypeText
+	}
+	return n
+}
+
+// localizedContentOf returns the content in the language, the language codes are case-insensitive
+func localizedContentOf(localized LocalizedContent, language string) (string, bool) {
+	for code, content := range localized.Content {
+		if strings.EqualFold(code, language) {
+			return content, true
+		}
+	}
+	return "", false
+}
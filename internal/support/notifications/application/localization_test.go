@@ -0,0 +1,94 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func localizedNotification(t *testing.T, localized LocalizedContent) models.Notification {
+	content, err := json.Marshal(localized)
+	require.NoError(t, err)
+	return models.Notification{Category: "health-check", Content: string(content), ContentType: LocalizedContentType, Severity: models.Normal}
+}
+
+func TestValidateLocalizedContent(t *testing.T) {
+	tests := []struct {
+		name          string
+		notification  models.Notification
+		expectedError bool
+	}{
+		{"valid - not localized", models.Notification{Content: "{", ContentType: common.ContentTypeJSON}, false},
+		{"valid - localized", localizedNotification(t, LocalizedContent{DefaultLanguage: "en", Content: map[string]string{"en": "hot", "pt-BR": "quente"}}), false},
+		{"invalid - not JSON", models.Notification{Content: "hot", ContentType: LocalizedContentType}, true},
+		{"invalid - no language", localizedNotification(t, LocalizedContent{DefaultLanguage: "en"}), true},
+		{"invalid - missing default language", localizedNotification(t, LocalizedContent{DefaultLanguage: "de", Content: map[string]string{"en": "hot"}}), true},
+		{"invalid - empty default language", localizedNotification(t, LocalizedContent{Content: map[string]string{"en": "hot"}}), true},
+		{"invalid - language code", localizedNotification(t, LocalizedContent{DefaultLanguage: "en", Content: map[string]string{"en": "hot", "english_us": "hot"}}), true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validateLocalizedContent(testCase.notification)
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateSubscriptionLanguage(t *testing.T) {
+	assert.NoError(t, validateSubscriptionLanguage(models.Subscription{Name: "noLanguage"}))
+	assert.NoError(t, validateSubscriptionLanguage(models.Subscription{Name: "german", Labels: []string{LanguageLabelPrefix + "de-AT"}}))
+	err := validateSubscriptionLanguage(models.Subscription{Name: "invalid", Labels: []string{LanguageLabelPrefix + "german!"}})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestRenderNotification_Localized(t *testing.T) {
+	n := localizedNotification(t, LocalizedContent{
+		DefaultLanguage: "en",
+		ContentType:     common.ContentTypeText,
+		Content:         map[string]string{"en": "too hot", "de": "zu heiß", "pt-BR": "muito quente"},
+	})
+	dic := mockDic()
+
+	tests := []struct {
+		name            string
+		language        string
+		expectedContent string
+	}{
+		{"no preferred language", "", "too hot"},
+		{"preferred language", "de", "zu heiß"},
+		{"preferred language is case-insensitive", "PT-br", "muito quente"},
+		{"base language of the preferred language", "de-AT", "zu heiß"},
+		{"default language", "fr", "too hot"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			subscription := models.Subscription{Name: "operators"}
+			if testCase.language != "" {
+				subscription.Labels = []string{LanguageLabelPrefix + testCase.language}
+			}
+			rendered := renderNotification(dic, n, subscription)
+			assert.Equal(t, testCase.expectedContent, rendered.Content)
+			assert.Equal(t, common.ContentTypeText, rendered.ContentType)
+		})
+	}
+
+	plain := models.Notification{Content: "too hot", ContentType: common.ContentTypeText}
+	assert.Equal(t, plain, renderNotification(dic, plain, models.Subscription{Labels: []string{LanguageLabelPrefix + "de"}}))
+}
@@ -8,12 +8,18 @@ package application
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
@@ -23,16 +29,50 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	"github.com/google/uuid"
+	gometrics "github.com/rcrowley/go-metrics"
 )
 
 var asyncPurgeNotificationOnce sync.Once
 
+const notificationsNotPersistedMetricName = "NotificationsNotPersisted"
+
+// notificationsNotPersistedCounter counts the notifications dispatched without being persisted
+var notificationsNotPersistedCounter = gometrics.NewCounter()
+
+// RegisterMetrics registers the notification metrics with the metrics manager
+func RegisterMetrics(dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
+	if metricsManager == nil {
+		lc.Error("Metric Manager not available. Notification metrics will not be collected.")
+		return
+	}
+
+	if err := metricsManager.Register(notificationsNotPersistedMetricName, notificationsNotPersistedCounter, nil); err != nil {
+		lc.Errorf("%s metrics will not be collected: %s", notificationsNotPersistedMetricName, err.Error())
+	} else {
+		lc.Infof("Registered metrics counter %s", notificationsNotPersistedMetricName)
+	}
+
+	registerInFlightMetrics(dic)
+	registerCircuitBreakerMetrics(dic)
+	registerDeliveryLatencyMetrics(dic)
+	registerThrottleMetrics(dic)
+}
+
 // The AddNotification function accepts the new Notification model from the controller function
 // and then invokes AddNotification function of infrastructure layer to add new Notification
 func AddNotification(n models.Notification, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
+	if !dispatchDrainerFrom(dic.Get).accepting() {
+		return "", errors.NewCommonEdgeX(errors.KindServiceUnavailable, "notification service is shutting down, the notification is not accepted", nil)
+	}
+	if edgeXerr = validateNotificationContent(&n, dic); edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
 	addedNotification, edgeXerr := dbClient.AddNotification(n)
 	if edgeXerr != nil {
 		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
@@ -41,12 +81,58 @@ func AddNotification(n models.Notification, ctx context.Context, dic *di.Contain
 	lc.Debugf("Notification created on DB successfully. Notification ID: %s, Correlation-ID: %s ",
 		addedNotification.Id,
 		correlation.FromContext(ctx))
+	recordDispatchAudit(dic, notificationModels.DispatchAuditRecord{NotificationId: addedNotification.Id, Event: notificationModels.DispatchAuditAccepted, CorrelationId: correlation.FromContext(ctx)})
 
-	go distribute(dic, addedNotification) // nolint:errcheck
+	// The notification stays with the NEW status and is distributed on the next start if the service is draining
+	slot := routingSlot(dic)
+	if !dispatchDrainerFrom(dic.Get).start(addedNotification, true, func() { slot.run(func() { distribute(dic, addedNotification, nil) }) }) { // nolint:errcheck
+		slot.release()
+	}
 
 	return addedNotification.Id, nil
 }
 
+// validateNotificationContent rejects the notification content which is not valid UTF-8, or replaces the invalid
+// sequences with the Unicode replacement character if Writable.SanitizeInvalidContent is enabled. The localized content
+// is validated as well.
+func validateNotificationContent(n *models.Notification, dic *di.Container) errors.EdgeX {
+	if !utf8.ValidString(n.Content) {
+		if !container.ConfigurationFrom(dic.Get).Writable.SanitizeInvalidContent {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "notification content is not valid UTF-8", nil)
+		}
+		n.Content = strings.ToValidUTF8(n.Content, string(utf8.RuneError))
+	}
+	return validateLocalizedContent(*n)
+}
+
+// DispatchNotification dispatches the notification to the associated subscriptions without writing the notification
+// and its transmissions to the database, which suits the high-frequency transient notifications
+func DispatchNotification(n models.Notification, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if edgeXerr = validateNotificationContent(&n, dic); edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if n.Id == "" {
+		n.Id = uuid.NewString()
+	}
+	ts := time.Now().UnixMilli()
+	n.Created = ts
+	n.Modified = ts
+	slot := routingSlot(dic)
+	if !dispatchDrainerFrom(dic.Get).start(n, false, func() { slot.run(func() { distributeWithoutPersistence(dic, n) }) }) { // nolint:errcheck
+		slot.release()
+		return "", errors.NewCommonEdgeX(errors.KindServiceUnavailable, "notification service is shutting down, the notification is not accepted", nil)
+	}
+	notificationsNotPersistedCounter.Inc(1)
+
+	lc.Debugf("Notification dispatched without persistence. Notification ID: %s, Correlation-ID: %s ",
+		n.Id,
+		correlation.FromContext(ctx))
+
+	return n.Id, nil
+}
+
 // NotificationsByCategory queries notifications with offset, limit, ack, and category
 func NotificationsByCategory(offset, limit int, ack string, category string, dic *di.Container) (notifications []dtos.Notification, totalCount uint32, err errors.EdgeX) {
 	if category == "" {
@@ -134,6 +220,59 @@ func NotificationsByStatus(offset, limit int, status, ack string, dic *di.Contai
 	return dtos.FromNotificationModelsToDTOs(notificationModels), totalCount, nil
 }
 
+// NotificationsBySender queries notifications with offset, limit and sender
+func NotificationsBySender(offset, limit int, sender string, dic *di.Container) (notifications []dtos.Notification, totalCount uint32, err errors.EdgeX) {
+	if sender == "" {
+		return notifications, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "sender is empty", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	totalCount, err = dbClient.NotificationCountBySender(sender)
+	if err != nil {
+		return notifications, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []dtos.Notification{}, totalCount, err
+	}
+
+	notificationModels, err := dbClient.NotificationsBySender(offset, limit, sender)
+	if err != nil {
+		return notifications, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtos.FromNotificationModelsToDTOs(notificationModels), totalCount, nil
+}
+
+// maxContentSearchQueryLength is the maximum length of the notification content search query
+const maxContentSearchQueryLength = 256
+
+// NotificationsByContentSearch query notifications with offset, limit and the query text contained in the content, e.g.
+// a device id or an error string. The search is case-insensitive.
+func NotificationsByContentSearch(offset, limit int, query string, dic *di.Container) (notifications []dtos.Notification, totalCount uint32, err errors.EdgeX) {
+	if strings.TrimSpace(query) == "" {
+		return notifications, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "content search query is empty", nil)
+	}
+	if utf8.RuneCountInString(query) > maxContentSearchQueryLength {
+		return notifications, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("content search query exceeds the maximum length %d", maxContentSearchQueryLength), nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	totalCount, err = dbClient.NotificationCountByContentSearch(query)
+	if err != nil {
+		return notifications, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []dtos.Notification{}, totalCount, err
+	}
+
+	notificationModels, err := dbClient.NotificationsByContentSearch(offset, limit, query)
+	if err != nil {
+		return notifications, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtos.FromNotificationModelsToDTOs(notificationModels), totalCount, nil
+}
+
 // NotificationsByTimeRange query notifications with offset, limit and time range
 func NotificationsByTimeRange(start int64, end int64, offset int, limit int, ack string, dic *di.Container) (notifications []dtos.Notification, totalCount uint32, err errors.EdgeX) {
 	dbClient := container.DBClientFrom(dic.Get)
@@ -262,7 +401,141 @@ func UpdateNotificationAckStatus(ack bool, ids []string, dic *di.Container) erro
 	return nil
 }
 
-// AsyncPurgeNotification purge notifications and related transmissions according to the retention capability.
+// purgeNotificationByAge purges the notifications older than the MaxAge of their category, and the notifications of the
+// other categories older than the global MaxAge. The categories with a retention rule are purged by the MaxAge of their
+// rule, if any, and never by the global MaxAge. The age purging never drops the notifications below the MinCap floor,
+// which is the MinCap of the rule for the categories with a retention rule, and the global MinCap for the others.
+func purgeNotificationByAge(dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+	retention := container.ConfigurationFrom(dic.Get).Retention
+
+	ruleCategories := retentionRuleCategories(retention)
+	// the notifications of the categories without a retention rule never drop below the global MinCap
+	floorAge, aboveFloor := int64(0), true
+	if retention.MinCap > 0 && (retention.MaxAge != "" || len(retention.MaxAgeByCategory) > 0) {
+		var err errors.EdgeX
+		floorAge, aboveFloor, err = minCapFloorAge(dbClient.LatestNotificationByOffsetExcludingCategories(retention.MinCap, ruleCategories))
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query notification with offset '%d' excluding categories", retention.MinCap), err)
+		}
+	}
+
+	maxAgeByCategory := maps.Clone(retention.MaxAgeByCategory)
+	if maxAgeByCategory == nil {
+		maxAgeByCategory = make(map[string]string)
+	}
+	ruleMinCaps := make(map[string]uint32)
+	for _, rule := range retention.Categories {
+		if rule.MaxAge != "" {
+			maxAgeByCategory[rule.Category] = rule.MaxAge
+			ruleMinCaps[rule.Category] = rule.MinCap
+		}
+	}
+	categories := slices.Sorted(maps.Keys(maxAgeByCategory))
+	for _, category := range categories {
+		maxAge, err := time.ParseDuration(maxAgeByCategory[category])
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse notification retention MaxAge '%s' of the category '%s'", maxAgeByCategory[category], category), err)
+		}
+		categoryFloorAge, categoryAboveFloor := floorAge, aboveFloor
+		if minCap, ok := ruleMinCaps[category]; ok {
+			categoryFloorAge, categoryAboveFloor = 0, true
+			if minCap > 0 {
+				var edgexErr errors.EdgeX
+				categoryFloorAge, categoryAboveFloor, edgexErr = minCapFloorAge(dbClient.LatestNotificationByCategoryAndOffset(category, minCap))
+				if edgexErr != nil {
+					return errors.NewCommonEdgeX(errors.Kind(edgexErr), fmt.Sprintf("failed to query notification of the category '%s' with offset '%d'", category, minCap), edgexErr)
+				}
+			}
+		}
+		if !categoryAboveFloor {
+			lc.Debugf("Skip purging the notifications of the category %s by age, which are not above the minimum capacity", category)
+			continue
+		}
+		lc.Debugf("Purging the notifications of the category %s older than %s", category, maxAgeByCategory[category])
+		if err := dbClient.CleanupNotificationsByCategoryAndAge(category, max(maxAge.Milliseconds(), categoryFloorAge)); err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete notifications of the category '%s' by age", category), err)
+		}
+	}
+
+	if retention.MaxAge == "" {
+		return nil
+	}
+	maxAge, err := time.ParseDuration(retention.MaxAge)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse notification retention MaxAge '%s'", retention.MaxAge), err)
+	}
+	if !aboveFloor {
+		lc.Debugf("Skip purging the notifications by age, which are not above the minimum capacity %d", retention.MinCap)
+		return nil
+	}
+	lc.Debugf("Purging the notifications older than %s", retention.MaxAge)
+	excluded := append(slices.Clone(ruleCategories), categories...)
+	slices.Sort(excluded)
+	excluded = slices.Compact(excluded)
+	if err := dbClient.CleanupNotificationsByAgeExcludingCategories(max(maxAge.Milliseconds(), floorAge), excluded); err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete notifications by age '%s'", retention.MaxAge), err)
+	}
+	return nil
+}
+
+// minCapFloorAge returns the age of the floor notification at the MinCap offset from the latest one, so purging the
+// notifications older than the age keeps at least MinCap notifications, and returns false if the notification doesn't
+// exist because there are no more than MinCap notifications to keep
+func minCapFloorAge(floor models.Notification, err errors.EdgeX) (int64, bool, errors.EdgeX) {
+	if err != nil {
+		if errors.Kind(err) == errors.KindEntityDoesNotExist {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	return time.Now().UnixMilli() - floor.Modified, true, nil
+}
+
+// retentionRuleCategories returns the sorted categories of the retention rules
+func retentionRuleCategories(retention config.NotificationRetention) []string {
+	categories := make([]string, 0, len(retention.Categories))
+	for _, rule := range retention.Categories {
+		categories = append(categories, rule.Category)
+	}
+	slices.Sort(categories)
+	return categories
+}
+
+// purgeNotificationByCategoryCap purges the notifications of each category whose retention rule has a MaxCap to the
+// MinCap of the rule, once the count of the notifications of the category reaches the MaxCap
+func purgeNotificationByCategoryCap(dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+	retention := container.ConfigurationFrom(dic.Get).Retention
+
+	for _, rule := range retention.Categories {
+		if rule.MaxCap == 0 {
+			continue
+		}
+		total, err := dbClient.NotificationCountByCategory(rule.Category, "")
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query notification count of the category '%s'", rule.Category), err)
+		}
+		if total < rule.MaxCap {
+			continue
+		}
+		lc.Debugf("Purging the notification amount %d of the category %s to the minimum capacity %d", total, rule.Category, rule.MinCap)
+		notification, err := dbClient.LatestNotificationByCategoryAndOffset(rule.Category, rule.MinCap)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query notification of the category '%s' with offset '%d'", rule.Category, rule.MinCap), err)
+		}
+		age := time.Now().UnixMilli() - notification.Modified
+		if err = dbClient.CleanupNotificationsByCategoryAndAge(rule.Category, age); err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete notifications of the category '%s' by age '%d'", rule.Category, age), err)
+		}
+	}
+	return nil
+}
+
+// AsyncPurgeNotification purge notifications and related transmissions according to the retention capability, and then
+// purge the transmissions and the dead letters according to their retention policies.
 func AsyncPurgeNotification(interval time.Duration, ctx context.Context, dic *di.Container) {
 	asyncPurgeNotificationOnce.Do(func() {
 		go func() {
@@ -280,22 +553,52 @@ func AsyncPurgeNotification(interval time.Duration, ctx context.Context, dic *di
 						lc.Errorf("Failed to purge notifications and transmissions, %v", err)
 						break
 					}
+					err = purgeTransmission(dic)
+					if err != nil {
+						lc.Errorf("Failed to purge transmissions, %v", err)
+						break
+					}
+					err = purgeDeadLetter(dic)
+					if err != nil {
+						lc.Errorf("Failed to purge dead letters, %v", err)
+						break
+					}
 				}
 			}
 		}()
 	})
 }
 
+// purgeNotification purges the notifications by all the retention rules in a single pass, first by age, then by the
+// capacity of the categories with a retention rule, and finally by the global capacity of the other categories
 func purgeNotification(dic *di.Container) errors.EdgeX {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	dbClient := container.DBClientFrom(dic.Get)
 	config := container.ConfigurationFrom(dic.Get)
+	if err := purgeNotificationByAge(dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := purgeNotificationByCategoryCap(dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 	total, err := dbClient.NotificationTotalCount()
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.Kind(err), "failed to query notification total count, %v", err)
 	}
+	// the notifications of the categories with a retention rule don't count towards the global capacity
+	ruleCategories := retentionRuleCategories(config.Retention)
+	for _, category := range ruleCategories {
+		count, err := dbClient.NotificationCountByCategory(category, "")
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query notification count of the category '%s'", category), err)
+		}
+		total -= min(count, total)
+	}
 	if total >= config.Retention.MaxCap {
 		lc.Debugf("Purging the notification amount %d to the minimum capacity %d", total, config.Retention.MinCap)
+		if len(ruleCategories) > 0 {
+			return purgeNotificationByCapExcludingCategories(dic, ruleCategories)
+		}
 		// Query the latest notification and clean notifications by modified date.
 		notification, err := dbClient.LatestNotificationByOffset(config.Retention.MinCap)
 		if err != nil {
@@ -310,3 +613,19 @@ func purgeNotification(dic *di.Container) errors.EdgeX {
 	}
 	return nil
 }
+
+// purgeNotificationByCapExcludingCategories purges the notifications to the global MinCap, except the notifications of
+// the excluded categories
+func purgeNotificationByCapExcludingCategories(dic *di.Container, categories []string) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	minCap := container.ConfigurationFrom(dic.Get).Retention.MinCap
+	notification, err := dbClient.LatestNotificationByOffsetExcludingCategories(minCap, categories)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query notification with offset '%d' excluding categories", minCap), err)
+	}
+	age := time.Now().UnixMilli() - notification.Modified
+	if err = dbClient.CleanupNotificationsByAgeExcludingCategories(age, categories); err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete notifications and transmissions by age '%d' excluding categories", age), err)
+	}
+	return nil
+}
@@ -6,8 +6,11 @@
 package application
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -15,8 +18,10 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	bootstrapMocks "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces/mocks"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
@@ -67,3 +72,339 @@ func TestPurgeNotification(t *testing.T) {
 		})
 	}
 }
+
+func TestPurgeNotificationByAge(t *testing.T) {
+	configuration := &config.ConfigurationStruct{
+		Retention: config.NotificationRetention{
+			Enabled:          true,
+			Interval:         "1s",
+			MaxAge:           "720h",
+			MaxAgeByCategory: map[string]string{"audit": "8760h", "debug": "48h"},
+		},
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("CleanupNotificationsByCategoryAndAge", "audit", (8760 * time.Hour).Milliseconds()).Return(nil)
+	dbClientMock.On("CleanupNotificationsByCategoryAndAge", "debug", (48 * time.Hour).Milliseconds()).Return(nil)
+	dbClientMock.On("CleanupNotificationsByAgeExcludingCategories", (720 * time.Hour).Milliseconds(), []string{"audit", "debug"}).Return(nil)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return configuration
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+
+	require.NoError(t, configuration.Retention.ValidateMaxAge())
+	err := purgeNotificationByAge(dic)
+	require.NoError(t, err)
+	dbClientMock.AssertExpectations(t)
+
+	configuration.Retention.MaxAge = ""
+	dbClientMock = &dbMock.DBClient{}
+	dbClientMock.On("CleanupNotificationsByCategoryAndAge", mock.Anything, mock.Anything).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	err = purgeNotificationByAge(dic)
+	require.NoError(t, err)
+	dbClientMock.AssertNotCalled(t, "CleanupNotificationsByAgeExcludingCategories", mock.Anything, mock.Anything)
+
+	configuration.Retention.MaxAgeByCategory["audit"] = "1 year"
+	require.Error(t, configuration.Retention.ValidateMaxAge())
+}
+
+func TestPurgeNotificationByAge_MinCap(t *testing.T) {
+	maxAge := (720 * time.Hour).Milliseconds()
+	floorAge := (1000 * time.Hour).Milliseconds()
+	olderThan := func(age int64) interface{} {
+		// allow the time elapsed between querying the floor notification and purging
+		return mock.MatchedBy(func(actual int64) bool { return actual >= age && actual < age+time.Minute.Milliseconds() })
+	}
+	floorNotification := func(age int64) models.Notification {
+		return models.Notification{DBTimestamp: models.DBTimestamp{Modified: time.Now().UnixMilli() - age}}
+	}
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "notification not found", nil)
+
+	tests := []struct {
+		name          string
+		minCap        uint32
+		floor         models.Notification
+		floorErr      errors.EdgeX
+		expectedPurge bool
+		expectedAge   int64
+	}{
+		{"no MinCap floor", 0, models.Notification{}, nil, true, maxAge},
+		{"MinCap floor newer than MaxAge", 100, floorNotification((24 * time.Hour).Milliseconds()), nil, true, maxAge},
+		{"MinCap floor older than MaxAge", 100, floorNotification(floorAge), nil, true, floorAge},
+		{"not above MinCap", 100, models.Notification{}, notFound, false, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			configuration := &config.ConfigurationStruct{
+				Retention: config.NotificationRetention{Enabled: true, Interval: "1s", MaxAge: "720h", MinCap: testCase.minCap},
+			}
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("LatestNotificationByOffsetExcludingCategories", testCase.minCap, []string{}).Return(testCase.floor, testCase.floorErr)
+			dbClientMock.On("CleanupNotificationsByAgeExcludingCategories", olderThan(testCase.expectedAge), []string{}).Return(nil)
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.ConfigurationName: func(get di.Get) interface{} {
+					return configuration
+				},
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+				bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+					return logger.NewMockClient()
+				},
+			})
+
+			err := purgeNotificationByAge(dic)
+			require.NoError(t, err)
+			if testCase.minCap == 0 {
+				dbClientMock.AssertNotCalled(t, "LatestNotificationByOffsetExcludingCategories", mock.Anything, mock.Anything)
+			}
+			if testCase.expectedPurge {
+				dbClientMock.AssertNumberOfCalls(t, "CleanupNotificationsByAgeExcludingCategories", 1)
+			} else {
+				dbClientMock.AssertNotCalled(t, "CleanupNotificationsByAgeExcludingCategories", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestPurgeNotificationByAge_CategoryMinCap(t *testing.T) {
+	configuration := &config.ConfigurationStruct{
+		Retention: config.NotificationRetention{
+			Enabled:          true,
+			Interval:         "1s",
+			MinCap:           100,
+			MaxAgeByCategory: map[string]string{"debug": "48h"},
+			Categories: []config.CategoryRetention{
+				{Category: "CRITICAL", MinCap: 50, MaxAge: "2160h"},
+				{Category: "MINOR", MinCap: 50, MaxAge: "168h"},
+			},
+		},
+	}
+	floorAge := (3000 * time.Hour).Milliseconds()
+	olderThan := func(age int64) interface{} {
+		return mock.MatchedBy(func(actual int64) bool { return actual >= age && actual < age+time.Minute.Milliseconds() })
+	}
+	dbClientMock := &dbMock.DBClient{}
+	// the global MinCap floor of the categories without a rule is older than the MaxAgeByCategory
+	dbClientMock.On("LatestNotificationByOffsetExcludingCategories", uint32(100), []string{"CRITICAL", "MINOR"}).
+		Return(models.Notification{DBTimestamp: models.DBTimestamp{Modified: time.Now().UnixMilli() - floorAge}}, nil)
+	dbClientMock.On("CleanupNotificationsByCategoryAndAge", "debug", olderThan(floorAge)).Return(nil).Once()
+	// CRITICAL has more notifications than its MinCap, which are newer than its MaxAge
+	dbClientMock.On("LatestNotificationByCategoryAndOffset", "CRITICAL", uint32(50)).
+		Return(models.Notification{DBTimestamp: models.DBTimestamp{Modified: time.Now().Add(-time.Hour).UnixMilli()}}, nil)
+	dbClientMock.On("CleanupNotificationsByCategoryAndAge", "CRITICAL", olderThan((2160 * time.Hour).Milliseconds())).Return(nil).Once()
+	// MINOR has no more notifications than its MinCap, so none is purged
+	dbClientMock.On("LatestNotificationByCategoryAndOffset", "MINOR", uint32(50)).
+		Return(models.Notification{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "notification not found", nil))
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return configuration
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+
+	err := purgeNotificationByAge(dic)
+	require.NoError(t, err)
+	dbClientMock.AssertExpectations(t)
+	dbClientMock.AssertNumberOfCalls(t, "CleanupNotificationsByCategoryAndAge", 2)
+	dbClientMock.AssertNotCalled(t, "CleanupNotificationsByAgeExcludingCategories", mock.Anything, mock.Anything)
+
+	dbClientMock = &dbMock.DBClient{}
+	dbClientMock.On("LatestNotificationByOffsetExcludingCategories", uint32(100), []string{"CRITICAL", "MINOR"}).
+		Return(models.Notification{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "connection refused", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	err = purgeNotificationByAge(dic)
+	require.Error(t, err)
+	dbClientMock.AssertNotCalled(t, "CleanupNotificationsByCategoryAndAge", mock.Anything, mock.Anything)
+}
+
+func TestPurgeNotification_CategoryRules(t *testing.T) {
+	configuration := &config.ConfigurationStruct{
+		Retention: config.NotificationRetention{
+			Enabled:  true,
+			Interval: "1s",
+			MaxCap:   5,
+			MinCap:   3,
+			MaxAge:   "720h",
+			Categories: []config.CategoryRetention{
+				{Category: "CRITICAL", MaxAge: "2160h"},
+				{Category: "NORMAL", MaxCap: 1000, MinCap: 800},
+			},
+		},
+	}
+	require.NoError(t, configuration.Retention.ValidateCategories())
+	ruleCategories := []string{"CRITICAL", "NORMAL"}
+	recentAge := mock.MatchedBy(func(age int64) bool { return age < (24 * time.Hour).Milliseconds() })
+
+	tests := []struct {
+		name                string
+		totalCount          uint32
+		expectedGlobalPurge bool
+	}{
+		{"other categories reach the global MaxCap", 1255, true},
+		{"other categories below the global MaxCap", 1254, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			latest := models.Notification{DBTimestamp: models.DBTimestamp{Modified: time.Now().Add(-time.Hour).UnixMilli()}}
+			dbClientMock := &dbMock.DBClient{}
+			// CRITICAL is only purged by its own MaxAge, and both categories are excluded from the global MaxAge
+			dbClientMock.On("CleanupNotificationsByCategoryAndAge", "CRITICAL", (2160 * time.Hour).Milliseconds()).Return(nil).Once()
+			dbClientMock.On("CleanupNotificationsByAgeExcludingCategories", (720 * time.Hour).Milliseconds(), ruleCategories).Return(nil).Once()
+			// NORMAL reaches its MaxCap, and is purged to its MinCap
+			dbClientMock.On("NotificationCountByCategory", "NORMAL", "").Return(uint32(1200), nil)
+			dbClientMock.On("NotificationCountByCategory", "CRITICAL", "").Return(uint32(50), nil)
+			dbClientMock.On("LatestNotificationByCategoryAndOffset", "NORMAL", uint32(800)).Return(latest, nil).Once()
+			dbClientMock.On("CleanupNotificationsByCategoryAndAge", "NORMAL", recentAge).Return(nil).Once()
+			// the notifications of the rule categories don't count towards the global MaxCap
+			dbClientMock.On("NotificationTotalCount").Return(testCase.totalCount, nil)
+			dbClientMock.On("LatestNotificationByOffsetExcludingCategories", configuration.Retention.MinCap, ruleCategories).Return(latest, nil)
+			dbClientMock.On("CleanupNotificationsByAgeExcludingCategories", recentAge, ruleCategories).Return(nil)
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.ConfigurationName: func(get di.Get) interface{} {
+					return configuration
+				},
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+				bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+					return logger.NewMockClient()
+				},
+			})
+
+			err := purgeNotification(dic)
+			require.NoError(t, err)
+			dbClientMock.AssertNumberOfCalls(t, "CleanupNotificationsByCategoryAndAge", 2)
+			dbClientMock.AssertNotCalled(t, "CleanupNotificationsByAge", mock.Anything)
+			dbClientMock.AssertNotCalled(t, "LatestNotificationByOffset", mock.Anything)
+			if testCase.expectedGlobalPurge {
+				dbClientMock.AssertNumberOfCalls(t, "CleanupNotificationsByAgeExcludingCategories", 2)
+			} else {
+				dbClientMock.AssertNumberOfCalls(t, "CleanupNotificationsByAgeExcludingCategories", 1)
+				// only queried for the MinCap floor of the age purging
+				dbClientMock.AssertNumberOfCalls(t, "LatestNotificationByOffsetExcludingCategories", 1)
+			}
+		})
+	}
+}
+
+func TestValidateRetentionCategories(t *testing.T) {
+	tests := []struct {
+		name          string
+		retention     config.NotificationRetention
+		expectedError bool
+	}{
+		{"valid", config.NotificationRetention{Categories: []config.CategoryRetention{{Category: "CRITICAL", MaxAge: "2160h"}, {Category: "NORMAL", MaxCap: 1000, MinCap: 800}}}, false},
+		{"empty category", config.NotificationRetention{Categories: []config.CategoryRetention{{MaxCap: 10}}}, true},
+		{"duplicate category", config.NotificationRetention{Categories: []config.CategoryRetention{{Category: "NORMAL"}, {Category: "NORMAL"}}}, true},
+		{"also in MaxAgeByCategory", config.NotificationRetention{MaxAgeByCategory: map[string]string{"NORMAL": "48h"}, Categories: []config.CategoryRetention{{Category: "NORMAL"}}}, true},
+		{"MinCap greater than MaxCap", config.NotificationRetention{Categories: []config.CategoryRetention{{Category: "NORMAL", MaxCap: 10, MinCap: 20}}}, true},
+		{"invalid MaxAge", config.NotificationRetention{Categories: []config.CategoryRetention{{Category: "CRITICAL", MaxAge: "90 days"}}}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.retention.ValidateCategories()
+			if testCase.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRetentionTransmission(t *testing.T) {
+	tests := []struct {
+		name          string
+		retention     config.TransmissionRetention
+		expectedError bool
+	}{
+		{"valid", config.TransmissionRetention{MaxCap: 1000, MinCap: 800, MaxAge: "168h"}, false},
+		{"disabled", config.TransmissionRetention{}, false},
+		{"MinCap without MaxCap", config.TransmissionRetention{MinCap: 800}, false},
+		{"MinCap greater than MaxCap", config.TransmissionRetention{MaxCap: 10, MinCap: 20}, true},
+		{"invalid MaxAge", config.TransmissionRetention{MaxAge: "7 days"}, true},
+		{"zero MaxAge", config.TransmissionRetention{MaxAge: "0s"}, true},
+		{"negative MaxAge", config.TransmissionRetention{MaxAge: "-168h"}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := config.NotificationRetention{Transmission: testCase.retention}.ValidateTransmission()
+			if testCase.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateNotificationContent(t *testing.T) {
+	// 0xff and 0xfe never appear in valid UTF-8
+	invalidContent := "temperature \xff\xfe too high"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	valid := models.Notification{Content: "température trop élevée"}
+	require.NoError(t, validateNotificationContent(&valid, dic))
+	assert.Equal(t, "température trop élevée", valid.Content)
+
+	invalid := models.Notification{Content: invalidContent}
+	err := validateNotificationContent(&invalid, dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	_, err = AddNotification(invalid, context.Background(), dic)
+	require.Error(t, err, "the invalid notification should be rejected before being stored")
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	dbClientMock.AssertNotCalled(t, "AddNotification", mock.Anything)
+
+	container.ConfigurationFrom(dic.Get).Writable.SanitizeInvalidContent = true
+	require.NoError(t, validateNotificationContent(&invalid, dic))
+	assert.Equal(t, "temperature \uFFFD too high", invalid.Content)
+}
+
+func TestRegisterMetrics(t *testing.T) {
+	metricsManager := &bootstrapMocks.MetricsManager{}
+	metricsManager.On("Register", notificationsNotPersistedMetricName, mock.Anything, mock.Anything).Return(errors.NewCommonEdgeX(errors.KindDuplicateName, "already registered", nil))
+	metricsManager.On("Register", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.MetricsManagerInterfaceName: func(get di.Get) interface{} {
+			return metricsManager
+		},
+	})
+	RegisterDispatchState(dic)
+
+	RegisterMetrics(dic)
+
+	// the failed registration should not prevent the other notification metrics from being registered
+	metricsManager.AssertCalled(t, "Register", notificationsInFlightMetricName+channelTypes[0], mock.Anything, mock.Anything)
+	metricsManager.AssertCalled(t, "Register", notificationsThrottledMetricName, mock.Anything, mock.Anything)
+}
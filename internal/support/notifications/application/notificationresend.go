@@ -0,0 +1,91 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/google/uuid"
+)
+
+// ResendNotificationById transmits the notification again to the channels of its subscriptions, e.g. after the outage
+// of a channel is resolved. Each channel gets a fresh transmission starting from the first send with the resend count
+// reset, whether its former transmissions failed or were escalated after exhausting the resend limit, except the
+// channels whose transmissions are still resending. The PROCESSED notification is only resent if force is true.
+// Returns the count of the transmissions created, which are sent in the background.
+func ResendNotificationById(id string, force bool, ctx context.Context, dic *di.Container) (int, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if id == "" {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "ID is empty", nil)
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "ID is not a valid UUID", err)
+	}
+	if !dispatchDrainerFrom(dic.Get).accepting() {
+		return 0, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "notification service is shutting down, the notification is not resent", nil)
+	}
+	n, err := dbClient.NotificationById(id)
+	if err != nil {
+		return 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	if n.Status == models.Processed && !force {
+		return 0, errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("notification %s is already processed, force the resend to transmit it again", id), nil)
+	}
+
+	transmissions, err := dbClient.TransmissionsByNotificationId(0, -1, id)
+	if err != nil {
+		return 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	var resending []models.Transmission
+	for _, trans := range transmissions {
+		if trans.Status == models.RESENDING || trans.Status == TransmissionStatusDeferred {
+			resending = append(resending, trans)
+		}
+	}
+	subs, err := distributableSubscriptions(dic, n)
+	if err != nil {
+		return 0, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	correlationId := correlation.FromContext(ctx)
+	recordDispatchAudit(dic, notificationModels.DispatchAuditRecord{NotificationId: n.Id, Event: notificationModels.DispatchAuditResent, CorrelationId: correlationId, Matches: routingMatches(n, subs)})
+	count := 0
+	for _, sub := range subs {
+		for _, address := range sub.Channels {
+			if isResumedTransmission(resending, sub, address) {
+				lc.Debugf("the notification %s is still resending or deferred to the subscription %s with address %v, skip the resend", n.Id, sub.Name, address.GetBaseAddress())
+				continue
+			}
+			slot := transmissionSlot(dic, n, sub, address)
+			dispatchDrainerFrom(dic.Get).join(n, true, func() { slot.run(func() { transmit(dic, n, sub, address) }) }) // nolint:errcheck
+			count++
+		}
+	}
+
+	// The notification not distributed yet, e.g. throttled, is processed by the resend
+	if n.Status == models.New || n.Status == NotificationStatusThrottled {
+		processed := n
+		processed.Status = models.Processed
+		if err = dbClient.UpdateNotification(processed); err != nil {
+			lc.Errorf("fail to update notification status to processed", err)
+			return count, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	lc.Infof("Resent the notification %s with %d transmissions, force: %t. Correlation-ID: %s", n.Id, count, force, correlationId)
+	return count, nil
+}
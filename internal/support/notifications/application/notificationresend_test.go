@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResendNotificationById(t *testing.T) {
+	resendSub := sub
+	resendSub.Channels = []models.Address{testRestAddress, testRestAddress2}
+	processed := notification
+	processed.Id = exampleUUID
+	processed.Status = models.Processed
+	throttled := notification
+	throttled.Id = "a0b9f6c1-2a2d-4e25-9a8e-2c1c3ad4d2e1"
+	throttled.Status = NotificationStatusThrottled
+	notFoundId := "d6c2cf1f-58c3-4b69-8b79-2f7f8e0f1b7a"
+	// the escalated transmission is resent with a fresh transmission, while the resending one is left to its resend
+	escalated := models.Transmission{Id: "8a41b5ec-3e4d-4d6f-a3a2-0f4b0c2d8e55", SubscriptionName: resendSub.Name, Channel: testRestAddress, Status: models.Escalated, ResendCount: 2}
+	resending := models.Transmission{Id: "5b2e4b5c-8f4a-4f0e-9a63-4d1b0c7e2f11", SubscriptionName: resendSub.Name, Channel: testRestAddress2, Status: models.RESENDING, ResendCount: 1}
+
+	tests := []struct {
+		name              string
+		id                string
+		n                 models.Notification
+		force             bool
+		transmissions     []models.Transmission
+		skipped           models.Address
+		expectedCount     int
+		expectedStatus    models.NotificationStatus
+		expectedErrorKind errors.ErrKind
+	}{
+		{"valid - force the processed notification", processed.Id, processed, true, []models.Transmission{escalated}, nil, 2, "", ""},
+		{"valid - skip the resending channel", processed.Id, processed, true, []models.Transmission{escalated, resending}, testRestAddress2, 1, "", ""},
+		{"valid - throttled notification is processed", throttled.Id, throttled, false, nil, nil, 2, models.Processed, ""},
+		{"invalid - processed notification without force", processed.Id, processed, false, nil, nil, 0, "", errors.KindStatusConflict},
+		{"invalid - notification not found", notFoundId, processed, true, nil, nil, 0, "", errors.KindEntityDoesNotExist},
+		{"invalid - id is not UUID", "notification", processed, true, nil, nil, 0, "", errors.KindContractInvalid},
+		{"invalid - id is empty", "", processed, true, nil, nil, 0, "", errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			transmitted := make(chan models.Transmission, 2)
+			var statuses []models.NotificationStatus
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("NotificationById", testCase.n.Id).Return(testCase.n, nil)
+			dbClientMock.On("NotificationById", notFoundId).Return(models.Notification{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+			dbClientMock.On("TransmissionsByNotificationId", 0, -1, testCase.n.Id).Return(testCase.transmissions, nil)
+			dbClientMock.On("AllSubscriptions", 0, -1).Return([]models.Subscription{resendSub}, nil)
+			dbClientMock.On("UpdateNotification", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				statuses = append(statuses, args.Get(0).(models.Notification).Status)
+			})
+			dbClientMock.On("AddTransmission", mock.Anything).Return(func(trans models.Transmission) (models.Transmission, errors.EdgeX) {
+				transmitted <- trans
+				return trans, nil
+			})
+			restSender := &senderMock.Sender{}
+			restSender.On("Send", testCase.n, mock.Anything).Return("", nil)
+			dic := deadLetterTestDic(dbClientMock, restSender)
+
+			count, err := ResendNotificationById(testCase.id, testCase.force, context.Background(), dic)
+			if testCase.expectedErrorKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrorKind, errors.Kind(err))
+				dbClientMock.AssertNotCalled(t, "AddTransmission", mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedCount, count)
+			for i := 0; i < testCase.expectedCount; i++ {
+				select {
+				case trans := <-transmitted:
+					assert.Equal(t, testCase.n.Id, trans.NotificationId)
+					assert.NotEqual(t, testCase.skipped, trans.Channel, "the resending channel shouldn't be resent")
+					assert.Zero(t, trans.ResendCount)
+					assert.EqualValues(t, models.Sent, trans.Status)
+				case <-time.After(5 * time.Second):
+					require.Fail(t, "the notification should be transmitted again")
+				}
+			}
+			if testCase.expectedCount < 2 {
+				assert.Never(t, func() bool { return len(transmitted) > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+			}
+			if testCase.expectedStatus != "" {
+				assert.Equal(t, []models.NotificationStatus{testCase.expectedStatus}, statuses)
+			} else {
+				assert.Empty(t, statuses)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// OrderingKeyLabelPrefix is the prefix of the notification label carrying the caller-supplied ordering key, e.g.
+// "orderingKey:incident-42". The notifications of an ordered subscription sharing the key are dispatched in order, while
+// the different keys proceed in parallel. The ordering key label is not used to match the subscriptions.
+const OrderingKeyLabelPrefix = "orderingKey:"
+
+// routingOrderingKey is the ordering key of the notification routing, the subscription ordering keys are never empty
+const routingOrderingKey = ""
+
+// SubscriptionOrdering tells whether the notifications of the subscription are dispatched in order
+type SubscriptionOrdering struct {
+	SubscriptionName string `json:"subscriptionName"`
+	Ordered          bool   `json:"ordered"`
+}
+
+// dispatchSequencer serializes the dispatches sharing an ordering key. Each dispatch reserves its place in the sequence
+// of the key before being run asynchronously, and waits for the previous dispatch of the key to finish.
+type dispatchSequencer struct {
+	mutex sync.Mutex
+	// tails holds the done channel of the last reserved dispatch of each ordering key
+	tails map[string]chan struct{}
+}
+
+// orderingSlot is the place of a dispatch in the sequence of its ordering key, the zero value runs the dispatch
+// without ordering
+type orderingSlot struct {
+	sequencer *dispatchSequencer
+	key       string
+	prev      chan struct{}
+	done      chan struct{}
+}
+
+func newDispatchSequencer() *dispatchSequencer {
+	return &dispatchSequencer{tails: make(map[string]chan struct{})}
+}
+
+// reserve reserves the place of the next dispatch of the ordering key, the slot must be either run or released
+func (q *dispatchSequencer) reserve(key string) orderingSlot {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	slot := orderingSlot{sequencer: q, key: key, prev: q.tails[key], done: make(chan struct{})}
+	q.tails[key] = slot.done
+	return slot
+}
+
+// run waits for the previous dispatch of the ordering key to finish, and then runs the dispatch
+func (s orderingSlot) run(dispatch func()) {
+	if s.done == nil {
+		dispatch()
+		return
+	}
+	defer s.release()
+	if s.prev != nil {
+		<-s.prev
+	}
+	dispatch()
+}
+
+// release lets the next dispatch of the ordering key proceed
+func (s orderingSlot) release() {
+	if s.done == nil {
+		return
+	}
+	s.sequencer.mutex.Lock()
+	defer s.sequencer.mutex.Unlock()
+	if s.sequencer.tails[s.key] == s.done {
+		delete(s.sequencer.tails, s.key)
+	}
+	close(s.done)
+}
+
+// routingSlot reserves the place of the notification in the routing sequence when any subscription is ordered, so the
+// notifications are routed to the ordered subscriptions in the order they are accepted
+func routingSlot(dic *di.Container) orderingSlot {
+	if !container.ConfigurationFrom(dic.Get).Writable.Ordering.Any() {
+		return orderingSlot{}
+	}
+	return dispatchSequencerFrom(dic.Get).reserve(routingOrderingKey)
+}
+
+// transmissionSlot reserves the place of the transmission when the subscription is ordered. The ordering key is the
+// subscription name, with the caller-supplied ordering key of the notification if any, and the channel address.
+func transmissionSlot(dic *di.Container, n models.Notification, sub models.Subscription, address models.Address) orderingSlot {
+	if !container.ConfigurationFrom(dic.Get).Writable.Ordering.Enabled(sub.Name) {
+		return orderingSlot{}
+	}
+	key := sub.Name
+	if orderingKey := notificationOrderingKey(n); orderingKey != "" {
+		key = key + "/" + orderingKey
+	}
+	return dispatchSequencerFrom(dic.Get).reserve(key + "/" + circuitKey(address))
+}
+
+// notificationOrderingKey returns the caller-supplied ordering key of the notification, or empty if there is none
+func notificationOrderingKey(n models.Notification) string {
+	for _, label := range n.Labels {
+		if key, ok := strings.CutPrefix(label, OrderingKeyLabelPrefix); ok && key != "" {
+			return key
+		}
+	}
+	return ""
+}
+
+// routingLabels returns the labels used to match the subscriptions, which exclude the ordering key labels
+func routingLabels(labels []string) []string {
+	var routing []string
+	for _, label := range labels {
+		if !strings.HasPrefix(label, OrderingKeyLabelPrefix) {
+			routing = append(routing, label)
+		}
+	}
+	return routing
+}
+
+// SubscriptionOrderingByName returns whether the notifications of the subscription are dispatched in order
+func SubscriptionOrderingByName(name string, dic *di.Container) (SubscriptionOrdering, errors.EdgeX) {
+	if name == "" {
+		return SubscriptionOrdering{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	if _, err := container.DBClientFrom(dic.Get).SubscriptionByName(name); err != nil {
+		return SubscriptionOrdering{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return SubscriptionOrdering{
+		SubscriptionName: name,
+		Ordered:          container.ConfigurationFrom(dic.Get).Writable.Ordering.Enabled(name),
+	}, nil
+}
@@ -0,0 +1,82 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+func TestDispatchSequencer(t *testing.T) {
+	sequencer := newDispatchSequencer()
+	var mutex sync.Mutex
+	var order []int
+	var wg sync.WaitGroup
+
+	// the blocker holds the sequence of sub1 until the other ordering key is checked
+	blocker := sequencer.reserve("sub1")
+	for i := 0; i < 5; i++ {
+		slot := sequencer.reserve("sub1")
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// the earlier reserved dispatches sleep longer, they must still finish first
+			time.Sleep(time.Duration(5-i) * 5 * time.Millisecond)
+			slot.run(func() {
+				mutex.Lock()
+				defer mutex.Unlock()
+				order = append(order, i)
+			})
+		}()
+	}
+
+	otherKey := make(chan struct{})
+	go sequencer.reserve("sub2").run(func() { close(otherKey) })
+	select {
+	case <-otherKey:
+	case <-time.After(time.Second):
+		assert.Fail(t, "the dispatch of another ordering key should not wait")
+	}
+	blocker.release()
+
+	wg.Wait()
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, order)
+	assert.Empty(t, sequencer.tails, "the finished ordering keys should be removed")
+
+	released := sequencer.reserve("sub1")
+	next := sequencer.reserve("sub1")
+	released.release()
+	done := make(chan struct{})
+	go next.run(func() { close(done) })
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		assert.Fail(t, "the released slot should let the next dispatch proceed")
+	}
+
+	ran := false
+	orderingSlot{}.run(func() { ran = true })
+	assert.True(t, ran, "the zero slot should run without ordering")
+}
+
+func TestOrderingKeys(t *testing.T) {
+	n := models.Notification{Labels: []string{"hvac", OrderingKeyLabelPrefix + "incident-42"}}
+	assert.Equal(t, "incident-42", notificationOrderingKey(n))
+	assert.Equal(t, []string{"hvac"}, routingLabels(n.Labels))
+	assert.Empty(t, notificationOrderingKey(models.Notification{Labels: []string{"hvac"}}))
+
+	ordering := config.OrderingInfo{Subscriptions: []string{"sub1"}}
+	assert.True(t, ordering.Enabled("sub1"))
+	assert.False(t, ordering.Enabled("sub2"))
+	assert.True(t, config.OrderingInfo{Subscriptions: []string{"*"}}.Enabled("sub2"))
+	assert.False(t, config.OrderingInfo{}.Any())
+}
@@ -0,0 +1,66 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"strings"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// DroppedRecipientsResponsePrefix prefixes the response of the transmission record of the email recipients dropped by
+// the MaxRecipientsPerNotification
+const DroppedRecipientsResponsePrefix = "dropped recipients"
+
+// limitRecipients truncates the recipients of the email address to the Writable.MaxRecipientsPerNotification, and
+// returns the truncated address along with the dropped recipients. The other addresses are returned as is.
+func limitRecipients(dic *di.Container, subscriptionName string, address models.Address) (models.Address, []string) {
+	maxRecipients := container.ConfigurationFrom(dic.Get).Writable.MaxRecipientsPerNotification
+	emailAddress, ok := address.(models.EmailAddress)
+	if !ok || maxRecipients <= 0 || len(emailAddress.Recipients) <= maxRecipients {
+		return address, nil
+	}
+
+	dropped := emailAddress.Recipients[maxRecipients:]
+	// copy the kept recipients, so the recipients of the subscription's channel are not modified
+	emailAddress.Recipients = append([]string(nil), emailAddress.Recipients[:maxRecipients]...)
+	bootstrapContainer.LoggingClientFrom(dic.Get).Warnf("the email channel of the subscription %s has %d recipients over the MaxRecipientsPerNotification %d, drop the recipients %s",
+		subscriptionName, len(dropped)+maxRecipients, maxRecipients, strings.Join(dropped, ", "))
+	return emailAddress, dropped
+}
+
+// droppedRecipientsRecord returns the FAILED transmission record of the recipients dropped by the
+// MaxRecipientsPerNotification, whose Response is prefixed by DroppedRecipientsResponsePrefix
+func droppedRecipientsRecord(maxRecipients int, dropped []string) models.TransmissionRecord {
+	return models.TransmissionRecord{
+		Status:   models.Failed,
+		Response: fmt.Sprintf("%s over the MaxRecipientsPerNotification %d: %s", DroppedRecipientsResponsePrefix, maxRecipients, strings.Join(dropped, ", ")),
+		Sent:     pkgCommon.MakeTimestamp(),
+	}
+}
+
+// validateSubscriptionRecipients checks the email channels of the subscription don't exceed the
+// MaxRecipientsPerNotification if RejectExcessRecipients is enabled
+func validateSubscriptionRecipients(sub models.Subscription, dic *di.Container) errors.EdgeX {
+	config := container.ConfigurationFrom(dic.Get)
+	maxRecipients := config.Writable.MaxRecipientsPerNotification
+	if !config.Writable.RejectExcessRecipients || maxRecipients <= 0 {
+		return nil
+	}
+	for _, address := range sub.Channels {
+		if emailAddress, ok := address.(models.EmailAddress); ok && len(emailAddress.Recipients) > maxRecipients {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("subscription %s has %d email recipients over the MaxRecipientsPerNotification %d", sub.Name, len(emailAddress.Recipients), maxRecipients), nil)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFirstSend_MaxRecipients(t *testing.T) {
+	address := models.EmailAddress{
+		BaseAddress: models.BaseAddress{Type: common.EMAIL, Host: testHost, Port: testPort},
+		Recipients:  []string{"a@example.com", "b@example.com", "c@example.com"},
+	}
+	truncated := address
+	truncated.Recipients = []string{"a@example.com", "b@example.com"}
+	emailSender := &senderMock.Sender{}
+	emailSender.On("Send", notification, truncated).Return("", nil)
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		channel.EmailSenderName: func(get di.Get) interface{} {
+			return emailSender
+		},
+	})
+	container.ConfigurationFrom(dic.Get).Writable.MaxRecipientsPerNotification = 2
+
+	trans, _ := firstSend(dic, notification, models.NewTransmission(sub.Name, address, notification.Id))
+	emailSender.AssertCalled(t, "Send", notification, truncated)
+	assert.EqualValues(t, models.Sent, trans.Status)
+	assert.Equal(t, truncated, trans.Channel, "the dropped recipients should not be resent")
+	require.Len(t, trans.Records, 3)
+	assert.EqualValues(t, models.Failed, trans.Records[0].Status)
+	assert.Equal(t, "dropped recipients over the MaxRecipientsPerNotification 2: c@example.com", trans.Records[0].Response)
+	assert.Equal(t, []string{"a@example.com", "b@example.com", "c@example.com"}, address.Recipients, "the recipients of the subscription should not be modified")
+
+	container.ConfigurationFrom(dic.Get).Writable.MaxRecipientsPerNotification = 0
+	emailSender.On("Send", notification, address).Return("", nil)
+	trans, _ = firstSend(dic, notification, models.NewTransmission(sub.Name, address, notification.Id))
+	assert.Equal(t, address, trans.Channel, "0 should be unlimited")
+	assert.Len(t, trans.Records, 3)
+}
+
+func TestValidateSubscriptionRecipients(t *testing.T) {
+	dic := mockDic()
+	writable := &container.ConfigurationFrom(dic.Get).Writable
+	subscription := models.Subscription{Name: sub.Name, Channels: []models.Address{
+		testRestAddress,
+		models.EmailAddress{Recipients: []string{"a@example.com", "b@example.com", "c@example.com"}},
+	}}
+
+	tests := []struct {
+		name          string
+		writable      config.WritableInfo
+		errorExpected bool
+	}{
+		{"unlimited", config.WritableInfo{RejectExcessRecipients: true}, false},
+		{"truncated at dispatch", config.WritableInfo{MaxRecipientsPerNotification: 2}, false},
+		{"within the limit", config.WritableInfo{MaxRecipientsPerNotification: 3, RejectExcessRecipients: true}, false},
+		{"over the limit", config.WritableInfo{MaxRecipientsPerNotification: 2, RejectExcessRecipients: true}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			writable.MaxRecipientsPerNotification = testCase.writable.MaxRecipientsPerNotification
+			writable.RejectExcessRecipients = testCase.writable.RejectExcessRecipients
+			err := validateSubscriptionRecipients(subscription, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// DeliveryReport summarizes the transmissions of a subscription created in the time range
+type DeliveryReport struct {
+	SubscriptionName string `json:"subscriptionName"`
+	Start            int64  `json:"start"`
+	End              int64  `json:"end"`
+	Total            int    `json:"total"`
+	// Sent counts the transmissions delivered, including the acknowledged ones
+	Sent int `json:"sent"`
+	// Failed counts the transmissions failed to deliver, including the escalated ones
+	Failed int `json:"failed"`
+	// Retried counts the transmissions resent at least once
+	Retried int `json:"retried"`
+	// AverageLatency is the average time in milliseconds from the first send attempt to the delivery of the delivered
+	// transmissions, which reflects the delay of the resends
+	AverageLatency int64 `json:"averageLatency"`
+}
+
+// SubscriptionDeliveryReport aggregates the transmissions of the subscription created between start and end, in
+// milliseconds, into a delivery report
+func SubscriptionDeliveryReport(subscriptionName string, start, end int64, dic *di.Container) (report DeliveryReport, err errors.EdgeX) {
+	if subscriptionName == "" {
+		return report, errors.NewCommonEdgeX(errors.KindContractInvalid, "subscription name is empty", nil)
+	}
+	if start < 0 || end <= start {
+		return report, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid time range %d ~ %d, end must be greater than start", start, end), nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	if _, err = dbClient.SubscriptionByName(subscriptionName); err != nil {
+		return report, errors.NewCommonEdgeXWrapper(err)
+	}
+	transmissions, err := dbClient.TransmissionsBySubscriptionNameAndTimeRange(start, end, 0, -1, subscriptionName)
+	if err != nil {
+		return report, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	report = DeliveryReport{SubscriptionName: subscriptionName, Start: start, End: end, Total: len(transmissions)}
+	var totalLatency int64
+	for _, trans := range transmissions {
+		if trans.ResendCount > 0 {
+			report.Retried++
+		}
+		switch trans.Status {
+		case models.Sent, models.Acknowledged:
+			report.Sent++
+			totalLatency += deliveryLatency(trans)
+		case models.Failed, models.Escalated:
+			report.Failed++
+		}
+	}
+	if report.Sent > 0 {
+		report.AverageLatency = totalLatency / int64(report.Sent)
+	}
+	return report, nil
+}
+
+// deliveryLatency returns the time from the first send attempt to the delivering attempt of the transmission
+func deliveryLatency(trans models.Transmission) int64 {
+	if len(trans.Records) == 0 {
+		return 0
+	}
+	first := trans.Records[0].Sent
+	for _, record := range trans.Records {
+		if record.Status == models.Sent {
+			return record.Sent - first
+		}
+	}
+	return 0
+}
@@ -0,0 +1,171 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// resumeResendingTransmissions resumes the resends of the transmissions left with the RESENDING status by the last
+// shutdown, and the transmissions deferred to a delivery window. Each resend continues from the stored ResendCount at
+// the stored next attempt, the overdue attempts are sent immediately. The deferred transmissions are scheduled for the
+// stored window opening. The resumed transmissions are returned by the notification id, so the redistribution of the
+// pending notifications doesn't transmit them again.
+func resumeResendingTransmissions(dic *di.Container) (map[string][]models.Transmission, errors.EdgeX) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+
+	transmissions, err := dbClient.TransmissionsByStatus(0, -1, models.RESENDING)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	deferred, err := dbClient.TransmissionsByStatus(0, -1, string(TransmissionStatusDeferred))
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	transmissions = append(transmissions, deferred...)
+	resumed := make(map[string][]models.Transmission)
+	for _, trans := range transmissions {
+		if deferredTransmission(trans) {
+			// the notification and the subscription are read when the delivery window opens
+			scheduleDeferredTransmission(dic, trans, storedNextAttempt(trans, 0))
+			resumed[trans.NotificationId] = append(resumed[trans.NotificationId], trans)
+			continue
+		}
+		n, sub, err := resendTarget(dbClient, trans)
+		if errors.Kind(err) == errors.KindEntityDoesNotExist {
+			// the notification or the subscription is removed, the transmission can't be resent anymore
+			lc.Errorf("Failed to resume the resending transmission %s, %v", trans.Id, err)
+			trans.Status = models.Failed
+			if err = dbClient.UpdateTransmission(trans); err != nil {
+				lc.Errorf("Failed to update the status of the transmission %s, %v", trans.Id, err)
+			}
+			continue
+		}
+		if err != nil {
+			// the failure may be transient, e.g. the database is not ready yet, so the transmission is left RESENDING to
+			// be resumed on the next start, and the redistribution doesn't transmit it again meanwhile
+			lc.Errorf("Failed to resume the resending transmission %s, it is resumed on the next start, %v", trans.Id, err)
+			resumed[trans.NotificationId] = append(resumed[trans.NotificationId], trans)
+			continue
+		}
+		slot := transmissionSlot(dic, n, sub, trans.Channel)
+		dispatchDrainerFrom(dic.Get).join(n, true, func() { slot.run(func() { resumeResend(dic, n, sub, trans) }) })
+		resumed[n.Id] = append(resumed[n.Id], trans)
+	}
+	if len(resumed) > 0 {
+		lc.Infof("Resuming the resending transmissions of %d notifications", len(resumed))
+	}
+	return resumed, nil
+}
+
+// resendTarget returns the notification and the subscription of the transmission
+func resendTarget(dbClient interfaces.DBClient, trans models.Transmission) (models.Notification, models.Subscription, errors.EdgeX) {
+	n, err := dbClient.NotificationById(trans.NotificationId)
+	if err != nil {
+		return n, models.Subscription{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	sub, err := dbClient.SubscriptionByName(trans.SubscriptionName)
+	if err != nil {
+		return n, sub, errors.NewCommonEdgeXWrapper(err)
+	}
+	return n, sub, nil
+}
+
+// resumeResend resends the notification of the stored transmission from the stored resend schedule, and escalates the
+// transmission like transmit if the resend limit is exceeded
+func resumeResend(dic *di.Container, n models.Notification, sub models.Subscription, trans models.Transmission) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	_, resendInterval, err := resendLimitAndInterval(container.ConfigurationFrom(dic.Get), sub)
+	if err != nil {
+		lc.Errorf("fail to resume the transmission %s, err: %v", trans.Id, err)
+		return
+	}
+	last := sendResult{failureClass: storedFailureClass(trans)}
+	rendered := renderNotification(dic, n, sub)
+	trans, err = resendFrom(dic, rendered, sub, trans, undeliveredAddress(trans), last, storedNextAttempt(trans, resendInterval))
+	if err != nil {
+		lc.Errorf("fail to resume the critical notification sending for the subscription %s with address %v, err: %v", sub.Name, trans.Channel.GetBaseAddress(), err)
+		return
+	}
+	if trans.Status == models.Escalated {
+		recordDeadLetter(dic, n, trans)
+		if err = escalatedSend(dic, n, trans); err != nil {
+			lc.Errorf("fail to handle the escalated notification sending, err: %v", err)
+		}
+	}
+}
+
+// storedNextAttempt returns the next attempt stored in the last transmission record, or the resend interval after the
+// last send if the service stopped before storing it
+func storedNextAttempt(trans models.Transmission, resendInterval time.Duration) time.Time {
+	if len(trans.Records) == 0 {
+		return time.Now()
+	}
+	last := trans.Records[len(trans.Records)-1]
+	if i := strings.LastIndex(last.Response, nextAttemptAnnotation); i >= 0 {
+		if nextAttempt, err := time.Parse(time.RFC3339Nano, last.Response[i+len(nextAttemptAnnotation):]); err == nil {
+			return nextAttempt
+		}
+	}
+	return time.UnixMilli(last.Sent).Add(resendInterval)
+}
+
+// storedFailureClass returns the failure class stored in the last transmission record, or empty if it is unknown
+func storedFailureClass(trans models.Transmission) channel.FailureClass {
+	if len(trans.Records) == 0 {
+		return ""
+	}
+	response := trans.Records[len(trans.Records)-1].Response
+	end := strings.LastIndex(response, nextAttemptAnnotation)
+	if end < 0 {
+		return ""
+	}
+	start := strings.LastIndex(response[:end], failureClassAnnotation)
+	if start < 0 {
+		return ""
+	}
+	return channel.FailureClass(response[start+len(failureClassAnnotation) : end])
+}
+
+// undeliveredAddress returns the address of the transmission, which is narrowed to the email recipients not yet
+// delivered according to the recipient records
+func undeliveredAddress(trans models.Transmission) models.Address {
+	emailAddress, ok := trans.Channel.(models.EmailAddress)
+	if !ok {
+		return trans.Channel
+	}
+	delivered := make(map[string]bool)
+	for _, record := range trans.Records {
+		if record.Status != models.Sent {
+			continue
+		}
+		if recipient, ok := strings.CutPrefix(record.Response, RecipientResponsePrefix+" "); ok {
+			// the last record may be annotated with the resend schedule
+			recipient, _, _ = strings.Cut(recipient, "; ")
+			delivered[recipient] = true
+		}
+	}
+	var recipients []string
+	for _, recipient := range emailAddress.Recipients {
+		if !delivered[recipient] {
+			recipients = append(recipients, recipient)
+		}
+	}
+	emailAddress.Recipients = recipients
+	return emailAddress
+}
@@ -0,0 +1,237 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// transmissionRecorder stores the copies of the updated transmissions, as the DB would
+type transmissionRecorder struct {
+	mutex  sync.Mutex
+	stored []models.Transmission
+}
+
+func (r *transmissionRecorder) record(args mock.Arguments) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	trans := args.Get(0).(models.Transmission)
+	trans.Records = slices.Clone(trans.Records)
+	r.stored = append(r.stored, trans)
+}
+
+func (r *transmissionRecorder) last() models.Transmission {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if len(r.stored) == 0 {
+		return models.Transmission{}
+	}
+	return r.stored[len(r.stored)-1]
+}
+
+func resumeTestDic(dbClient *dbMock.DBClient, restSender *senderMock.Sender) *di.Container {
+	dic := mockDic()
+	configuration := container.ConfigurationFrom(dic.Get)
+	configuration.Writable.ResendLimit = 3
+	configuration.Writable.ResendInterval = "50ms"
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClient
+		},
+		channel.RESTSenderName: func(get di.Get) interface{} {
+			return restSender
+		},
+	})
+	return dic
+}
+
+func TestRedistributePendingNotifications_ResumeResending(t *testing.T) {
+	n := notification
+	n.Id = exampleUUID
+	n.Severity = models.Critical
+	sendErr := errors.NewCommonEdgeX(errors.KindServerError, "fail to send the request", nil)
+
+	// run the resends without a restart, and take the transmission stored between the first and the second resend
+	before := &transmissionRecorder{}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil).Run(before.record)
+	restSender := &senderMock.Sender{}
+	restSender.On("Send", n, testRestAddress2).Return("", sendErr)
+	dic := resumeTestDic(dbClientMock, restSender)
+
+	trans := models.NewTransmission(sub.Name, testRestAddress2, n.Id)
+	trans.Id = exampleUUID
+	trans, result := firstSend(dic, n, trans)
+	trans.Status = models.RESENDING
+	uninterrupted, err := reSend(dic, n, sub, trans, result)
+	require.NoError(t, err)
+	assert.EqualValues(t, models.Escalated, uninterrupted.Status)
+	restSender.AssertNumberOfCalls(t, "Send", 1+3)
+
+	idx := slices.IndexFunc(before.stored, func(trans models.Transmission) bool { return trans.ResendCount == 1 })
+	require.GreaterOrEqual(t, idx, 0)
+	stored := before.stored[idx]
+	require.EqualValues(t, models.RESENDING, stored.Status)
+	nextAttempt := storedNextAttempt(stored, 0)
+	assert.Equal(t, channel.FailureClassNetwork, storedFailureClass(stored))
+
+	// restart with the stored transmission
+	after := &transmissionRecorder{}
+	dbClientMock = &dbMock.DBClient{}
+	dbClientMock.On("TransmissionsByStatus", 0, -1, models.RESENDING).Return([]models.Transmission{stored}, nil)
+	dbClientMock.On("TransmissionsByStatus", 0, -1, string(TransmissionStatusDeferred)).Return([]models.Transmission{}, nil)
+	dbClientMock.On("NotificationById", n.Id).Return(n, nil)
+	dbClientMock.On("SubscriptionByName", sub.Name).Return(sub, nil)
+	dbClientMock.On("SubscriptionByName", models.EscalationSubscriptionName).Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("AddDeadLetter", mock.Anything).Return(notificationModels.DeadLetter{}, nil)
+	dbClientMock.On("NotificationsByStatus", 0, -1, "", models.New).Return([]models.Notification{}, nil)
+	dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil).Run(after.record)
+	var firstResent time.Time
+	var once sync.Once
+	restSender = &senderMock.Sender{}
+	restSender.On("Send", n, testRestAddress2).Return("", sendErr).Run(func(args mock.Arguments) {
+		once.Do(func() { firstResent = time.Now() })
+	})
+	dic = resumeTestDic(dbClientMock, restSender)
+
+	require.NoError(t, RedistributePendingNotifications(dic))
+	require.Eventually(t, func() bool { return after.last().Status == models.Escalated }, 5*time.Second, 10*time.Millisecond)
+
+	resumed := after.last()
+	restSender.AssertNumberOfCalls(t, "Send", 2)
+	assert.Equal(t, 3, resumed.ResendCount, "no resend should be skipped or repeated")
+	assert.Len(t, resumed.Records, len(uninterrupted.Records))
+	assert.Equal(t, stored.Records, resumed.Records[:len(stored.Records)])
+	assert.False(t, firstResent.Before(nextAttempt.Add(-time.Millisecond)), "the resend should wait for the stored next attempt")
+}
+
+func TestRedistributePendingNotifications_SkipResumedChannels(t *testing.T) {
+	n := notification
+	n.Id = exampleUUID
+	n.Severity = models.Critical
+	resumedSub := sub
+	resumedSub.Channels = []models.Address{testRestAddress2}
+	stored := models.NewTransmission(resumedSub.Name, testRestAddress2, n.Id)
+	stored.Status = models.RESENDING
+	stored.ResendCount = 3
+	stored.Records = []models.TransmissionRecord{{Status: models.Failed, Sent: time.Now().Add(-time.Hour).UnixMilli()}}
+
+	after := &transmissionRecorder{}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("TransmissionsByStatus", 0, -1, models.RESENDING).Return([]models.Transmission{stored}, nil)
+	dbClientMock.On("TransmissionsByStatus", 0, -1, string(TransmissionStatusDeferred)).Return([]models.Transmission{}, nil)
+	dbClientMock.On("NotificationById", n.Id).Return(n, nil)
+	dbClientMock.On("SubscriptionByName", resumedSub.Name).Return(resumedSub, nil)
+	dbClientMock.On("SubscriptionByName", models.EscalationSubscriptionName).Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("AddDeadLetter", mock.Anything).Return(notificationModels.DeadLetter{}, nil)
+	dbClientMock.On("NotificationsByStatus", 0, -1, "", models.New).Return([]models.Notification{n}, nil)
+	dbClientMock.On("AllSubscriptions", 0, -1).Return([]models.Subscription{resumedSub}, nil)
+	distributed := make(chan struct{}, 1)
+	dbClientMock.On("UpdateNotification", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		distributed <- struct{}{}
+	})
+	dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil).Run(after.record)
+	restSender := &senderMock.Sender{}
+	dic := resumeTestDic(dbClientMock, restSender)
+
+	require.NoError(t, RedistributePendingNotifications(dic))
+	require.Eventually(t, func() bool { return after.last().Status == models.Escalated }, 5*time.Second, 10*time.Millisecond)
+	select {
+	case <-distributed:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "the pending notification should be redistributed")
+	}
+
+	// the resend limit was already reached before the restart, and the redistribution doesn't transmit to the channel
+	restSender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	dbClientMock.AssertNotCalled(t, "AddTransmission", mock.Anything)
+}
+
+func TestResumeResendingTransmissions_TargetFailure(t *testing.T) {
+	tests := []struct {
+		name            string
+		notificationErr errors.EdgeX
+		expectedFailed  bool
+	}{
+		{"notification removed", errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil), true},
+		{"database not ready", errors.NewCommonEdgeX(errors.KindDatabaseError, "connection refused", nil), false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			stored := models.NewTransmission(sub.Name, testRestAddress2, exampleUUID)
+			stored.Id = exampleUUID
+			stored.Status = models.RESENDING
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("TransmissionsByStatus", 0, -1, models.RESENDING).Return([]models.Transmission{stored}, nil)
+			dbClientMock.On("TransmissionsByStatus", 0, -1, string(TransmissionStatusDeferred)).Return([]models.Transmission{}, nil)
+			dbClientMock.On("NotificationById", exampleUUID).Return(models.Notification{}, testCase.notificationErr)
+			dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil)
+			dic := resumeTestDic(dbClientMock, &senderMock.Sender{})
+
+			resumed, err := resumeResendingTransmissions(dic)
+			require.NoError(t, err)
+			if testCase.expectedFailed {
+				failed := stored
+				failed.Status = models.Failed
+				dbClientMock.AssertCalled(t, "UpdateTransmission", failed)
+				assert.Empty(t, resumed)
+				return
+			}
+			dbClientMock.AssertNotCalled(t, "UpdateTransmission", mock.Anything)
+			assert.Equal(t, []models.Transmission{stored}, resumed[exampleUUID], "the transmission left RESENDING should not be transmitted again")
+		})
+	}
+}
+
+func TestStoredNextAttempt(t *testing.T) {
+	nextAttempt := time.Now().Add(time.Minute).UTC()
+	sent := time.Now().Add(-time.Minute)
+	annotated := models.TransmissionRecord{Status: models.Failed, Response: "fail to send the request", Sent: sent.UnixMilli()}
+	annotateNextAttempt(&annotated, nextAttempt, channel.FailureClassServerError)
+
+	trans := models.Transmission{Records: []models.TransmissionRecord{annotated}}
+	assert.True(t, nextAttempt.Equal(storedNextAttempt(trans, time.Second)))
+	assert.Equal(t, channel.FailureClassServerError, storedFailureClass(trans))
+
+	trans.Records = []models.TransmissionRecord{{Status: models.Failed, Response: "fail to send the request", Sent: sent.UnixMilli()}}
+	assert.True(t, time.UnixMilli(sent.UnixMilli()).Add(time.Second).Equal(storedNextAttempt(trans, time.Second)), "the next attempt should fall back to the interval after the last send")
+	assert.Empty(t, storedFailureClass(trans))
+}
+
+func TestUndeliveredAddress(t *testing.T) {
+	address := models.EmailAddress{Recipients: []string{"a@example.com", "b@example.com", "c@example.com"}}
+	trans := models.Transmission{
+		Channel: address,
+		Records: []models.TransmissionRecord{
+			{Status: models.Sent, Response: "recipient a@example.com"},
+			{Status: models.Failed, Response: "recipient b@example.com: 550 mailbox unavailable"},
+			{Status: models.Sent, Response: "recipient c@example.com; failure class network; next attempt at 2025-01-01T00:00:00Z"},
+		},
+	}
+	undelivered, ok := undeliveredAddress(trans).(models.EmailAddress)
+	require.True(t, ok)
+	assert.Equal(t, []string{"b@example.com"}, undelivered.Recipients)
+
+	trans.Channel = testRestAddress
+	assert.Equal(t, testRestAddress, undeliveredAddress(trans))
+}
@@ -7,12 +7,14 @@ package application
 
 import (
 	"fmt"
+	"math/rand/v2"
 	"time"
 
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
@@ -21,19 +23,67 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
-// firstSend sends the notification and return the transmission
-func firstSend(dic *di.Container, n models.Notification, trans models.Transmission) models.Transmission {
+// RecipientResponsePrefix prefixes the response of the transmission record of an email recipient
+const RecipientResponsePrefix = "recipient"
+
+// sendResult is the result of sending the notification via a channel
+type sendResult struct {
+	// record is the transmission record of the send, whose status is SENT or FAILED
+	record models.TransmissionRecord
+	// failureClass is the class of the failure of the sender, which is empty if the notification isn't sent by the sender
+	failureClass channel.FailureClass
+	// failedRecipients maps the email recipients the send failed for to the failure responses, which is empty if the
+	// failed recipients are unknown, e.g. the SMTP server is unreachable
+	failedRecipients map[string]string
+}
+
+// firstSend sends the notification and return the transmission, along with the result of the send. The email
+// recipients over the MaxRecipientsPerNotification are dropped from the channel of the transmission, so they are not
+// resent either, and recorded in a FAILED record.
+func firstSend(dic *di.Container, n models.Notification, trans models.Transmission) (models.Transmission, sendResult) {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
-	record := sendNotificationViaChannel(dic, n, trans.Channel)
-	trans.Records = append(trans.Records, record)
-	trans.Status = record.Status
+	var dropped []string
+	trans.Channel, dropped = limitRecipients(dic, trans.SubscriptionName, trans.Channel)
+	if len(dropped) > 0 {
+		trans.Records = append(trans.Records, droppedRecipientsRecord(container.ConfigurationFrom(dic.Get).Writable.MaxRecipientsPerNotification, dropped))
+	}
+	result := sendForSubscription(dic, n, trans.SubscriptionName, trans.Channel)
+	trans.Records = append(trans.Records, recipientRecords(trans.Channel, result)...)
+	trans.Status = result.record.Status
+	recordDeliveryLatency(n, trans, result.record)
 	lc.Debugf("sent the notification to %s with address %v, transmission status %s", trans.SubscriptionName, trans.Channel.GetBaseAddress(), trans.Status)
-	return trans
+	return trans, result
+}
+
+// reSend sends the Critical notification and return the transmission. The resend limit is picked by the class of the
+// last failed send, starting from the last result of the first send. The email is only resent to the recipients the
+// last send failed for, so the recipients received the notification don't receive it again.
+func reSend(dic *di.Container, n models.Notification, sub models.Subscription, trans models.Transmission, last sendResult) (models.Transmission, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+
+	resendLimit, resendInterval, err := resendLimitAndInterval(config, sub)
+	if err != nil {
+		return trans, errors.NewCommonEdgeXWrapper(err)
+	}
+	limit := resendLimitByFailure(config, sub, resendLimit, last.failureClass)
+	nextAttempt := nextResendAttempt(config.Writable.ResendJitter, resendInterval)
+	if limit > 0 && len(trans.Records) > 0 {
+		// store the next attempt, so the stored transmission tells when it will be resent, and the resend is resumed on
+		// schedule if the service restarts before then
+		annotateNextAttempt(&trans.Records[len(trans.Records)-1], nextAttempt, last.failureClass)
+		if err = dbClient.UpdateTransmission(trans); err != nil {
+			return trans, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return resendFrom(dic, n, sub, trans, trans.Channel, last, nextAttempt)
 }
 
-// reSend sends the Critical notification and return the transmission
-func reSend(dic *di.Container, n models.Notification, sub models.Subscription, trans models.Transmission) (models.Transmission, errors.EdgeX) {
+// resendFrom resends the notification to the address from the next attempt after the ResendCount of the transmission,
+// the first of which is sent at the nextAttempt. Each attempt is stored along with the next attempt before waiting for
+// it, so the resend can be resumed without skipping or repeating attempts.
+func resendFrom(dic *di.Container, n models.Notification, sub models.Subscription, trans models.Transmission, address models.Address, last sendResult, nextAttempt time.Time) (models.Transmission, errors.EdgeX) {
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	config := container.ConfigurationFrom(dic.Get)
@@ -42,25 +92,39 @@ func reSend(dic *di.Container, n models.Notification, sub models.Subscription, t
 	if err != nil {
 		return trans, errors.NewCommonEdgeXWrapper(err)
 	}
-	for i := 1; i <= resendLimit; i++ {
+	limit := resendLimitByFailure(config, sub, resendLimit, last.failureClass)
+	for i := trans.ResendCount + 1; i <= limit; i++ {
 		// Since this sending process is triggered for the critical notification which is failed to send to the subscription at the first time,
 		// so we wait seconds and retry to send the notification again.
-		time.Sleep(resendInterval)
+		time.Sleep(time.Until(nextAttempt))
+		if opens, deferred := deliveryWindowOpening(dic, n, address); deferred {
+			// the resend is deferred to the delivery window instead of waiting for the window here
+			return deferTransmission(dic, n, trans, opens, last.failureClass)
+		}
 		lc.Warn("fail to send the critical notification. Retry to send again...")
 
-		record := sendNotificationViaChannel(dic, n, trans.Channel)
-		if record.Status == models.Failed {
-			// fail to transmit the notification, keep resending
+		address = retryAddress(address, last)
+		last = sendForSubscription(dic, n, trans.SubscriptionName, address)
+		records := recipientRecords(address, last)
+		if last.record.Status == models.Failed {
+			// fail to transmit the notification, keep resending within the limit of the failure class
 			trans.Status = models.RESENDING
+			limit = resendLimitByFailure(config, sub, resendLimit, last.failureClass)
+			nextAttempt = nextResendAttempt(config.Writable.ResendJitter, resendInterval)
+			if i < limit {
+				annotateNextAttempt(&records[len(records)-1], nextAttempt, last.failureClass)
+			}
 		} else {
-			trans.Status = record.Status
+			trans.Status = last.record.Status
 		}
 		trans.ResendCount = trans.ResendCount + 1
-		trans.Records = append(trans.Records, record)
+		trans.Records = append(trans.Records, records...)
+		recordDeliveryLatency(n, trans, last.record)
 		err = dbClient.UpdateTransmission(trans)
 		if err != nil {
 			return trans, errors.NewCommonEdgeXWrapper(err)
 		}
+		recordDispatchAudit(dic, attemptAuditRecord(notificationModels.DispatchAuditAttempted, trans))
 
 		if trans.Status == models.RESENDING {
 			continue
@@ -75,13 +139,61 @@ func reSend(dic *di.Container, n models.Notification, sub models.Subscription, t
 	if err != nil {
 		return trans, errors.NewCommonEdgeXWrapper(err)
 	}
+	recordDispatchAudit(dic, attemptAuditRecord(notificationModels.DispatchAuditEscalated, trans))
 	return trans, nil
 }
 
+// nextResendAttempt returns the time of the next resend attempt, which is the resend interval after now with the
+// jitter applied
+func nextResendAttempt(jitter config.ResendJitterInfo, interval time.Duration) time.Time {
+	return time.Now().Add(jitteredInterval(jitter, interval))
+}
+
+// jitteredInterval spreads the resend interval by the jitter, so the resends of many failed notifications don't hit a
+// recovering server at the same time. The Fraction of the interval is randomized: the full jitter picks the interval
+// from [interval*(1-Fraction), interval), and the equal jitter from [interval*(1-Fraction/2), interval).
+func jitteredInterval(jitter config.ResendJitterInfo, interval time.Duration) time.Duration {
+	fraction := min(max(jitter.Fraction, 0), 1)
+	switch jitter.Mode {
+	case config.JitterModeFull:
+	case config.JitterModeEqual:
+		fraction = fraction / 2
+	default:
+		return interval
+	}
+	spread := int64(float64(interval) * fraction)
+	if spread <= 0 {
+		return interval
+	}
+	return interval - time.Duration(spread) + time.Duration(rand.Int64N(spread))
+}
+
+// The annotations of the response of the failed transmission record, which store the resend schedule
+const (
+	failureClassAnnotation = "; failure class "
+	nextAttemptAnnotation  = "; next attempt at "
+)
+
+// annotateNextAttempt appends the failure class, if known, and the next attempt time to the response of the failed
+// transmission record
+func annotateNextAttempt(record *models.TransmissionRecord, nextAttempt time.Time, failureClass channel.FailureClass) {
+	if failureClass != "" {
+		record.Response = record.Response + failureClassAnnotation + string(failureClass)
+	}
+	record.Response = record.Response + nextAttemptAnnotation + nextAttempt.UTC().Format(time.RFC3339Nano)
+}
+
+// resendLimitAndInterval returns the resend limit and interval of the subscription. The ResendLimit and ResendInterval of
+// the subscription override the Writable.ResendLimit and Writable.ResendInterval, and the unset ones fall back to the
+// Writable defaults. As the zero ResendLimit is unset, the negative ResendLimit disables the resends of the subscription,
+// e.g. the noisy subscriptions whose notifications are only sent once. The Writable.ResendLimitByFailure of the class of
+// the last failure supersedes the Writable default but not the subscription's limit, see resendLimitByFailure.
 func resendLimitAndInterval(config *config.ConfigurationStruct, sub models.Subscription) (int, time.Duration, errors.EdgeX) {
 	resendLimit := config.Writable.ResendLimit
 	if sub.ResendLimit > 0 {
 		resendLimit = sub.ResendLimit
+	} else if sub.ResendLimit < 0 {
+		resendLimit = 0
 	}
 	resendInterval := config.Writable.ResendInterval
 	if sub.ResendInterval != "" {
@@ -94,6 +206,64 @@ func resendLimitAndInterval(config *config.ConfigurationStruct, sub models.Subsc
 	return resendLimit, resendIntervalDuration, nil
 }
 
+// retryAddress returns the address to resend the notification to, which is the email address narrowed to the
+// recipients the last send failed for, or the address itself
+func retryAddress(address models.Address, last sendResult) models.Address {
+	emailAddress, ok := address.(models.EmailAddress)
+	if !ok || len(last.failedRecipients) == 0 {
+		return address
+	}
+	var recipients []string
+	for _, recipient := range emailAddress.Recipients {
+		if _, failed := last.failedRecipients[recipient]; failed {
+			recipients = append(recipients, recipient)
+		}
+	}
+	emailAddress.Recipients = recipients
+	return emailAddress
+}
+
+// recipientRecords returns the transmission records of the send result. The email delivered to or rejected by the SMTP
+// server is recorded per recipient, so the delivery to each recipient is visible when the send failed for some of the
+// recipients. The Response of each recipient record is prefixed by RecipientResponsePrefix and the recipient. The email
+// failed without the per-recipient responses, e.g. the SMTP server is unreachable, is recorded once.
+func recipientRecords(address models.Address, result sendResult) []models.TransmissionRecord {
+	emailAddress, ok := address.(models.EmailAddress)
+	if !ok || len(emailAddress.Recipients) == 0 || (result.record.Status == models.Failed && len(result.failedRecipients) == 0) {
+		return []models.TransmissionRecord{result.record}
+	}
+	records := make([]models.TransmissionRecord, len(emailAddress.Recipients))
+	for i, recipient := range emailAddress.Recipients {
+		record := result.record
+		record.Status = models.Sent
+		record.Response = fmt.Sprintf("%s %s", RecipientResponsePrefix, recipient)
+		if response, failed := result.failedRecipients[recipient]; failed {
+			record.Status = models.Failed
+			record.Response = fmt.Sprintf("%s: %s", record.Response, response)
+		}
+		records[i] = record
+	}
+	return records
+}
+
+// resendLimitByFailure returns the resend limit of the last failure from the resendLimit of resendLimitAndInterval. The
+// limits are applied in the order of:
+//  1. the negative ResendLimit of the subscription, which disables the resends regardless of the failure class
+//  2. the resend limit of the failure class in Writable.ResendLimitByFailure, capped by the positive ResendLimit of
+//     the subscription
+//  3. the resendLimit, if the failure class has no resend limit configured
+func resendLimitByFailure(config *config.ConfigurationStruct, sub models.Subscription, resendLimit int, failureClass channel.FailureClass) int {
+	limit, ok := config.Writable.ResendLimitByFailure[string(failureClass)]
+	if !ok || failureClass == "" {
+		return resendLimit
+	}
+	if sub.ResendLimit != 0 {
+		// the resendLimit is the subscription's limit, or zero if the subscription disables the resends
+		return min(limit, resendLimit)
+	}
+	return limit
+}
+
 // escalatedSend handle the escalated notification for the ESCALATION subscription
 func escalatedSend(dic *di.Container, n models.Notification, trans models.Transmission) errors.EdgeX {
 	dbClient := container.DBClientFrom(dic.Get)
@@ -112,7 +282,8 @@ func escalatedSend(dic *di.Container, n models.Notification, trans models.Transm
 	}
 
 	for _, address := range sub.Channels {
-		go transmit(dic, escalated, sub, address) // nolint:errcheck
+		// The escalated transmissions are drained along with the original notification
+		dispatchDrainerFrom(dic.Get).join(n, true, func() { transmit(dic, escalated, sub, address) }) // nolint:errcheck
 	}
 	return nil
 }
@@ -126,13 +297,45 @@ func escalatedNotification(n models.Notification, trans models.Transmission) mod
 	return n
 }
 
-// sendNotificationViaChannel sends notification via address and return the transmission record. The record status should be SENT or FAILED.
-func sendNotificationViaChannel(dic *di.Container, n models.Notification, address models.Address) (transRecord models.TransmissionRecord) {
+// sendNotificationViaChannel sends notification via address and return the send result. The record status should be SENT or FAILED.
+func sendNotificationViaChannel(dic *di.Container, n models.Notification, address models.Address) sendResult {
+	var transRecord models.TransmissionRecord
 	var err errors.EdgeX
 	transRecord.Status = models.Sent
-	switch address.GetBaseAddress().Type {
+	channelType := address.GetBaseAddress().Type
+	limit := container.ConfigurationFrom(dic.Get).Writable.Channel.ChannelLimit(channelType)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	key := circuitKey(address)
+	circuitBreaker := channelCircuitBreakerFrom(dic.Get)
+	if !circuitBreaker.allow(key, limit, lc) {
+		// short-circuit the send to the failing endpoint, the critical notification is resent later
+		transRecord.Status = models.Failed
+		transRecord.Response = fmt.Sprintf("%s: the circuit of the %s endpoint is open", CircuitOpenResponse, channelType)
+		transRecord.Sent = pkgCommon.MakeTimestamp()
+		return sendResult{record: transRecord}
+	}
+	limiter := inFlightLimiterFrom(dic.Get)
+	if err = limiter.acquire(channelType, limit); err != nil {
+		// fail the send rather than piling up the in-flight sends, the critical notification is resent later
+		transRecord.Status = models.Failed
+		transRecord.Response = err.Error()
+		transRecord.Sent = pkgCommon.MakeTimestamp()
+		return sendResult{record: transRecord}
+	}
+	defer limiter.release(channelType)
+
+	switch channelType {
 	case common.REST:
+		// the REST channels with the webhook, slack and teams schemes are delivered to the configured endpoints
 		restSender := channel.RESTSenderFrom(dic.Get)
+		switch {
+		case channel.IsWebhookAddress(address):
+			restSender = channel.WebhookSenderFrom(dic.Get)
+		case channel.IsSlackAddress(address):
+			restSender = channel.SlackSenderFrom(dic.Get)
+		case channel.IsTeamsAddress(address):
+			restSender = channel.TeamsSenderFrom(dic.Get)
+		}
 		transRecord.Response, err = restSender.Send(n, address)
 	case common.EMAIL:
 		emailSender := channel.EmailSenderFrom(dic.Get)
@@ -144,14 +347,20 @@ func sendNotificationViaChannel(dic *di.Container, n models.Notification, addres
 		zeroMQSender := channel.ZeroMQSenderFrom(dic.Get)
 		transRecord.Response, err = zeroMQSender.Send(n, address)
 	default:
-		transRecord.Response = fmt.Sprintf("unsupported address type: %s", address.GetBaseAddress().Type)
-		return transRecord
+		transRecord.Response = fmt.Sprintf("unsupported address type: %s", channelType)
+		return sendResult{record: transRecord}
 	}
 
+	failedRecipients := channel.FailedRecipients(err)
+	// the endpoint is healthy if the send only failed for some of the recipients
+	circuitBreaker.record(key, limit, err == nil || len(failedRecipients) > 0, lc)
+	result := sendResult{failedRecipients: failedRecipients}
 	if err != nil {
 		transRecord.Status = models.Failed
 		transRecord.Response = err.Error()
+		result.failureClass = channel.ClassifyFailure(err)
 	}
 	transRecord.Sent = pkgCommon.MakeTimestamp()
-	return transRecord
+	result.record = transRecord
+	return result
 }
@@ -6,11 +6,16 @@
 package application
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
 	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
@@ -58,6 +63,18 @@ var testRestAddress2 = models.RESTAddress{
 	HTTPMethod:  http.MethodGet,
 	Path:        "path2",
 }
+var testWebhookAddress = models.RESTAddress{
+	BaseAddress: models.BaseAddress{Type: common.REST, Scheme: channel.WebhookScheme, Host: testHost, Port: testPort},
+	HTTPMethod:  http.MethodPost,
+}
+var testSlackAddress = models.RESTAddress{
+	BaseAddress: models.BaseAddress{Type: common.REST, Scheme: channel.SlackScheme, Host: testHost, Port: testPort},
+	HTTPMethod:  http.MethodPost,
+}
+var testTeamsAddress = models.RESTAddress{
+	BaseAddress: models.BaseAddress{Type: common.REST, Scheme: channel.TeamsScheme, Host: testHost, Port: testPort},
+	HTTPMethod:  http.MethodPost,
+}
 var testEmailAddress = models.EmailAddress{
 	BaseAddress: models.BaseAddress{Type: common.EMAIL, Host: testHost, Port: testPort},
 	Recipients:  []string{"test@gamil.com"},
@@ -75,6 +92,12 @@ func TestFirstSend(t *testing.T) {
 	emailSender := &senderMock.Sender{}
 	emailSender.On("Send", notification, testEmailAddress).Return("", nil)
 	emailSender.On("Send", notification, testEmailAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the email", nil))
+	webhookSender := &senderMock.Sender{}
+	webhookSender.On("Send", notification, testWebhookAddress).Return("", nil)
+	slackSender := &senderMock.Sender{}
+	slackSender.On("Send", notification, testSlackAddress).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to post the message", nil))
+	teamsSender := &senderMock.Sender{}
+	teamsSender.On("Send", notification, testTeamsAddress).Return("1", nil)
 	dic.Update(di.ServiceConstructorMap{
 		channel.RESTSenderName: func(get di.Get) interface{} {
 			return restSender
@@ -82,6 +105,15 @@ func TestFirstSend(t *testing.T) {
 		channel.EmailSenderName: func(get di.Get) interface{} {
 			return emailSender
 		},
+		channel.WebhookSenderName: func(get di.Get) interface{} {
+			return webhookSender
+		},
+		channel.SlackSenderName: func(get di.Get) interface{} {
+			return slackSender
+		},
+		channel.TeamsSenderName: func(get di.Get) interface{} {
+			return teamsSender
+		},
 	})
 
 	tests := []struct {
@@ -91,6 +123,9 @@ func TestFirstSend(t *testing.T) {
 	}{
 		{"sent rest address successful", testRestAddress, false},
 		{"sent email address successful", testEmailAddress, false},
+		{"sent webhook address successful", testWebhookAddress, false},
+		{"sent slack failed", testSlackAddress, true},
+		{"sent teams address successful", testTeamsAddress, false},
 		{"sent rest failed", testRestAddress2, true},
 		{"sent email failed", testEmailAddress2, true},
 	}
@@ -99,7 +134,7 @@ func TestFirstSend(t *testing.T) {
 			sub.Channels = []models.Address{testCase.address}
 			trans := models.NewTransmission(sub.Name, testCase.address, notification.Id)
 
-			trans = firstSend(dic, notification, trans)
+			trans, _ = firstSend(dic, notification, trans)
 
 			assert.Equal(t, 1, len(trans.Records))
 			if testCase.expectedError {
@@ -109,6 +144,13 @@ func TestFirstSend(t *testing.T) {
 			}
 		})
 	}
+	// the REST channels with the webhook, slack and teams schemes are delivered to the endpoints rather than their host and port
+	webhookSender.AssertNumberOfCalls(t, "Send", 1)
+	slackSender.AssertNumberOfCalls(t, "Send", 1)
+	teamsSender.AssertNumberOfCalls(t, "Send", 1)
+	restSender.AssertNotCalled(t, "Send", notification, testWebhookAddress)
+	restSender.AssertNotCalled(t, "Send", notification, testSlackAddress)
+	restSender.AssertNotCalled(t, "Send", notification, testTeamsAddress)
 }
 
 func TestReSend(t *testing.T) {
@@ -152,7 +194,7 @@ func TestReSend(t *testing.T) {
 			sub.Channels = []models.Address{testCase.address}
 			trans := models.NewTransmission(sub.Name, testCase.address, notification.Id)
 
-			trans, err := reSend(dic, notification, sub, trans)
+			trans, err := reSend(dic, notification, sub, trans, sendResult{})
 			require.NoError(t, err)
 
 			if testCase.expectedError {
@@ -167,3 +209,256 @@ func TestReSend(t *testing.T) {
 		})
 	}
 }
+
+func TestJitteredInterval(t *testing.T) {
+	interval := 10 * time.Second
+	tests := []struct {
+		name        string
+		jitter      config.ResendJitterInfo
+		expectedMin time.Duration
+	}{
+		{"no jitter", config.ResendJitterInfo{Mode: config.JitterModeNone, Fraction: 1}, interval},
+		{"empty mode", config.ResendJitterInfo{Fraction: 1}, interval},
+		{"full jitter", config.ResendJitterInfo{Mode: config.JitterModeFull, Fraction: 1}, 0},
+		{"partial full jitter", config.ResendJitterInfo{Mode: config.JitterModeFull, Fraction: 0.2}, 8 * time.Second},
+		{"equal jitter", config.ResendJitterInfo{Mode: config.JitterModeEqual, Fraction: 1}, 5 * time.Second},
+		{"fraction above one", config.ResendJitterInfo{Mode: config.JitterModeFull, Fraction: 2}, 0},
+		{"zero fraction", config.ResendJitterInfo{Mode: config.JitterModeFull}, interval},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				result := jitteredInterval(testCase.jitter, interval)
+				assert.GreaterOrEqual(t, result, testCase.expectedMin)
+				assert.LessOrEqual(t, result, interval)
+			}
+		})
+	}
+}
+
+func TestReSend_Jitter(t *testing.T) {
+	dic := mockDic()
+	configuration := notificationContainer.ConfigurationFrom(dic.Get)
+	configuration.Writable.ResendInterval = "10ms"
+	configuration.Writable.ResendJitter = config.ResendJitterInfo{Mode: config.JitterModeFull, Fraction: 1}
+	defer func() { configuration.Writable.ResendJitter = config.ResendJitterInfo{} }()
+	var stored []models.Transmission
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		stored = append(stored, args.Get(0).(models.Transmission))
+	})
+	restSender := &senderMock.Sender{}
+	restSender.On("Send", notification, testRestAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the request", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		channel.RESTSenderName: func(get di.Get) interface{} {
+			return restSender
+		},
+	})
+
+	trans := models.NewTransmission(sub.Name, testRestAddress2, notification.Id)
+	trans.Records = []models.TransmissionRecord{{Status: models.Failed, Response: "fail to send the request"}}
+	trans, err := reSend(dic, notification, sub, trans, sendResult{})
+	require.NoError(t, err)
+	assert.EqualValues(t, models.Escalated, trans.Status)
+
+	require.NotEmpty(t, stored)
+	firstRecord := stored[0].Records[0]
+	assert.Contains(t, firstRecord.Response, "next attempt at", "the next attempt should be stored before waiting")
+	nextAttempt, parseErr := time.Parse(time.RFC3339Nano, firstRecord.Response[strings.LastIndex(firstRecord.Response, " ")+1:])
+	require.NoError(t, parseErr)
+	assert.WithinDuration(t, time.Now(), nextAttempt, time.Second)
+	lastRecord := trans.Records[len(trans.Records)-1]
+	assert.NotContains(t, lastRecord.Response, "next attempt at", "no next attempt after the last resend")
+}
+
+func TestReSend_ResendLimitByFailure(t *testing.T) {
+	dic := mockDic()
+	configuration := notificationContainer.ConfigurationFrom(dic.Get)
+	configuration.Writable.ResendInterval = "1ms"
+	configuration.Writable.ResendLimitByFailure = map[string]int{
+		string(channel.FailureClassClientError):     0,
+		string(channel.FailureClassTooManyRequests): 4,
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil)
+	statusError := func(code int) errors.EdgeX {
+		return errors.NewCommonEdgeX(errors.KindMapping(code), "", utils.HTTPStatusError{StatusCode: code})
+	}
+	restSender := &senderMock.Sender{}
+	restSender.On("Send", notification, testRestAddress).Return("", statusError(http.StatusTooManyRequests))
+	restSender.On("Send", notification, testRestAddress2).Return("", statusError(http.StatusInternalServerError))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		channel.RESTSenderName: func(get di.Get) interface{} {
+			return restSender
+		},
+	})
+
+	tests := []struct {
+		name                string
+		address             models.Address
+		firstFailure        channel.FailureClass
+		subResendLimit      int
+		expectedResendCount int
+	}{
+		{"4xx is not resent", testRestAddress, channel.FailureClassClientError, 0, 0},
+		{"429 is resent up to its limit", testRestAddress, channel.FailureClassTooManyRequests, 0, 4},
+		{"5xx keeps the ResendLimit", testRestAddress2, channel.FailureClassServerError, 0, configuration.Writable.ResendLimit},
+		{"limit follows the last failure", testRestAddress2, channel.FailureClassTooManyRequests, 0, configuration.Writable.ResendLimit},
+		{"subscription sent only once is not resent on 429", testRestAddress, channel.FailureClassTooManyRequests, -1, 0},
+		{"subscription limit caps the 429 limit", testRestAddress, channel.FailureClassTooManyRequests, 2, 2},
+		{"429 limit below the subscription limit", testRestAddress, channel.FailureClassTooManyRequests, 10, 4},
+		{"subscription limit keeps the 4xx limit", testRestAddress, channel.FailureClassClientError, 10, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := sub
+			s.ResendLimit = testCase.subResendLimit
+			trans := models.NewTransmission(s.Name, testCase.address, notification.Id)
+			trans, err := reSend(dic, notification, s, trans, sendResult{failureClass: testCase.firstFailure})
+			require.NoError(t, err)
+			assert.EqualValues(t, models.Escalated, trans.Status)
+			assert.Equal(t, testCase.expectedResendCount, trans.ResendCount)
+		})
+	}
+}
+
+func TestResendLimitAndInterval(t *testing.T) {
+	configuration := &config.ConfigurationStruct{Writable: config.WritableInfo{ResendLimit: 2, ResendInterval: "1s"}}
+
+	tests := []struct {
+		name             string
+		resendLimit      int
+		resendInterval   string
+		expectedLimit    int
+		expectedInterval time.Duration
+		expectedError    bool
+	}{
+		{"fall back to the Writable defaults", 0, "", 2, time.Second, false},
+		{"override the limit", 10, "", 10, time.Second, false},
+		{"override the interval", 0, "5m", 2, 5 * time.Minute, false},
+		{"override both", 1, "10s", 1, 10 * time.Second, false},
+		{"negative limit disables the resends", -1, "", 0, time.Second, false},
+		{"invalid interval", 0, "soon", 0, 0, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := models.Subscription{Name: "sub", ResendLimit: testCase.resendLimit, ResendInterval: testCase.resendInterval}
+			limit, interval, err := resendLimitAndInterval(configuration, s)
+			if testCase.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedLimit, limit)
+			assert.Equal(t, testCase.expectedInterval, interval)
+		})
+	}
+}
+
+func TestReSend_SubscriptionResendLimit(t *testing.T) {
+	dic := mockDic()
+	configuration := notificationContainer.ConfigurationFrom(dic.Get)
+	configuration.Writable.ResendInterval = "1s"
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil)
+	restSender := &senderMock.Sender{}
+	restSender.On("Send", notification, testRestAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the request", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		channel.RESTSenderName: func(get di.Get) interface{} {
+			return restSender
+		},
+	})
+
+	tests := []struct {
+		name                string
+		resendLimit         int
+		expectedResendCount int
+	}{
+		{"noisy subscription is only sent once", -1, 0},
+		{"critical subscription is resent up to its limit", 5, 5},
+		{"unset limit falls back to the ResendLimit", 0, configuration.Writable.ResendLimit},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := sub
+			s.ResendLimit = testCase.resendLimit
+			// the interval of the subscription overrides the one second of the Writable.ResendInterval
+			s.ResendInterval = "1ms"
+			trans := models.NewTransmission(s.Name, testRestAddress2, notification.Id)
+			trans, err := reSend(dic, notification, s, trans, sendResult{})
+			require.NoError(t, err)
+			assert.EqualValues(t, models.Escalated, trans.Status)
+			assert.Equal(t, testCase.expectedResendCount, trans.ResendCount)
+		})
+	}
+}
+
+func TestReSend_FailedRecipients(t *testing.T) {
+	dic := mockDic()
+	configuration := notificationContainer.ConfigurationFrom(dic.Get)
+	configuration.Writable.ResendInterval = "1ms"
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil)
+	address := models.EmailAddress{
+		BaseAddress: models.BaseAddress{Type: common.EMAIL, Host: testHost, Port: testPort},
+		Recipients:  []string{"a@example.com", "b@example.com", "c@example.com"},
+	}
+	rejected := errors.NewCommonEdgeX(errors.KindServerError, "", channel.RecipientsError{
+		Failures: []channel.RecipientFailure{{Recipient: "b@example.com", Err: fmt.Errorf("550 mailbox unavailable")}},
+	})
+	retried := address
+	retried.Recipients = []string{"b@example.com"}
+	emailSender := &senderMock.Sender{}
+	emailSender.On("Send", notification, address).Return("", rejected)
+	emailSender.On("Send", notification, retried).Return("", nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		channel.EmailSenderName: func(get di.Get) interface{} {
+			return emailSender
+		},
+	})
+
+	trans, result := firstSend(dic, notification, models.NewTransmission(sub.Name, address, notification.Id))
+	assert.EqualValues(t, models.Failed, trans.Status)
+	require.Len(t, trans.Records, 3, "the email send is recorded per recipient")
+	assert.EqualValues(t, models.Sent, trans.Records[0].Status)
+	assert.Equal(t, "recipient a@example.com", trans.Records[0].Response)
+	assert.EqualValues(t, models.Failed, trans.Records[1].Status)
+	assert.Equal(t, "recipient b@example.com: 550 mailbox unavailable", trans.Records[1].Response)
+	assert.EqualValues(t, models.Sent, trans.Records[2].Status)
+
+	trans, err := reSend(dic, notification, sub, trans, result)
+	require.NoError(t, err)
+	assert.EqualValues(t, models.Sent, trans.Status)
+	assert.Equal(t, 1, trans.ResendCount)
+	require.Len(t, trans.Records, 4)
+	assert.EqualValues(t, models.Sent, trans.Records[3].Status)
+	assert.Equal(t, "recipient b@example.com", trans.Records[3].Response)
+	emailSender.AssertCalled(t, "Send", notification, retried)
+}
+
+func TestRecipientRecords(t *testing.T) {
+	address := models.EmailAddress{Recipients: []string{"a@example.com", "b@example.com"}}
+	failed := models.TransmissionRecord{Status: models.Failed, Response: "fail to connected the SMTP server"}
+
+	records := recipientRecords(address, sendResult{record: failed})
+	assert.Equal(t, []models.TransmissionRecord{failed}, records, "the send failed without the per-recipient responses is recorded once")
+
+	records = recipientRecords(testRestAddress, sendResult{record: failed})
+	assert.Equal(t, []models.TransmissionRecord{failed}, records)
+
+	records = recipientRecords(address, sendResult{record: models.TransmissionRecord{Status: models.Sent}})
+	require.Len(t, records, 2)
+	assert.Equal(t, "recipient b@example.com", records[1].Response)
+}
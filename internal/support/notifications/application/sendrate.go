@@ -0,0 +1,104 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	gometrics "github.com/rcrowley/go-metrics"
+)
+
+const subscriptionSendTokensMetricName = "SubscriptionSendTokens"
+
+// sendRateLimiter throttles the sends to the channels of each subscription with a token bucket, which holds up to Burst
+// tokens and is refilled at the MaxSendRate tokens per second
+type sendRateLimiter struct {
+	mutex   sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+type tokenBucket struct {
+	// tokens is negative when the sends are waiting for the tokens reserved ahead
+	tokens float64
+	last   time.Time
+	gauge  gometrics.GaugeFloat64
+}
+
+func newSendRateLimiter() *sendRateLimiter {
+	return &sendRateLimiter{buckets: make(map[string]*tokenBucket)}
+}
+
+// subscriptionSendRate returns the send rate of the subscription, "*" sets the rate of the subscriptions not listed
+func subscriptionSendRate(writable config.WritableInfo, subscriptionName string) config.SendRate {
+	rate, ok := writable.SubscriptionSendRates[subscriptionName]
+	if !ok {
+		rate = writable.SubscriptionSendRates[allSubscriptionsKey]
+	}
+	return rate
+}
+
+// reserve takes a token of the subscription at now, and returns how long the send must wait for the token. The full
+// bucket allows Burst sends at once. The rate without a positive MaxSendRate is unlimited, and the Burst less than 1
+// is 1, as the rates are validated on start but can be changed at runtime. The gauge of the subscription is created
+// and passed to register on its first send.
+func (l *sendRateLimiter) reserve(subscriptionName string, rate config.SendRate, now time.Time, register func(gometrics.GaugeFloat64)) time.Duration {
+	if rate.MaxSendRate <= 0 {
+		return 0
+	}
+	burst := float64(max(rate.Burst, 1))
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	bucket, ok := l.buckets[subscriptionName]
+	if !ok {
+		bucket = &tokenBucket{tokens: burst, last: now, gauge: gometrics.NewGaugeFloat64()}
+		l.buckets[subscriptionName] = bucket
+		register(bucket.gauge)
+	}
+	if now.After(bucket.last) {
+		bucket.tokens = min(burst, bucket.tokens+now.Sub(bucket.last).Seconds()*rate.MaxSendRate)
+		bucket.last = now
+	}
+	bucket.tokens--
+	bucket.gauge.Update(bucket.tokens)
+	if bucket.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-bucket.tokens / rate.MaxSendRate * float64(time.Second))
+}
+
+// waitForSendRate waits until the send to the channel of the subscription is within its SubscriptionSendRates
+func waitForSendRate(dic *di.Container, subscriptionName string) {
+	rate := subscriptionSendRate(container.ConfigurationFrom(dic.Get).Writable, subscriptionName)
+	wait := sendRateLimiterFrom(dic.Get).reserve(subscriptionName, rate, time.Now(), func(gauge gometrics.GaugeFloat64) {
+		registerSubscriptionSendTokensMetric(dic, subscriptionName, gauge)
+	})
+	if wait > 0 {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Debugf("the send of the subscription %s waits %s for the send rate", subscriptionName, wait)
+		time.Sleep(wait)
+	}
+}
+
+func registerSubscriptionSendTokensMetric(dic *di.Container, subscriptionName string, gauge gometrics.GaugeFloat64) {
+	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
+	if metricsManager == nil {
+		return
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	name := subscriptionSendTokensMetricName + subscriptionName
+	if err := metricsManager.Register(name, gauge, map[string]string{"subscription": subscriptionName}); err != nil {
+		lc.Errorf("%s metrics will not be collected: %s", name, err.Error())
+		return
+	}
+	lc.Debugf("Registered metrics gauge %s", name)
+}
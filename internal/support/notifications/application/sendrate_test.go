@@ -0,0 +1,79 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gometrics "github.com/rcrowley/go-metrics"
+)
+
+func TestSubscriptionSendRate(t *testing.T) {
+	writable := config.WritableInfo{SubscriptionSendRates: map[string]config.SendRate{"pager": {MaxSendRate: 2, Burst: 20}}}
+	assert.Equal(t, config.SendRate{MaxSendRate: 2, Burst: 20}, subscriptionSendRate(writable, "pager"))
+	assert.Equal(t, config.SendRate{}, subscriptionSendRate(writable, "unlisted"), "the unlisted subscription should be unlimited")
+
+	writable.SubscriptionSendRates[allSubscriptionsKey] = config.SendRate{MaxSendRate: 10, Burst: 50}
+	assert.Equal(t, config.SendRate{MaxSendRate: 10, Burst: 50}, subscriptionSendRate(writable, "unlisted"))
+}
+
+func TestSendRateLimiter_Burst(t *testing.T) {
+	limiter := newSendRateLimiter()
+	rate := config.SendRate{MaxSendRate: 2, Burst: 3}
+	now := time.Now()
+	var gauge gometrics.GaugeFloat64
+	register := func(g gometrics.GaugeFloat64) { gauge = g }
+
+	for i := 0; i < 3; i++ {
+		assert.Zero(t, limiter.reserve("pager", rate, now, register), "the sends up to the Burst should not wait")
+	}
+	require.NotNil(t, gauge, "the token gauge should be registered on the first send")
+	assert.Zero(t, gauge.Value())
+	assert.Equal(t, 500*time.Millisecond, limiter.reserve("pager", rate, now, register), "the send over the Burst should wait for the steady rate")
+	assert.Equal(t, time.Second, limiter.reserve("pager", rate, now, register))
+	assert.Equal(t, float64(-2), gauge.Value(), "the negative tokens should show the waiting sends")
+
+	// the bucket is refilled up to the Burst
+	later := now.Add(time.Minute)
+	for i := 0; i < 3; i++ {
+		assert.Zero(t, limiter.reserve("pager", rate, later, register))
+	}
+	assert.Greater(t, limiter.reserve("pager", rate, later, register), time.Duration(0))
+
+	assert.Zero(t, limiter.reserve("unlimited", config.SendRate{}, now, register))
+	assert.Zero(t, limiter.reserve("unlimited", config.SendRate{}, now, register))
+}
+
+func TestValidateSubscriptionSendRates(t *testing.T) {
+	tests := []struct {
+		name          string
+		rate          config.SendRate
+		errorExpected bool
+	}{
+		{"valid", config.SendRate{MaxSendRate: 2, Burst: 20}, false},
+		{"unlimited without burst", config.SendRate{}, false},
+		{"burst of 1", config.SendRate{MaxSendRate: 0.5, Burst: 1}, false},
+		{"rate without burst", config.SendRate{MaxSendRate: 2}, true},
+		{"negative rate", config.SendRate{MaxSendRate: -1, Burst: 1}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			writable := config.WritableInfo{SubscriptionSendRates: map[string]config.SendRate{"pager": testCase.rate}}
+			err := writable.ValidateSubscriptionSendRates()
+			if testCase.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// severityAtLeast returns whether the severity ranks at least as high as the threshold in the configured
+// Writable.SeverityOrder. The unknown severities rank below all the known ones.
+func severityAtLeast(dic *di.Container, severity models.NotificationSeverity, threshold models.NotificationSeverity) bool {
+	writable := container.ConfigurationFrom(dic.Get).Writable
+	return writable.SeverityRank(string(severity)) >= writable.SeverityRank(string(threshold))
+}
@@ -0,0 +1,48 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeverityOrder(t *testing.T) {
+	custom := config.WritableInfo{SeverityOrder: []string{"MINOR", "NORMAL", "CRITICAL", "EMERGENCY"}}
+	assert.NoError(t, custom.ValidateSeverityOrder())
+	assert.NoError(t, config.WritableInfo{}.ValidateSeverityOrder(), "empty uses the default ordering")
+	assert.Error(t, config.WritableInfo{SeverityOrder: []string{"NORMAL", "CRITICAL"}}.ValidateSeverityOrder(), "the built-in severities are required")
+	assert.Error(t, config.WritableInfo{SeverityOrder: []string{"MINOR", "NORMAL", "CRITICAL", "MINOR"}}.ValidateSeverityOrder())
+	assert.Error(t, config.WritableInfo{SeverityOrder: []string{"MINOR", "NORMAL", "CRITICAL", ""}}.ValidateSeverityOrder())
+
+	invalid := config.WritableInfo{SeverityOrder: []string{"CRITICAL"}}
+	assert.Equal(t, 2, invalid.SeverityRank("CRITICAL"), "the default ordering is used when the SeverityOrder is invalid")
+	assert.Equal(t, -1, custom.SeverityRank("UNKNOWN"))
+
+	configuration := &config.ConfigurationStruct{}
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return configuration
+		},
+	})
+	assert.True(t, severityAtLeast(dic, models.Critical, models.Critical))
+	assert.False(t, severityAtLeast(dic, models.Normal, models.Critical))
+
+	configuration.Writable = custom
+	assert.True(t, severityAtLeast(dic, "EMERGENCY", models.Critical))
+	assert.False(t, severityAtLeast(dic, "UNKNOWN", models.Minor))
+
+	// the deployments can rank the built-in severities differently, e.g. treat NORMAL as the most severe
+	configuration.Writable.SeverityOrder = []string{"MINOR", "CRITICAL", "NORMAL"}
+	assert.True(t, severityAtLeast(dic, models.Normal, models.Critical))
+}
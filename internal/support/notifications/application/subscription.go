@@ -8,6 +8,8 @@ package application
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
@@ -24,16 +26,36 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
+// allSubscriptionsKey is the key of the per-subscription Writable settings, i.e. the DeliveryConcurrency, the
+// DeliveryPolicy and the SubscriptionSendRates, which sets the value of the subscriptions not listed
+const allSubscriptionsKey = "*"
+
 // The AddSubscription function accepts the new Subscription model from the controller function
 // and then invokes AddSubscription function of infrastructure layer to add new Subscription
 func AddSubscription(d models.Subscription, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
+	if err := validateSubscriptionCategories(d, dic); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := validateSubscriptionRecipients(d, dic); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := validateSubscriptionExpiry(d, true); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := validateSubscriptionTemplate(dbClient, d); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := validateSubscriptionLanguage(d); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
 	addedSubscription, err := dbClient.AddSubscription(d)
 	if err != nil {
 		return "", errors.NewCommonEdgeXWrapper(err)
 	}
+	subscriptionIndexFrom(dic.Get).invalidate()
 
 	lc.Debugf("Subscription created on DB successfully. Subscription ID: %s, Correlation-ID: %s ",
 		addedSubscription.Id,
@@ -180,9 +202,50 @@ func DeleteSubscriptionByName(name string, ctx context.Context, dic *di.Containe
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	subscriptionIndexFrom(dic.Get).invalidate()
 	return nil
 }
 
+// BulkToggleSubscriptionsByLabel enables or disables all the subscriptions carrying the label by setting their
+// AdminState to UNLOCKED or LOCKED, and returns the names of the subscriptions whose AdminState is changed. With dryRun,
+// the names are returned without updating any subscription.
+func BulkToggleSubscriptionsByLabel(label string, enabled bool, dryRun bool, ctx context.Context, dic *di.Container) ([]string, errors.EdgeX) {
+	if label == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "label is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	subscriptions, err := dbClient.SubscriptionsByLabel(0, -1, label)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	adminState := models.AdminState(models.Locked)
+	if enabled {
+		adminState = models.Unlocked
+	}
+
+	affected := []string{}
+	for _, s := range subscriptions {
+		if s.AdminState == adminState {
+			continue
+		}
+		if !dryRun {
+			s.AdminState = adminState
+			if err = dbClient.UpdateSubscription(s); err != nil {
+				subscriptionIndexFrom(dic.Get).invalidate()
+				return affected, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to set the AdminState of the subscription %s to %s", s.Name, adminState), err)
+			}
+		}
+		affected = append(affected, s.Name)
+	}
+	if !dryRun && len(affected) > 0 {
+		subscriptionIndexFrom(dic.Get).invalidate()
+		lc.Debugf("%d subscriptions with label %s are set to %s. Correlation-ID: %s ", len(affected), label, adminState, correlation.FromContext(ctx))
+	}
+	return affected, nil
+}
+
 // PatchSubscription executes the PATCH operation with the subscription DTO to replace the old data
 func PatchSubscription(ctx context.Context, dto dtos.UpdateSubscription, dic *di.Container) errors.EdgeX {
 	dbClient := container.DBClientFrom(dic.Get)
@@ -198,11 +261,24 @@ func PatchSubscription(ctx context.Context, dto dtos.UpdateSubscription, dic *di
 	if len(subscription.Categories) == 0 && len(subscription.Labels) == 0 {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "subscription categories and labels can not be both empty", nil)
 	}
+	if err = validateSubscriptionTemplate(dbClient, subscription); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err = validateSubscriptionLanguage(subscription); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err = validateSubscriptionRecipients(subscription, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err = validateSubscriptionExpiry(subscription, false); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	err = dbClient.UpdateSubscription(subscription)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	subscriptionIndexFrom(dic.Get).invalidate()
 
 	lc.Debugf("Subscription patched on DB successfully. Correlation-ID: %s ", correlation.FromContext(ctx))
 
@@ -213,6 +289,25 @@ func PatchSubscription(ctx context.Context, dto dtos.UpdateSubscription, dic *di
 	return nil
 }
 
+// validateSubscriptionCategories checks the categories of the subscription are registered in the Categories if
+// ValidateSubscriptionCategories is enabled
+func validateSubscriptionCategories(sub models.Subscription, dic *di.Container) errors.EdgeX {
+	config := container.ConfigurationFrom(dic.Get)
+	if !config.Writable.ValidateSubscriptionCategories {
+		return nil
+	}
+	var unknown []string
+	for _, category := range sub.Categories {
+		if !slices.Contains(config.Writable.Categories, category) && !slices.Contains(unknown, category) {
+			unknown = append(unknown, category)
+		}
+	}
+	if len(unknown) > 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("subscription %s has the unknown categories %s", sub.Name, strings.Join(unknown, ", ")), nil)
+	}
+	return nil
+}
+
 func subscriptionByDTO(dbClient interfaces.DBClient, dto dtos.UpdateSubscription) (subscription models.Subscription, err errors.EdgeX) {
 	// The ID or Name is required by DTO and the DTO also accepts empty string ID if the Name is provided
 	if dto.Id != nil && *dto.Id != "" {
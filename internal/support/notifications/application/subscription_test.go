@@ -24,6 +24,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -43,7 +44,7 @@ var (
 )
 
 func mockDic() *di.Container {
-	return di.NewContainer(di.ServiceConstructorMap{
+	dic := di.NewContainer(di.ServiceConstructorMap{
 		container.ConfigurationName: func(get di.Get) interface{} {
 			return &config.ConfigurationStruct{
 				Writable: config.WritableInfo{
@@ -60,6 +61,8 @@ func mockDic() *di.Container {
 			return logger.NewMockClient()
 		},
 	})
+	RegisterDispatchState(dic)
+	return dic
 }
 
 func updateSubscriptionData() dtos.UpdateSubscription {
@@ -137,3 +140,63 @@ func TestPatchSubscription(t *testing.T) {
 		})
 	}
 }
+
+func TestBulkToggleSubscriptionsByLabel(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	label := "hvac"
+	locked := models.Subscription{Name: "locked", Labels: []string{label}, AdminState: models.Locked}
+	unlocked := models.Subscription{Name: "unlocked", Labels: []string{label}, AdminState: models.Unlocked}
+	dbClientMock.On("SubscriptionsByLabel", 0, -1, label).Return([]models.Subscription{locked, unlocked}, nil)
+	enabled := locked
+	enabled.AdminState = models.Unlocked
+	dbClientMock.On("UpdateSubscription", enabled).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	names, err := BulkToggleSubscriptionsByLabel(label, false, true, context.Background(), dic)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"unlocked"}, names)
+	dbClientMock.AssertNotCalled(t, "UpdateSubscription", mock.Anything)
+
+	names, err = BulkToggleSubscriptionsByLabel(label, true, false, context.Background(), dic)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"locked"}, names)
+	dbClientMock.AssertCalled(t, "UpdateSubscription", enabled)
+
+	_, err = BulkToggleSubscriptionsByLabel("", true, false, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestAddSubscription_ValidateSubscriptionCategories(t *testing.T) {
+	sub := models.Subscription{Name: testSubscriptionName, Categories: []string{"health-check", "helth-check", "helth-check"}}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddSubscription", mock.Anything).Return(sub, nil)
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	configuration := container.ConfigurationFrom(dic.Get)
+	configuration.Writable.Categories = []string{"health-check", "security"}
+
+	_, err := AddSubscription(sub, context.Background(), dic)
+	require.NoError(t, err, "the categories are not validated by default")
+
+	configuration.Writable.ValidateSubscriptionCategories = true
+	_, err = AddSubscription(sub, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	assert.Contains(t, err.Error(), "unknown categories helth-check")
+	assert.NotContains(t, err.Error(), "helth-check, helth-check", "the unknown categories are listed once")
+	dbClientMock.AssertNumberOfCalls(t, "AddSubscription", 1)
+
+	sub.Categories = []string{"security"}
+	_, err = AddSubscription(sub, context.Background(), dic)
+	require.NoError(t, err)
+}
@@ -0,0 +1,117 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// ExpiresAtLabelPrefix is the prefix of the subscription label declaring when the subscription expires as an RFC 3339
+// timestamp, e.g. "expiresAt:2025-06-30T18:00:00Z". The notifications are not dispatched to the expired subscription,
+// which is deleted by the background worker if SubscriptionExpiry is enabled.
+const ExpiresAtLabelPrefix = "expiresAt:"
+
+var asyncPurgeExpiredSubscriptionsOnce sync.Once
+
+// subscriptionExpiresAt returns when the subscription expires, and whether the subscription declares the expiry
+func subscriptionExpiresAt(sub models.Subscription) (time.Time, bool, error) {
+	value := subscriptionLabelValue(sub, ExpiresAtLabelPrefix)
+	if value == "" {
+		return time.Time{}, false, nil
+	}
+	expiresAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, true, err
+	}
+	return expiresAt, true, nil
+}
+
+// subscriptionExpired returns whether the subscription expired at the time. The subscription with an invalid expiry,
+// which can only be stored before the validation was added, never expires.
+func subscriptionExpired(sub models.Subscription, now time.Time) bool {
+	expiresAt, ok, err := subscriptionExpiresAt(sub)
+	return ok && err == nil && !now.Before(expiresAt)
+}
+
+// validateSubscriptionExpiry checks the expiry of the subscription is a valid timestamp, which must be in the future if
+// requireFuture is true, i.e. when the subscription is added
+func validateSubscriptionExpiry(sub models.Subscription, requireFuture bool) errors.EdgeX {
+	expiresAt, ok, err := subscriptionExpiresAt(sub)
+	if !ok {
+		return nil
+	}
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("subscription %s has an invalid expiry, which must be an RFC 3339 timestamp", sub.Name), err)
+	}
+	if requireFuture && !expiresAt.After(time.Now()) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("subscription %s expiry %s is not in the future", sub.Name, expiresAt.Format(time.RFC3339)), nil)
+	}
+	return nil
+}
+
+// AsyncPurgeExpiredSubscriptions deletes the expired subscriptions every interval until the ctx is done
+func AsyncPurgeExpiredSubscriptions(interval time.Duration, ctx context.Context, dic *di.Container) {
+	asyncPurgeExpiredSubscriptionsOnce.Do(func() {
+		go func() {
+			lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+			timer := time.NewTimer(interval)
+			for {
+				timer.Reset(interval)
+				select {
+				case <-ctx.Done():
+					lc.Info("Exiting expired subscription purging")
+					return
+				case <-timer.C:
+					if err := purgeExpiredSubscriptions(dic); err != nil {
+						lc.Errorf("Failed to purge expired subscriptions, %v", err)
+					}
+				}
+			}
+		}()
+	})
+}
+
+// purgeExpiredSubscriptions deletes the expired subscriptions, and returns the error of the first failed deletion after
+// trying the others
+func purgeExpiredSubscriptions(dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	subs, err := dbClient.AllSubscriptions(0, -1)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	var purgeErr errors.EdgeX
+	now := time.Now()
+	for _, sub := range subs {
+		if !subscriptionExpired(sub, now) {
+			continue
+		}
+		if err = channel.RemoveClientFromCache(dic, sub.Channels); err == nil {
+			err = dbClient.DeleteSubscriptionByName(sub.Name)
+		}
+		if err != nil {
+			if purgeErr == nil {
+				purgeErr = errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete the expired subscription %s", sub.Name), err)
+			}
+			continue
+		}
+		subscriptionIndexFrom(dic.Get).invalidate()
+		lc.Infof("Deleted the expired subscription %s", sub.Name)
+	}
+	return purgeErr
+}
@@ -0,0 +1,100 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func expiringSubscription(name string, expiresAt time.Time) models.Subscription {
+	return models.Subscription{Name: name, Categories: []string{"incident"}, Labels: []string{ExpiresAtLabelPrefix + expiresAt.Format(time.RFC3339)}}
+}
+
+func TestValidateSubscriptionExpiry(t *testing.T) {
+	tests := []struct {
+		name          string
+		labels        []string
+		requireFuture bool
+		errorExpected bool
+	}{
+		{"no expiry", []string{"hvac"}, true, false},
+		{"future expiry", []string{ExpiresAtLabelPrefix + time.Now().Add(time.Hour).Format(time.RFC3339)}, true, false},
+		{"past expiry at creation", []string{ExpiresAtLabelPrefix + time.Now().Add(-time.Hour).Format(time.RFC3339)}, true, true},
+		{"past expiry at update", []string{ExpiresAtLabelPrefix + time.Now().Add(-time.Hour).Format(time.RFC3339)}, false, false},
+		{"invalid timestamp", []string{ExpiresAtLabelPrefix + "tomorrow"}, false, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validateSubscriptionExpiry(models.Subscription{Name: sub.Name, Labels: testCase.labels}, testCase.requireFuture)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDistributableSubscriptions_Expired(t *testing.T) {
+	now := time.Now()
+	active := expiringSubscription("active", now.Add(time.Hour))
+	expired := expiringSubscription("expired", now.Add(-time.Minute))
+	permanent := models.Subscription{Name: "permanent", Categories: []string{"incident"}}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllSubscriptions", 0, -1).Return([]models.Subscription{active, expired, permanent}, nil)
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	subs, err := distributableSubscriptions(dic, models.Notification{Category: "incident"})
+	require.NoError(t, err)
+	assert.Equal(t, []models.Subscription{active, permanent}, subs)
+}
+
+func TestPurgeExpiredSubscriptions(t *testing.T) {
+	now := time.Now()
+	active := expiringSubscription("active", now.Add(time.Hour))
+	expired := expiringSubscription("expired", now.Add(-time.Minute))
+	failed := expiringSubscription("failed", now.Add(-time.Hour))
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllSubscriptions", 0, -1).Return([]models.Subscription{active, expired, failed}, nil)
+	dbClientMock.On("DeleteSubscriptionByName", expired.Name).Return(nil)
+	dbClientMock.On("DeleteSubscriptionByName", failed.Name).Return(errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to delete", nil))
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		channel.MQTTSenderName: func(get di.Get) interface{} {
+			return &channel.MQTTSender{}
+		},
+		channel.ZeroMQTSenderName: func(get di.Get) interface{} {
+			return &channel.ZeroMQSender{}
+		},
+	})
+
+	err := purgeExpiredSubscriptions(dic)
+	require.Error(t, err, "the failed deletion should be reported")
+	assert.Equal(t, errors.KindDatabaseError, errors.Kind(err))
+	dbClientMock.AssertCalled(t, "DeleteSubscriptionByName", expired.Name)
+	dbClientMock.AssertNotCalled(t, "DeleteSubscriptionByName", active.Name)
+}
@@ -0,0 +1,278 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// The strategies to resolve the conflict of an imported subscription with an existing subscription of the same name
+const (
+	// ConflictStrategyFail fails the import of the conflicting subscription, which is the default
+	ConflictStrategyFail = "fail"
+	// ConflictStrategySkip keeps the existing subscription and skips the imported one
+	ConflictStrategySkip = "skip"
+	// ConflictStrategyOverwrite replaces the existing subscription with the imported one
+	ConflictStrategyOverwrite = "overwrite"
+	// ConflictStrategyMerge adds the categories, the labels and the channels of the imported subscription to the
+	// existing subscription, and takes the other non-empty fields from the imported subscription
+	ConflictStrategyMerge = "merge"
+)
+
+// SubscriptionFieldDiff is a difference of a field between the stored and the imported subscriptions. The list fields,
+// i.e. the categories, the labels and the channels, report the Added and the Removed items of the imported subscription,
+// and the other fields report the Stored and the Incoming values.
+type SubscriptionFieldDiff struct {
+	Field    string `json:"field"`
+	Stored   any    `json:"stored,omitempty"`
+	Incoming any    `json:"incoming,omitempty"`
+	Added    any    `json:"added,omitempty"`
+	Removed  any    `json:"removed,omitempty"`
+}
+
+// SubscriptionImportResult reports how an imported subscription is stored
+type SubscriptionImportResult struct {
+	// Id is the id of the added subscription, which is empty if the subscription conflicts with an existing one
+	Id   string `json:"id,omitempty"`
+	Name string `json:"name"`
+	// Created is whether the subscription is added
+	Created bool `json:"created"`
+	// AppliedStrategy is the conflict strategy applied to the subscription, which is empty if there is no conflict
+	AppliedStrategy string `json:"appliedStrategy,omitempty"`
+	// Diff is the field-level differences between the stored and the imported subscriptions on conflict
+	Diff []SubscriptionFieldDiff `json:"diff,omitempty"`
+}
+
+// ValidateConflictStrategy validates the conflict strategy of the subscription import
+func ValidateConflictStrategy(onConflict string) errors.EdgeX {
+	switch onConflict {
+	case ConflictStrategyFail, ConflictStrategySkip, ConflictStrategyOverwrite, ConflictStrategyMerge:
+		return nil
+	}
+	return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid onConflict strategy %s, must be one of %s, %s, %s or %s",
+		onConflict, ConflictStrategyFail, ConflictStrategySkip, ConflictStrategyOverwrite, ConflictStrategyMerge), nil)
+}
+
+// channelKey identifies the channel by its address. The channels refer to the secrets by name only, e.g. the SecretPath
+// of an MQTT channel, so the secrets are compared by reference and their values are never read.
+func channelKey(address models.Address) string {
+	encoded, err := json.Marshal(dtos.FromAddressModelToDTO(address))
+	if err != nil {
+		return fmt.Sprintf("%v", address)
+	}
+	return string(encoded)
+}
+
+// diffStrings returns the items of the incoming list not in the stored list, and the items of the stored list not in
+// the incoming list
+func diffStrings(stored, incoming []string) (added, removed []string) {
+	for _, s := range incoming {
+		if !slices.Contains(stored, s) {
+			added = append(added, s)
+		}
+	}
+	for _, s := range stored {
+		if !slices.Contains(incoming, s) {
+			removed = append(removed, s)
+		}
+	}
+	return added, removed
+}
+
+// diffChannels returns the channels of the incoming subscription not in the stored subscription, and the channels of the
+// stored subscription not in the incoming subscription
+func diffChannels(stored, incoming []models.Address) (added, removed []dtos.Address) {
+	storedKeys := make([]string, len(stored))
+	for i, a := range stored {
+		storedKeys[i] = channelKey(a)
+	}
+	incomingKeys := make([]string, len(incoming))
+	for i, a := range incoming {
+		incomingKeys[i] = channelKey(a)
+	}
+	for i, a := range incoming {
+		if !slices.Contains(storedKeys, incomingKeys[i]) {
+			added = append(added, dtos.FromAddressModelToDTO(a))
+		}
+	}
+	for i, a := range stored {
+		if !slices.Contains(incomingKeys, storedKeys[i]) {
+			removed = append(removed, dtos.FromAddressModelToDTO(a))
+		}
+	}
+	return added, removed
+}
+
+// diffSubscriptions returns the field-level differences between the stored and the incoming subscriptions, in the order
+// of the categories, the labels, the channels, the resend limit and interval, the receiver, the description and the
+// admin state. The ids and the timestamps are not compared.
+func diffSubscriptions(stored, incoming models.Subscription) []SubscriptionFieldDiff {
+	var diff []SubscriptionFieldDiff
+	for _, list := range []struct {
+		field            string
+		stored, incoming []string
+	}{
+		{"categories", stored.Categories, incoming.Categories},
+		{"labels", stored.Labels, incoming.Labels},
+	} {
+		if added, removed := diffStrings(list.stored, list.incoming); len(added) > 0 || len(removed) > 0 {
+			diff = append(diff, SubscriptionFieldDiff{Field: list.field, Added: added, Removed: removed})
+		}
+	}
+	if added, removed := diffChannels(stored.Channels, incoming.Channels); len(added) > 0 || len(removed) > 0 {
+		diff = append(diff, SubscriptionFieldDiff{Field: "channels", Added: added, Removed: removed})
+	}
+	for _, field := range []struct {
+		name             string
+		stored, incoming any
+	}{
+		{"resendLimit", stored.ResendLimit, incoming.ResendLimit},
+		{"resendInterval", stored.ResendInterval, incoming.ResendInterval},
+		{"receiver", stored.Receiver, incoming.Receiver},
+		{"description", stored.Description, incoming.Description},
+		{"adminState", stored.AdminState, incoming.AdminState},
+	} {
+		if field.stored != field.incoming {
+			diff = append(diff, SubscriptionFieldDiff{Field: field.name, Stored: field.stored, Incoming: field.incoming})
+		}
+	}
+	return diff
+}
+
+// mergeSubscriptions returns the stored subscription with the categories, the labels and the channels of the incoming
+// subscription added, and the other non-empty fields of the incoming subscription
+func mergeSubscriptions(stored, incoming models.Subscription) models.Subscription {
+	merged := stored
+	merged.Categories = append(slices.Clone(stored.Categories), diffStringsAdded(stored.Categories, incoming.Categories)...)
+	merged.Labels = append(slices.Clone(stored.Labels), diffStringsAdded(stored.Labels, incoming.Labels)...)
+	merged.Channels = slices.Clone(stored.Channels)
+	for _, a := range incoming.Channels {
+		if !slices.ContainsFunc(merged.Channels, func(m models.Address) bool { return channelKey(m) == channelKey(a) }) {
+			merged.Channels = append(merged.Channels, a)
+		}
+	}
+	if incoming.ResendLimit != 0 {
+		merged.ResendLimit = incoming.ResendLimit
+	}
+	if incoming.ResendInterval != "" {
+		merged.ResendInterval = incoming.ResendInterval
+	}
+	if incoming.Receiver != "" {
+		merged.Receiver = incoming.Receiver
+	}
+	if incoming.Description != "" {
+		merged.Description = incoming.Description
+	}
+	if incoming.AdminState != "" {
+		merged.AdminState = incoming.AdminState
+	}
+	return merged
+}
+
+func diffStringsAdded(stored, incoming []string) []string {
+	added, _ := diffStrings(stored, incoming)
+	return added
+}
+
+// ImportSubscription adds the imported subscription, and resolves the conflict with the existing subscription of the
+// same name by the onConflict strategy. The conflicting subscription is reported with its field-level differences from
+// the existing one, so the import can be reviewed, e.g. with the skip strategy, before it is overwritten or merged.
+func ImportSubscription(sub models.Subscription, onConflict string, ctx context.Context, dic *di.Container) (SubscriptionImportResult, errors.EdgeX) {
+	if err := ValidateConflictStrategy(onConflict); err != nil {
+		return SubscriptionImportResult{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	result := SubscriptionImportResult{Name: sub.Name}
+
+	stored, err := dbClient.SubscriptionByName(sub.Name)
+	if errors.Kind(err) == errors.KindEntityDoesNotExist {
+		result.Id, err = AddSubscription(sub, ctx, dic)
+		if err == nil {
+			result.Created = true
+			return result, nil
+		}
+		if errors.Kind(err) != errors.KindDuplicateName {
+			return result, errors.NewCommonEdgeXWrapper(err)
+		}
+		// the subscription is created concurrently after the existence check, which is a conflict as well
+		stored, err = dbClient.SubscriptionByName(sub.Name)
+	}
+	if err != nil {
+		return result, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	result.AppliedStrategy = onConflict
+	result.Diff = diffSubscriptions(stored, sub)
+	switch onConflict {
+	case ConflictStrategySkip:
+		lc.Debugf("Subscription %s exists, the imported subscription is skipped. Correlation-ID: %s ", sub.Name, correlation.FromContext(ctx))
+		return result, nil
+	case ConflictStrategyOverwrite:
+		sub.Id = stored.Id
+		sub.DBTimestamp = stored.DBTimestamp
+		if err = updateImportedSubscription(stored, sub, ctx, dic); err != nil {
+			return result, errors.NewCommonEdgeXWrapper(err)
+		}
+		return result, nil
+	case ConflictStrategyMerge:
+		if err = updateImportedSubscription(stored, mergeSubscriptions(stored, sub), ctx, dic); err != nil {
+			return result, errors.NewCommonEdgeXWrapper(err)
+		}
+		return result, nil
+	}
+	return result, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("subscription name %s exists", sub.Name), nil)
+}
+
+// updateImportedSubscription validates and stores the subscription replacing the stored one like PatchSubscription
+func updateImportedSubscription(stored, sub models.Subscription, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if len(sub.Categories) == 0 && len(sub.Labels) == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "subscription categories and labels can not be both empty", nil)
+	}
+	if err := validateSubscriptionCategories(sub, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := validateSubscriptionTemplate(dbClient, sub); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := validateSubscriptionLanguage(sub); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := validateSubscriptionRecipients(sub, dic); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := validateSubscriptionExpiry(sub, false); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	if err := dbClient.UpdateSubscription(sub); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	subscriptionIndexFrom(dic.Get).invalidate()
+	lc.Debugf("Subscription %s is updated by the import. Correlation-ID: %s ", sub.Name, correlation.FromContext(ctx))
+
+	// the cached clients of the replaced channels are no longer used
+	if err := channel.RemoveClientFromCache(dic, stored.Channels); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffSubscriptions(t *testing.T) {
+	email := dtos.ToAddressModel(dtos.NewEmailAddress([]string{"ops@example.com"}))
+	rest := dtos.ToAddressModel(dtos.NewRESTAddress("host", 123, http.MethodPost, "http"))
+	mqtt := dtos.ToAddressModel(dtos.NewMQTTAddress("broker", 1883, "notifications", "alerts"))
+	mqttWithSecret := mqtt.(models.MQTTPubAddress)
+	mqttWithSecret.SecretPath = "mqtt-credentials"
+
+	stored := models.Subscription{Name: "ops", Categories: []string{"health-check", "security"}, Channels: []models.Address{email, mqtt},
+		ResendLimit: 2, Receiver: "ops"}
+	incoming := models.Subscription{Name: "ops", Categories: []string{"security", "hvac"}, Channels: []models.Address{email, rest, mqttWithSecret},
+		ResendLimit: 5, Receiver: "ops"}
+
+	diff := diffSubscriptions(stored, incoming)
+	require.Len(t, diff, 3)
+	assert.Equal(t, SubscriptionFieldDiff{Field: "categories", Added: []string{"hvac"}, Removed: []string{"health-check"}}, diff[0])
+	assert.Equal(t, "channels", diff[1].Field)
+	assert.Equal(t, []dtos.Address{dtos.FromAddressModelToDTO(rest), dtos.FromAddressModelToDTO(mqttWithSecret)}, diff[1].Added,
+		"the channel referring to another secret should be reported")
+	assert.Equal(t, []dtos.Address{dtos.FromAddressModelToDTO(mqtt)}, diff[1].Removed)
+	assert.Equal(t, SubscriptionFieldDiff{Field: "resendLimit", Stored: 2, Incoming: 5}, diff[2])
+
+	assert.Empty(t, diffSubscriptions(stored, stored))
+}
+
+func TestMergeSubscriptions(t *testing.T) {
+	email := dtos.ToAddressModel(dtos.NewEmailAddress([]string{"ops@example.com"}))
+	rest := dtos.ToAddressModel(dtos.NewRESTAddress("host", 123, http.MethodPost, "http"))
+	stored := models.Subscription{Id: exampleUUID, Name: "ops", Categories: []string{"security"}, Labels: []string{"hvac"},
+		Channels: []models.Address{email}, ResendLimit: 2, Description: "ops team"}
+	incoming := models.Subscription{Name: "ops", Categories: []string{"security", "health-check"}, Channels: []models.Address{email, rest},
+		ResendLimit: 5}
+
+	merged := mergeSubscriptions(stored, incoming)
+	assert.Equal(t, exampleUUID, merged.Id)
+	assert.Equal(t, []string{"security", "health-check"}, merged.Categories)
+	assert.Equal(t, []string{"hvac"}, merged.Labels, "the stored labels should be kept")
+	assert.Equal(t, []models.Address{email, rest}, merged.Channels)
+	assert.Equal(t, 5, merged.ResendLimit)
+	assert.Equal(t, "ops team", merged.Description, "the empty incoming fields should keep the stored values")
+	assert.Equal(t, []string{"security"}, stored.Categories, "the stored subscription should not be changed")
+}
+
+func TestImportSubscription(t *testing.T) {
+	email := dtos.ToAddressModel(dtos.NewEmailAddress([]string{"ops@example.com"}))
+	stored := models.Subscription{Id: exampleUUID, Name: "ops", Categories: []string{"security"}, Channels: []models.Address{email}, ResendLimit: 2}
+	incoming := models.Subscription{Name: "ops", Categories: []string{"health-check"}, Channels: []models.Address{email}, ResendLimit: 2}
+	added := models.Subscription{Name: "new", Categories: []string{"security"}, Channels: []models.Address{email}}
+
+	overwritten := incoming
+	overwritten.Id = exampleUUID
+	merged := stored
+	merged.Categories = []string{"security", "health-check"}
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionByName", stored.Name).Return(stored, nil)
+	dbClientMock.On("SubscriptionByName", added.Name).Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("AddSubscription", mock.Anything).Return(models.Subscription{Id: exampleUUID, Name: added.Name}, nil)
+	dbClientMock.On("UpdateSubscription", overwritten).Return(nil)
+	dbClientMock.On("UpdateSubscription", merged).Return(nil)
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		channel.MQTTSenderName: func(get di.Get) interface{} {
+			return &channel.MQTTSender{}
+		},
+		channel.ZeroMQTSenderName: func(get di.Get) interface{} {
+			return &channel.ZeroMQSender{}
+		},
+	})
+
+	result, err := ImportSubscription(added, ConflictStrategyFail, context.Background(), dic)
+	require.NoError(t, err)
+	assert.Equal(t, SubscriptionImportResult{Id: exampleUUID, Name: added.Name, Created: true}, result)
+
+	expectedDiff := []SubscriptionFieldDiff{{Field: "categories", Added: []string{"health-check"}, Removed: []string{"security"}}}
+	result, err = ImportSubscription(incoming, ConflictStrategyFail, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindDuplicateName, errors.Kind(err))
+	assert.Equal(t, expectedDiff, result.Diff, "the diff should be reported for the failed import")
+
+	result, err = ImportSubscription(incoming, ConflictStrategySkip, context.Background(), dic)
+	require.NoError(t, err)
+	assert.Equal(t, SubscriptionImportResult{Name: incoming.Name, AppliedStrategy: ConflictStrategySkip, Diff: expectedDiff}, result)
+	dbClientMock.AssertNotCalled(t, "UpdateSubscription", mock.Anything)
+
+	_, err = ImportSubscription(incoming, ConflictStrategyOverwrite, context.Background(), dic)
+	require.NoError(t, err)
+	dbClientMock.AssertCalled(t, "UpdateSubscription", overwritten)
+
+	_, err = ImportSubscription(incoming, ConflictStrategyMerge, context.Background(), dic)
+	require.NoError(t, err)
+	dbClientMock.AssertCalled(t, "UpdateSubscription", merged)
+
+	_, err = ImportSubscription(incoming, "replace", context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
@@ -0,0 +1,120 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// subscriptionIndex is the in-memory routing index of the subscriptions, which narrows the subscriptions considered for
+// a notification down to the subscriptions of the notification category. The index is rebuilt from the database on the
+// first routing after any subscription change.
+type subscriptionIndex struct {
+	mutex sync.RWMutex
+	valid bool
+	// generation is increased on every invalidation, so a rebuild racing with a subscription change is discarded
+	generation uint64
+	byCategory map[string][]models.Subscription
+	byLabel    map[string][]models.Subscription
+}
+
+// invalidate marks the index to be rebuilt, it must be called whenever a subscription is added, updated or deleted
+func (i *subscriptionIndex) invalidate() {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.valid = false
+	i.generation++
+}
+
+// match returns the subscriptions matching the notification, in the order of the subscription creation
+func (i *subscriptionIndex) match(dic *di.Container, n models.Notification) ([]models.Subscription, errors.EdgeX) {
+	n.Labels = routingLabels(n.Labels)
+	candidates, err := i.candidates(dic, n)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	var matched []models.Subscription
+	for _, sub := range candidates {
+		if subscriptionMatches(sub, n) {
+			matched = append(matched, sub)
+		}
+	}
+	return matched, nil
+}
+
+// candidates returns the indexed subscriptions of the notification category, or of the first notification label if the
+// notification has no category
+func (i *subscriptionIndex) candidates(dic *di.Container, n models.Notification) ([]models.Subscription, errors.EdgeX) {
+	i.mutex.RLock()
+	if !i.valid {
+		i.mutex.RUnlock()
+		if err := i.rebuild(dic); err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		i.mutex.RLock()
+	}
+	defer i.mutex.RUnlock()
+
+	switch {
+	case n.Category != "":
+		return i.byCategory[n.Category], nil
+	case len(n.Labels) > 0:
+		return i.byLabel[n.Labels[0]], nil
+	default:
+		return nil, nil
+	}
+}
+
+func (i *subscriptionIndex) rebuild(dic *di.Container) errors.EdgeX {
+	i.mutex.RLock()
+	generation := i.generation
+	i.mutex.RUnlock()
+
+	subs, err := container.DBClientFrom(dic.Get).AllSubscriptions(0, -1)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), "failed to load the subscriptions for building the routing index", err)
+	}
+	byCategory := make(map[string][]models.Subscription)
+	byLabel := make(map[string][]models.Subscription)
+	for _, sub := range subs {
+		for _, category := range sub.Categories {
+			byCategory[category] = append(byCategory[category], sub)
+		}
+		for _, label := range sub.Labels {
+			byLabel[label] = append(byLabel[label], sub)
+		}
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.byCategory = byCategory
+	i.byLabel = byLabel
+	// keep the index invalid if the subscriptions changed during the rebuild, the loaded subscriptions are still used
+	// for the current routing
+	i.valid = generation == i.generation
+	return nil
+}
+
+// subscriptionMatches returns whether the subscription covers the category and all the labels of the notification
+func subscriptionMatches(sub models.Subscription, n models.Notification) bool {
+	if n.Category != "" && !slices.Contains(sub.Categories, n.Category) {
+		return false
+	}
+	for _, label := range n.Labels {
+		if !slices.Contains(sub.Labels, label) {
+			return false
+		}
+	}
+	return n.Category != "" || len(n.Labels) > 0
+}
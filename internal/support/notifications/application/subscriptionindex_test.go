@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+func subscriptionNames(subs []models.Subscription) []string {
+	names := []string{}
+	for _, sub := range subs {
+		names = append(names, sub.Name)
+	}
+	return names
+}
+
+func TestSubscriptionIndexMatch(t *testing.T) {
+	subs := []models.Subscription{
+		{Name: "health", Categories: []string{"health-check"}},
+		{Name: "healthFloor1", Categories: []string{"health-check"}, Labels: []string{"floor1"}},
+		{Name: "healthFloor1Hvac", Categories: []string{"health-check", "alert"}, Labels: []string{"floor1", "hvac"}},
+		{Name: "floor1", Labels: []string{"floor1"}},
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllSubscriptions", 0, -1).Return(subs, nil)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	index := &subscriptionIndex{}
+
+	tests := []struct {
+		name          string
+		notification  models.Notification
+		expectedNames []string
+	}{
+		{"category only", models.Notification{Category: "health-check"}, []string{"health", "healthFloor1", "healthFloor1Hvac"}},
+		{"category and label", models.Notification{Category: "health-check", Labels: []string{"floor1"}}, []string{"healthFloor1", "healthFloor1Hvac"}},
+		{"category and labels", models.Notification{Category: "health-check", Labels: []string{"floor1", "hvac"}}, []string{"healthFloor1Hvac"}},
+		{"label only", models.Notification{Labels: []string{"floor1"}}, []string{"healthFloor1", "healthFloor1Hvac", "floor1"}},
+		{"labels only", models.Notification{Labels: []string{"hvac", "floor1"}}, []string{"healthFloor1Hvac"}},
+		{"unknown category", models.Notification{Category: "security"}, []string{}},
+		{"unknown label", models.Notification{Category: "health-check", Labels: []string{"floor2"}}, []string{}},
+		{"no category and labels", models.Notification{}, []string{}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			matched, err := index.match(dic, testCase.notification)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedNames, subscriptionNames(matched))
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "AllSubscriptions", 1)
+}
+
+func TestSubscriptionIndexInvalidate(t *testing.T) {
+	notification := models.Notification{Category: "health-check"}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllSubscriptions", 0, -1).Return([]models.Subscription{{Name: "health", Categories: []string{"health-check"}}}, nil).Once()
+	dbClientMock.On("AllSubscriptions", 0, -1).Return([]models.Subscription{}, nil).Once()
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	index := &subscriptionIndex{}
+
+	matched, err := index.match(dic, notification)
+	require.NoError(t, err)
+	assert.Len(t, matched, 1)
+	matched, err = index.match(dic, notification)
+	require.NoError(t, err)
+	assert.Len(t, matched, 1, "the index should be reused before any subscription change")
+
+	index.invalidate()
+	matched, err = index.match(dic, notification)
+	require.NoError(t, err)
+	assert.Empty(t, matched, "the index should be rebuilt after the subscription change")
+	dbClientMock.AssertExpectations(t)
+}
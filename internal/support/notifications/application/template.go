@@ -0,0 +1,228 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// TemplateLabelPrefix is the prefix of the subscription label referencing a notification template by name, e.g.
+// "template:alarm". The content of the notifications sent to the subscription is rendered with the template.
+const TemplateLabelPrefix = "template:"
+
+// AddNotificationTemplate adds a new notification template
+func AddNotificationTemplate(t notificationModels.NotificationTemplate, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if err := validateNotificationTemplate(t); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	added, err := dbClient.AddNotificationTemplate(t)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("NotificationTemplate created on DB successfully. NotificationTemplate ID: %s, Correlation-ID: %s ",
+		added.Id,
+		correlation.FromContext(ctx))
+	return added.Id, nil
+}
+
+// NotificationTemplateByName queries the notification template by name
+func NotificationTemplateByName(name string, dic *di.Container) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	if name == "" {
+		return notificationModels.NotificationTemplate{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	t, err := container.DBClientFrom(dic.Get).NotificationTemplateByName(name)
+	if err != nil {
+		return t, errors.NewCommonEdgeXWrapper(err)
+	}
+	return t, nil
+}
+
+// AllNotificationTemplates queries the notification templates by offset and limit
+func AllNotificationTemplates(offset, limit int, dic *di.Container) (templates []notificationModels.NotificationTemplate, totalCount uint32, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+
+	totalCount, err = dbClient.NotificationTemplateTotalCount()
+	if err != nil {
+		return templates, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []notificationModels.NotificationTemplate{}, totalCount, err
+	}
+
+	templates, err = dbClient.AllNotificationTemplates(offset, limit)
+	if err != nil {
+		return templates, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return templates, totalCount, nil
+}
+
+// UpdateNotificationTemplate replaces the description, content and content type of the existing notification template
+// with the same name
+func UpdateNotificationTemplate(t notificationModels.NotificationTemplate, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if err := validateNotificationTemplate(t); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	existing, err := dbClient.NotificationTemplateByName(t.Name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if t.Id != "" && t.Id != existing.Id {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("notification template id '%s' not match the existing '%s' ", t.Id, existing.Id), nil)
+	}
+	t.Id = existing.Id
+	t.Created = existing.Created
+
+	err = dbClient.UpdateNotificationTemplate(t)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("NotificationTemplate updated on DB successfully. Correlation-ID: %s ", correlation.FromContext(ctx))
+	return nil
+}
+
+// DeleteNotificationTemplateByName deletes the notification template by name, the template referenced by any
+// subscription can not be deleted
+func DeleteNotificationTemplateByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if _, err := dbClient.NotificationTemplateByName(name); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	var count uint32
+	for _, prefix := range []string{TemplateLabelPrefix, DigestTemplateLabelPrefix} {
+		referenced, err := dbClient.SubscriptionCountByLabel(prefix + name)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		count += referenced
+	}
+	if count > 0 {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("fail to delete the notification template %s, which is referenced by %d subscriptions", name, count), nil)
+	}
+
+	err := dbClient.DeleteNotificationTemplateByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("NotificationTemplate deleted on DB successfully. Correlation-ID: %s ", correlation.FromContext(ctx))
+	return nil
+}
+
+// validateNotificationTemplate validates the fields and the syntax of the template content
+func validateNotificationTemplate(t notificationModels.NotificationTemplate) errors.EdgeX {
+	if err := common.Validate(t); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid NotificationTemplate", err)
+	}
+	if _, err := template.New(t.Name).Option("missingkey=error").Parse(t.Content); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid content of the notification template %s", t.Name), err)
+	}
+	return nil
+}
+
+// subscriptionTemplateName returns the name of the notification template referenced by the subscription, or empty if
+// there is none
+func subscriptionTemplateName(sub models.Subscription) string {
+	return subscriptionLabelValue(sub, TemplateLabelPrefix)
+}
+
+// subscriptionLabelValue returns the value of the first subscription label with the prefix, or empty if there is none
+func subscriptionLabelValue(sub models.Subscription, prefix string) string {
+	for _, label := range sub.Labels {
+		if value, ok := strings.CutPrefix(label, prefix); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// validateSubscriptionTemplate checks the notification template and the digest template referenced by the subscription
+// exist
+func validateSubscriptionTemplate(dbClient interfaces.DBClient, sub models.Subscription) errors.EdgeX {
+	for _, name := range []string{subscriptionTemplateName(sub), subscriptionDigestTemplateName(sub)} {
+		if name == "" {
+			continue
+		}
+		_, err := dbClient.NotificationTemplateByName(name)
+		if errors.Kind(err) == errors.KindEntityDoesNotExist {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("subscription %s references the missing notification template %s", sub.Name, name), err)
+		} else if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
+
+// renderNotification selects the localized content of the notification in the preferred language of the subscription,
+// and renders the content with the notification template referenced by the subscription. The localized notification is
+// returned if the subscription references no template or the rendering fails, so the notification is still delivered.
+func renderNotification(dic *di.Container, n models.Notification, sub models.Subscription) models.Notification {
+	n = localizeNotification(n, sub)
+	name := subscriptionTemplateName(sub)
+	if name == "" {
+		return n
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	t, err := container.DBClientFrom(dic.Get).NotificationTemplateByName(name)
+	if err != nil {
+		lc.Errorf("fail to query the notification template %s of the subscription %s, send the original content, err: %v", name, sub.Name, err)
+		return n
+	}
+	content, err := renderTemplateContent(t, n)
+	if err != nil {
+		lc.Errorf("fail to render the notification %s with the template %s, send the original content, err: %v", n.Id, name, err)
+		return n
+	}
+	n.Content = content
+	if t.ContentType != "" {
+		n.ContentType = t.ContentType
+	}
+	return n
+}
+
+// renderTemplateContent executes the template content with the data, e.g. the notification fields {{.Severity}} or
+// {{.Content}}
+func renderTemplateContent(t notificationModels.NotificationTemplate, data any) (string, errors.EdgeX) {
+	tmpl, err := template.New(t.Name).Option("missingkey=error").Parse(t.Content)
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid content of the notification template %s", t.Name), err)
+	}
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, data); err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("fail to execute the notification template %s", t.Name), err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,143 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddNotificationTemplate(t *testing.T) {
+	valid := notificationModels.NotificationTemplate{Name: "alarm", Content: "[{{.Severity}}] {{.Content}}"}
+	invalidSyntax := notificationModels.NotificationTemplate{Name: "broken", Content: "{{.Content"}
+	noContent := notificationModels.NotificationTemplate{Name: "empty"}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddNotificationTemplate", valid).Return(notificationModels.NotificationTemplate{Id: exampleUUID, Name: valid.Name}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	id, err := AddNotificationTemplate(valid, context.Background(), dic)
+	require.NoError(t, err)
+	assert.Equal(t, exampleUUID, id)
+
+	_, err = AddNotificationTemplate(invalidSyntax, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	_, err = AddNotificationTemplate(noContent, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestDeleteNotificationTemplateByName(t *testing.T) {
+	referenced := "referenced"
+	unreferenced := "unreferenced"
+	digestReferenced := "digestReferenced"
+	notFound := "notFound"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("NotificationTemplateByName", referenced).Return(notificationModels.NotificationTemplate{Name: referenced}, nil)
+	dbClientMock.On("NotificationTemplateByName", unreferenced).Return(notificationModels.NotificationTemplate{Name: unreferenced}, nil)
+	dbClientMock.On("NotificationTemplateByName", notFound).Return(notificationModels.NotificationTemplate{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("SubscriptionCountByLabel", TemplateLabelPrefix+referenced).Return(uint32(2), nil)
+	dbClientMock.On("SubscriptionCountByLabel", DigestTemplateLabelPrefix+referenced).Return(uint32(0), nil)
+	dbClientMock.On("SubscriptionCountByLabel", TemplateLabelPrefix+unreferenced).Return(uint32(0), nil)
+	dbClientMock.On("SubscriptionCountByLabel", DigestTemplateLabelPrefix+unreferenced).Return(uint32(0), nil)
+	dbClientMock.On("NotificationTemplateByName", digestReferenced).Return(notificationModels.NotificationTemplate{Name: digestReferenced}, nil)
+	dbClientMock.On("SubscriptionCountByLabel", TemplateLabelPrefix+digestReferenced).Return(uint32(0), nil)
+	dbClientMock.On("SubscriptionCountByLabel", DigestTemplateLabelPrefix+digestReferenced).Return(uint32(1), nil)
+	dbClientMock.On("DeleteNotificationTemplateByName", unreferenced).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	tests := []struct {
+		name         string
+		templateName string
+		errorKind    errors.ErrKind
+	}{
+		{"valid", unreferenced, ""},
+		{"invalid - referenced by subscriptions", referenced, errors.KindStatusConflict},
+		{"invalid - referenced as digest template", digestReferenced, errors.KindStatusConflict},
+		{"invalid - not found", notFound, errors.KindEntityDoesNotExist},
+		{"invalid - empty name", "", errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := DeleteNotificationTemplateByName(testCase.templateName, context.Background(), dic)
+			if testCase.errorKind == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, testCase.errorKind, errors.Kind(err))
+		})
+	}
+	dbClientMock.AssertNotCalled(t, "DeleteNotificationTemplateByName", referenced)
+	dbClientMock.AssertNotCalled(t, "DeleteNotificationTemplateByName", digestReferenced)
+}
+
+func TestRenderNotification(t *testing.T) {
+	tmpl := notificationModels.NotificationTemplate{Name: "alarm", Content: "[{{.Severity}}] {{.Sender}}: {{.Content}}", ContentType: common.ContentTypeText}
+	broken := notificationModels.NotificationTemplate{Name: "broken", Content: "{{.Missing}}"}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("NotificationTemplateByName", tmpl.Name).Return(tmpl, nil)
+	dbClientMock.On("NotificationTemplateByName", broken.Name).Return(broken, nil)
+	dbClientMock.On("NotificationTemplateByName", "deleted").Return(notificationModels.NotificationTemplate{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	n := models.Notification{Content: "disk full", ContentType: common.ContentTypeJSON, Sender: "device-1", Severity: models.Critical}
+
+	rendered := renderNotification(dic, n, models.Subscription{Labels: []string{"hvac", TemplateLabelPrefix + tmpl.Name}})
+	assert.Equal(t, "[CRITICAL] device-1: disk full", rendered.Content)
+	assert.Equal(t, common.ContentTypeText, rendered.ContentType)
+
+	assert.Equal(t, n, renderNotification(dic, n, models.Subscription{Labels: []string{"hvac"}}), "no template is referenced")
+	assert.Equal(t, n, renderNotification(dic, n, models.Subscription{Labels: []string{TemplateLabelPrefix + broken.Name}}), "the rendering failure should send the original")
+	assert.Equal(t, n, renderNotification(dic, n, models.Subscription{Labels: []string{TemplateLabelPrefix + "deleted"}}), "the missing template should send the original")
+}
+
+func TestValidateSubscriptionTemplate(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("NotificationTemplateByName", "alarm").Return(notificationModels.NotificationTemplate{Name: "alarm"}, nil)
+	dbClientMock.On("NotificationTemplateByName", "missing").Return(notificationModels.NotificationTemplate{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+
+	assert.NoError(t, validateSubscriptionTemplate(dbClientMock, models.Subscription{Labels: []string{"hvac"}}))
+	assert.NoError(t, validateSubscriptionTemplate(dbClientMock, models.Subscription{Labels: []string{TemplateLabelPrefix + "alarm"}}))
+	err := validateSubscriptionTemplate(dbClientMock, models.Subscription{Labels: []string{TemplateLabelPrefix + "missing"}})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	assert.NoError(t, validateSubscriptionTemplate(dbClientMock, models.Subscription{Labels: []string{DigestTemplateLabelPrefix + "alarm"}}))
+	err = validateSubscriptionTemplate(dbClientMock, models.Subscription{Labels: []string{TemplateLabelPrefix + "alarm", DigestTemplateLabelPrefix + "missing"}})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
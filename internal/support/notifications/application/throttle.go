@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	gometrics "github.com/rcrowley/go-metrics"
+)
+
+// NotificationStatusThrottled is the status of the notification not transmitted because its category exceeded the
+// Writable.CategoryRateLimits
+const NotificationStatusThrottled models.NotificationStatus = "THROTTLED"
+
+const notificationsThrottledMetricName = "NotificationsThrottled"
+
+// notificationsThrottledCounter counts the notifications not transmitted because of the category rate limits
+var notificationsThrottledCounter = gometrics.NewCounter()
+
+// categoryRateLimiter limits the notifications of each category dispatched per fixed window
+type categoryRateLimiter struct {
+	mutex   sync.Mutex
+	windows map[string]*rateWindow
+}
+
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+func newCategoryRateLimiter() *categoryRateLimiter {
+	return &categoryRateLimiter{windows: make(map[string]*rateWindow)}
+}
+
+// allow returns whether one more notification of the category can be dispatched at now under the limit, and counts it
+// if so. The invalid limit is unlimited, as the limits are validated on start but can be changed at runtime.
+func (l *categoryRateLimiter) allow(category string, limit config.RateLimit, now time.Time) bool {
+	interval, err := time.ParseDuration(limit.Interval)
+	if err != nil || interval <= 0 || limit.MaxNotifications <= 0 {
+		return true
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	window, ok := l.windows[category]
+	if !ok || now.Sub(window.start) >= interval {
+		window = &rateWindow{start: now}
+		l.windows[category] = window
+	}
+	if window.count >= limit.MaxNotifications {
+		return false
+	}
+	window.count++
+	return true
+}
+
+// categoryThrottled returns whether the notification exceeds the rate limit of its category, which is applied before
+// the notification is fanned out to the subscriptions
+func categoryThrottled(dic *di.Container, n models.Notification) bool {
+	limit, ok := container.ConfigurationFrom(dic.Get).Writable.CategoryRateLimits[n.Category]
+	if !ok || n.Category == "" {
+		return false
+	}
+	if categoryRateLimiterFrom(dic.Get).allow(n.Category, limit, time.Now()) {
+		return false
+	}
+	notificationsThrottledCounter.Inc(1)
+	bootstrapContainer.LoggingClientFrom(dic.Get).Debugf("notification %s is throttled by the rate limit of the category %s", n.Id, n.Category)
+	return true
+}
+
+// registerThrottleMetrics registers the throttled notification counter with the metrics manager
+func registerThrottleMetrics(dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
+	if metricsManager == nil {
+		lc.Error("Metric Manager not available. Throttle metrics will not be collected.")
+		return
+	}
+	if err := metricsManager.Register(notificationsThrottledMetricName, notificationsThrottledCounter, nil); err != nil {
+		lc.Errorf("%s metrics will not be collected: %s", notificationsThrottledMetricName, err.Error())
+		return
+	}
+	lc.Infof("Registered metrics counter %s", notificationsThrottledMetricName)
+}
@@ -0,0 +1,68 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryRateLimiter(t *testing.T) {
+	limiter := newCategoryRateLimiter()
+	limit := config.RateLimit{MaxNotifications: 2, Interval: "1m"}
+	now := time.Now()
+
+	assert.True(t, limiter.allow("health-check", limit, now))
+	assert.True(t, limiter.allow("health-check", limit, now.Add(time.Second)))
+	assert.False(t, limiter.allow("health-check", limit, now.Add(2*time.Second)), "the third notification in the window should be throttled")
+	assert.True(t, limiter.allow("security", limit, now.Add(2*time.Second)), "the categories should be limited independently")
+	assert.True(t, limiter.allow("health-check", limit, now.Add(time.Minute)), "the limit should be reset in the next window")
+
+	invalid := config.RateLimit{MaxNotifications: 1, Interval: "invalid"}
+	for i := 0; i < 3; i++ {
+		assert.True(t, limiter.allow("invalid", invalid, now), "the invalid limit should be unlimited")
+	}
+}
+
+func TestDistribute_CategoryThrottled(t *testing.T) {
+	n := notification
+	n.Id = exampleUUID
+	n.Category = "health-check"
+	var statuses []models.NotificationStatus
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllSubscriptions", 0, -1).Return([]models.Subscription{}, nil)
+	dbClientMock.On("UpdateNotification", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		statuses = append(statuses, args.Get(0).(models.Notification).Status)
+	})
+	dic := mockDic()
+	configuration := container.ConfigurationFrom(dic.Get)
+	configuration.Writable.CategoryRateLimits = map[string]config.RateLimit{n.Category: {MaxNotifications: 1, Interval: "1h"}}
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	require.NoError(t, distribute(dic, n, nil))
+	require.NoError(t, distribute(dic, n, nil))
+	unlimited := n
+	unlimited.Category = "security"
+	require.NoError(t, distribute(dic, unlimited, nil))
+
+	assert.Equal(t, []models.NotificationStatus{models.Processed, NotificationStatusThrottled, models.Processed}, statuses)
+	dbClientMock.AssertNumberOfCalls(t, "AllSubscriptions", 1)
+}
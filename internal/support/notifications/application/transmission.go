@@ -6,9 +6,13 @@
 package application
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -163,3 +167,47 @@ func TransmissionsByNotificationId(offset, limit int, notificationId string, dic
 		return dtos.FromTransmissionModelsToDTOs(transModels), totalCount, nil
 	}
 }
+
+// purgeTransmission purges the processed transmissions according to the transmission retention policy. The notifications
+// are never purged here, so a retained notification only loses its processed transmission records.
+func purgeTransmission(dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+	retention := container.ConfigurationFrom(dic.Get).Retention.Transmission
+
+	if retention.MaxAge != "" {
+		maxAge, err := time.ParseDuration(retention.MaxAge)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse transmission retention MaxAge '%s'", retention.MaxAge), err)
+		}
+		lc.Debugf("Purging the processed transmissions older than %s", retention.MaxAge)
+		if err := dbClient.DeleteProcessedTransmissionsByAge(maxAge.Milliseconds()); err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete transmissions by age '%s'", retention.MaxAge), err)
+		}
+	}
+
+	if retention.MaxCap == 0 {
+		return nil
+	}
+	total, err := dbClient.TransmissionTotalCount()
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), "failed to query transmission total count", err)
+	}
+	if total < retention.MaxCap {
+		return nil
+	}
+	lc.Debugf("Purging the transmission amount %d to the minimum capacity %d", total, retention.MinCap)
+	// Query the latest transmission at the minimum capacity and clean the processed transmissions by created date.
+	transmissions, err := dbClient.AllTransmissions(int(retention.MinCap), 1)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query transmission with offset '%d'", retention.MinCap), err)
+	}
+	if len(transmissions) == 0 {
+		return nil
+	}
+	age := time.Now().UnixMilli() - transmissions[0].Created
+	if err := dbClient.DeleteProcessedTransmissionsByAge(age); err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete transmissions by age '%d'", age), err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+func TestPurgeTransmission(t *testing.T) {
+	tests := []struct {
+		name              string
+		retention         config.TransmissionRetention
+		transmissionCount uint32
+		expectedAgePurge  bool
+		expectedCapPurge  bool
+		errorExpected     bool
+	}{
+		{"disabled", config.TransmissionRetention{}, 10, false, false, false},
+		{"purge by age", config.TransmissionRetention{MaxAge: "24h"}, 10, true, false, false},
+		{"purge by capacity", config.TransmissionRetention{MaxCap: 5, MinCap: 3}, 5, false, true, false},
+		{"not purge under capacity", config.TransmissionRetention{MaxCap: 5, MinCap: 3}, 4, false, false, false},
+		{"purge by age and capacity", config.TransmissionRetention{MaxCap: 5, MinCap: 3, MaxAge: "24h"}, 6, true, true, false},
+		{"invalid max age", config.TransmissionRetention{MaxAge: "a day"}, 10, false, false, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			configuration := &config.ConfigurationStruct{
+				Retention: config.NotificationRetention{Enabled: true, Interval: "1s", MaxCap: 5, MinCap: 3, Transmission: testCase.retention},
+			}
+			transmission := models.Transmission{Created: time.Now().Add(-time.Hour).UnixMilli()}
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("TransmissionTotalCount").Return(testCase.transmissionCount, nil)
+			dbClientMock.On("AllTransmissions", int(testCase.retention.MinCap), 1).Return([]models.Transmission{transmission}, nil)
+			dbClientMock.On("DeleteProcessedTransmissionsByAge", mock.Anything).Return(nil)
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.ConfigurationName: func(get di.Get) interface{} {
+					return configuration
+				},
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+				bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+					return logger.NewMockClient()
+				},
+			})
+
+			err := purgeTransmission(dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				dbClientMock.AssertNotCalled(t, "DeleteProcessedTransmissionsByAge", mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+
+			if testCase.expectedAgePurge {
+				dbClientMock.AssertCalled(t, "DeleteProcessedTransmissionsByAge", (24 * time.Hour).Milliseconds())
+			}
+			if testCase.expectedCapPurge {
+				dbClientMock.AssertCalled(t, "DeleteProcessedTransmissionsByAge", mock.MatchedBy(func(age int64) bool {
+					return age >= time.Hour.Milliseconds() && age < (24*time.Hour).Milliseconds()
+				}))
+			} else {
+				dbClientMock.AssertNotCalled(t, "AllTransmissions", mock.Anything, mock.Anything)
+			}
+			expectedCalls := 0
+			if testCase.expectedAgePurge {
+				expectedCalls++
+			}
+			if testCase.expectedCapPurge {
+				expectedCalls++
+			}
+			dbClientMock.AssertNumberOfCalls(t, "DeleteProcessedTransmissionsByAge", expectedCalls)
+			dbClientMock.AssertNotCalled(t, "CleanupNotificationsByAge", mock.Anything)
+		})
+	}
+}
@@ -18,7 +18,15 @@
 package config
 
 import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v4/config"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
 type ConfigurationStruct struct {
@@ -29,7 +37,16 @@ type ConfigurationStruct struct {
 	Service    bootstrapConfig.ServiceInfo
 	MessageBus bootstrapConfig.MessageBusInfo
 	Smtp       SmtpInfo
-	Retention  NotificationRetention
+	// Webhook defines the webhook receiving the notifications of the REST channels with the "webhook" scheme
+	Webhook WebhookInfo
+	// Slack defines the Slack incoming webhook receiving the notifications of the REST channels with the "slack" scheme
+	Slack SlackInfo
+	// Teams defines the Microsoft Teams incoming webhook receiving the notifications of the REST channels with the "teams"
+	// scheme
+	Teams     TeamsInfo
+	Retention NotificationRetention
+	// SubscriptionExpiry deletes the expired subscriptions in the background
+	SubscriptionExpiry SubscriptionExpiryInfo
 }
 
 type WritableInfo struct {
@@ -37,9 +54,445 @@ type WritableInfo struct {
 	// ResendLimit is the default retry limit for attempts to send notifications.
 	ResendLimit int
 	// ResendInterval is the default interval of resending the notification. The format of this field is to be an unsigned integer followed by a unit which may be "ns", "us" (or "µs"), "ms", "s", "m", "h" representing nanoseconds, microseconds, milliseconds, seconds, minutes or hours. Eg, "100ms", "24h"
-	ResendInterval  string
+	ResendInterval string
+	// ResendLimitByFailure maps the failure classes (network, timeout, 4xx, 429 and 5xx) to the resend limits, which
+	// supersede the ResendLimit when the last send failed with that class of failure. The subscription's ResendLimit
+	// caps the limit of the class, and its negative ResendLimit still disables the resends. The classes not listed keep
+	// the ResendLimit.
+	ResendLimitByFailure map[string]int
+	// ResendJitter randomizes the resend interval, so the resends of many failed notifications are spread out
+	ResendJitter ResendJitterInfo
+	// DrainTimeout is the maximum time to wait for the in-flight notification dispatches to finish on shutdown. The notifications still being dispatched after the timeout are persisted and distributed again on the next start. The format of this field is the same as ResendInterval, Eg, "10s"
+	DrainTimeout    string
 	InsecureSecrets bootstrapConfig.InsecureSecrets
 	Telemetry       bootstrapConfig.TelemetryInfo
+	// Smtp overrides the non-empty fields of the top-level Smtp section, so the SMTP settings such as the SecretName
+	// can be changed at runtime without restarting the service.
+	Smtp SmtpOverrideInfo
+	// Channel defines the backpressure of each channel type, so one slow target can't pile up unbounded in-flight sends
+	Channel ChannelInfo
+	// Ordering dispatches the notifications of the ordered subscriptions sequentially
+	Ordering OrderingInfo
+	// DeliveryConcurrency maps the subscription names to the maximum number of the concurrent sends to the channels of
+	// the subscription, "*" sets the limit of the subscriptions not listed. The subscriptions without a positive limit
+	// send to their channels in parallel without a limit, and the ordered subscriptions always send one at a time.
+	DeliveryConcurrency map[string]int
+	// SubscriptionSendRates maps the subscription names to the rates of the sends to the channels of the subscription,
+	// "*" sets the rate of the subscriptions not listed. The subscriptions without a positive MaxSendRate are unlimited.
+	SubscriptionSendRates map[string]SendRate
+	// DeliveryPolicy maps the subscription names to the policy deciding when a notification is delivered to the
+	// subscription, "*" sets the policy of the subscriptions not listed. The "all" policy requires every channel of the
+	// subscription to be delivered, and the "any" policy one of them. Empty is "all".
+	DeliveryPolicy map[string]string
+	// SanitizeInvalidContent replaces the invalid UTF-8 sequences in the content of the new notifications with the
+	// Unicode replacement character, instead of rejecting the notifications
+	SanitizeInvalidContent bool
+	// SeverityOrder ranks the notification severities from the lowest to the highest, e.g. [MINOR, NORMAL, CRITICAL].
+	// It must contain the built-in severities, and may add the custom ones. Empty uses the DefaultSeverityOrder.
+	SeverityOrder []string
+	// Categories is the registry of the known notification categories, which the new subscriptions are validated
+	// against if ValidateSubscriptionCategories is enabled
+	Categories []string
+	// ValidateSubscriptionCategories rejects the new subscriptions with the categories not in the Categories, so a
+	// typo in the categories doesn't create a subscription never matching any notification
+	ValidateSubscriptionCategories bool
+	// CategoryRateLimits limits the notifications of each category dispatched per interval, so a chatty category can't
+	// drown out the others. The notifications over the limit are not transmitted and are recorded with the THROTTLED
+	// status. The categories not listed are unlimited.
+	CategoryRateLimits map[string]RateLimit
+	// DeliveryWindows maps the channel types, e.g. EMAIL, or the hosts of the channels, e.g. sms-gateway, to the
+	// windows the sends to the channels are allowed in. The host takes precedence over the channel type. The sends
+	// outside the windows are deferred until the next window opens, the deferred transmissions are stored with the
+	// DEFERRED status and resumed on restart. The channels not listed are always allowed.
+	DeliveryWindows map[string]DeliveryWindow
+	// DispatchAudit records the dispatch decisions of each notification, i.e. the subscriptions matched, the channels
+	// attempted and their outcomes, as an append-only hash chain kept separately from the transmissions
+	DispatchAudit bool
+	// FailureAlert alerts the ops subscription when the failure rate of the sends of a subscription exceeds a threshold
+	FailureAlert FailureAlertInfo
+	// MaxRecipientsPerNotification limits the recipients of each email channel a notification is sent to, the
+	// recipients over the limit are dropped with a warning and recorded in the transmission. 0 is unlimited.
+	MaxRecipientsPerNotification int
+	// RejectExcessRecipients rejects the subscriptions with an email channel over the MaxRecipientsPerNotification when
+	// they are added or patched, instead of only truncating the recipients at dispatch
+	RejectExcessRecipients bool
+	// DigestCallback reports the outcome of each dispatched digest to the callback URL in one POST
+	DigestCallback DigestCallbackInfo
+}
+
+// MaxDigestCallbackRetryLimit bounds the retries of the digest callback, so an unreachable callback URL can't hold up
+// the digest dispatch
+const MaxDigestCallbackRetryLimit = 5
+
+type DigestCallbackInfo struct {
+	// URL is the http or https URL the digest batch reports are posted to. Empty disables the digest callback.
+	URL string
+	// RetryLimit is the number of the retries after the first POST fails, up to the MaxDigestCallbackRetryLimit
+	RetryLimit int
+	// RetryInterval is the interval between the retries. The format of this field is the same as ResendInterval, Eg,
+	// "5s"
+	RetryInterval string
+	// Timeout is the timeout of each POST, which is not limited if empty. The format of this field is the same as
+	// ResendInterval, Eg, "10s"
+	Timeout string
+}
+
+// ValidateMaxRecipients validates the MaxRecipientsPerNotification is not negative
+func (w WritableInfo) ValidateMaxRecipients() error {
+	if w.MaxRecipientsPerNotification < 0 {
+		return fmt.Errorf("MaxRecipientsPerNotification must not be negative")
+	}
+	return nil
+}
+
+// ValidateDigestCallback validates the URL, the retry limit, the retry interval and the timeout of the enabled digest
+// callback
+func (w WritableInfo) ValidateDigestCallback() error {
+	callback := w.DigestCallback
+	if callback.URL == "" {
+		return nil
+	}
+	callbackURL, err := url.Parse(callback.URL)
+	if err != nil {
+		return fmt.Errorf("DigestCallback has the invalid URL '%s': %w", callback.URL, err)
+	}
+	if (callbackURL.Scheme != "http" && callbackURL.Scheme != "https") || callbackURL.Host == "" {
+		return fmt.Errorf("DigestCallback URL '%s' must be an absolute http or https URL", callback.URL)
+	}
+	if callback.RetryLimit < 0 || callback.RetryLimit > MaxDigestCallbackRetryLimit {
+		return fmt.Errorf("DigestCallback RetryLimit must be between 0 and %d", MaxDigestCallbackRetryLimit)
+	}
+	for name, value := range map[string]string{"RetryInterval": callback.RetryInterval, "Timeout": callback.Timeout} {
+		if value == "" {
+			continue
+		}
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("DigestCallback has the invalid %s '%s': %w", name, value, err)
+		}
+		if duration < 0 {
+			return fmt.Errorf("DigestCallback %s must not be negative", name)
+		}
+	}
+	return nil
+}
+
+type SubscriptionExpiryInfo struct {
+	// Enabled deletes the subscriptions whose expiresAt label has passed every Interval. The expired subscriptions are
+	// skipped by the dispatch regardless.
+	Enabled bool
+	// Interval is the interval of deleting the expired subscriptions. The format of this field is the same as
+	// ResendInterval, Eg, "10m"
+	Interval string
+}
+
+type FailureAlertInfo struct {
+	// Threshold is the ratio of the failed sends of a subscription over the Window, between 0 and 1, above which the
+	// alert is raised. 0 disables the failure rate monitoring.
+	Threshold float64
+	// Window is the sliding window the failure rate is computed over. The format of this field is the same as
+	// ResendInterval, Eg, "10m"
+	Window string
+	// MinSends is the minimum number of the sends in the Window for the failure rate to be evaluated, so a few failed
+	// sends of a rarely used subscription don't raise the alert
+	MinSends int
+	// Subscription is the name of the ops subscription the alerts are sent to
+	Subscription string
+	// Category and Severity are of the alert notifications, the Severity is CRITICAL if empty
+	Category string
+	Severity string
+}
+
+// ValidateFailureAlert validates the threshold, the window and the ops subscription of the enabled failure alert
+func (w WritableInfo) ValidateFailureAlert() error {
+	alert := w.FailureAlert
+	if alert.Threshold < 0 || alert.Threshold > 1 {
+		return fmt.Errorf("FailureAlert Threshold must be between 0 and 1")
+	}
+	if alert.Threshold == 0 {
+		return nil
+	}
+	window, err := time.ParseDuration(alert.Window)
+	if err != nil {
+		return fmt.Errorf("FailureAlert has the invalid Window '%s': %w", alert.Window, err)
+	}
+	if window <= 0 {
+		return fmt.Errorf("FailureAlert must have a positive Window")
+	}
+	if alert.MinSends < 0 {
+		return fmt.Errorf("FailureAlert MinSends must not be negative")
+	}
+	if alert.Subscription == "" {
+		return fmt.Errorf("FailureAlert must have the Subscription to send the alerts to")
+	}
+	if alert.Severity != "" && w.SeverityRank(alert.Severity) < 0 {
+		return fmt.Errorf("FailureAlert has the unknown Severity '%s'", alert.Severity)
+	}
+	return nil
+}
+
+type RateLimit struct {
+	// MaxNotifications is the maximum number of the notifications dispatched per Interval
+	MaxNotifications int
+	// Interval is the window of the rate limit. The format of this field is the same as ResendInterval, Eg, "1m"
+	Interval string
+}
+
+// ValidateCategoryRateLimits validates each category rate limit has a positive MaxNotifications and Interval
+func (w WritableInfo) ValidateCategoryRateLimits() error {
+	for category, limit := range w.CategoryRateLimits {
+		if limit.MaxNotifications <= 0 {
+			return fmt.Errorf("CategoryRateLimits of the category '%s' must have a positive MaxNotifications", category)
+		}
+		interval, err := time.ParseDuration(limit.Interval)
+		if err != nil {
+			return fmt.Errorf("CategoryRateLimits of the category '%s' has the invalid Interval '%s': %w", category, limit.Interval, err)
+		}
+		if interval <= 0 {
+			return fmt.Errorf("CategoryRateLimits of the category '%s' must have a positive Interval", category)
+		}
+	}
+	return nil
+}
+
+type SendRate struct {
+	// MaxSendRate is the steady number of the sends per second, which is unlimited if not positive
+	MaxSendRate float64
+	// Burst is the number of the sends allowed at once before the sends are throttled to the MaxSendRate, which must be
+	// at least 1 if the MaxSendRate is set
+	Burst int
+}
+
+// ValidateSubscriptionSendRates validates each subscription send rate with a positive MaxSendRate has a Burst of at
+// least 1
+func (w WritableInfo) ValidateSubscriptionSendRates() error {
+	for subscriptionName, rate := range w.SubscriptionSendRates {
+		if rate.MaxSendRate < 0 {
+			return fmt.Errorf("SubscriptionSendRates of the subscription '%s' must not have a negative MaxSendRate", subscriptionName)
+		}
+		if rate.MaxSendRate > 0 && rate.Burst < 1 {
+			return fmt.Errorf("SubscriptionSendRates of the subscription '%s' must have a Burst of at least 1", subscriptionName)
+		}
+	}
+	return nil
+}
+
+type DeliveryWindow struct {
+	// Allowed are the windows the sends are allowed in
+	Allowed []TimeWindow
+	// TimeZone is the IANA time zone of the windows, e.g. "Europe/Berlin", UTC if empty
+	TimeZone string
+	// CriticalOverride sends the notifications at least as severe as CRITICAL outside the windows
+	CriticalOverride bool
+}
+
+type TimeWindow struct {
+	// Days are the days of the week the window opens on, e.g. [Mon, Tue], every day if empty
+	Days []string
+	// Start and End are the times of the day in the format of "15:04", End may be "24:00". The window whose End is
+	// before its Start crosses midnight, e.g. 22:00-06:00 ends on the next day.
+	Start string
+	End   string
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// Weekdays returns the days of the week the window opens on, all the days if Days is empty
+func (w TimeWindow) Weekdays() ([]time.Weekday, error) {
+	if len(w.Days) == 0 {
+		return []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}, nil
+	}
+	days := make([]time.Weekday, 0, len(w.Days))
+	for _, day := range w.Days {
+		weekday, ok := weekdays[strings.ToLower(day)]
+		if !ok {
+			return nil, fmt.Errorf("invalid day '%s', must be one of Mon, Tue, Wed, Thu, Fri, Sat and Sun", day)
+		}
+		days = append(days, weekday)
+	}
+	return days, nil
+}
+
+// Minutes returns the Start and the End as the minutes of the day
+func (w TimeWindow) Minutes() (start, end int, err error) {
+	if start, err = minuteOfDay(w.Start); err != nil {
+		return 0, 0, err
+	}
+	if end, err = minuteOfDay(w.End); err != nil {
+		return 0, 0, err
+	}
+	if start == end {
+		return 0, 0, fmt.Errorf("the window %s-%s is empty", w.Start, w.End)
+	}
+	if start == 24*60 {
+		return 0, 0, fmt.Errorf("the window can't start at %s", w.Start)
+	}
+	return start, end, nil
+}
+
+func minuteOfDay(clock string) (int, error) {
+	if clock == "24:00" {
+		return 24 * 60, nil
+	}
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of the day '%s', must be in the format of 15:04", clock)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Location returns the location of the TimeZone
+func (d DeliveryWindow) Location() (*time.Location, error) {
+	if d.TimeZone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(d.TimeZone)
+}
+
+// ValidateDeliveryWindows validates the time zone, the days and the times of each delivery window
+func (w WritableInfo) ValidateDeliveryWindows() error {
+	for key, window := range w.DeliveryWindows {
+		if len(window.Allowed) == 0 {
+			return fmt.Errorf("DeliveryWindows of the channel '%s' has no allowed window", key)
+		}
+		if _, err := window.Location(); err != nil {
+			return fmt.Errorf("DeliveryWindows of the channel '%s' has the invalid TimeZone '%s': %w", key, window.TimeZone, err)
+		}
+		for _, allowed := range window.Allowed {
+			if _, err := allowed.Weekdays(); err != nil {
+				return fmt.Errorf("DeliveryWindows of the channel '%s' is invalid: %w", key, err)
+			}
+			if _, _, err := allowed.Minutes(); err != nil {
+				return fmt.Errorf("DeliveryWindows of the channel '%s' is invalid: %w", key, err)
+			}
+		}
+	}
+	return nil
+}
+
+const (
+	// DeliveryPolicyAll requires every channel of the subscription to be delivered
+	DeliveryPolicyAll = "all"
+	// DeliveryPolicyAny requires one channel of the subscription to be delivered
+	DeliveryPolicyAny = "any"
+)
+
+// ValidateDeliveryPolicy validates each delivery policy is either "all" or "any"
+func (w WritableInfo) ValidateDeliveryPolicy() error {
+	for subscriptionName, policy := range w.DeliveryPolicy {
+		if policy != DeliveryPolicyAll && policy != DeliveryPolicyAny {
+			return fmt.Errorf("DeliveryPolicy of the subscription '%s' has the invalid policy '%s', must be '%s' or '%s'", subscriptionName, policy, DeliveryPolicyAll, DeliveryPolicyAny)
+		}
+	}
+	return nil
+}
+
+// DefaultSeverityOrder is the built-in ranking of the notification severities from the lowest to the highest
+var DefaultSeverityOrder = []string{string(models.Minor), string(models.Normal), string(models.Critical)}
+
+// ValidateSeverityOrder validates the SeverityOrder ranks each severity once and contains the built-in severities
+func (w WritableInfo) ValidateSeverityOrder() error {
+	if len(w.SeverityOrder) == 0 {
+		return nil
+	}
+	for i, severity := range w.SeverityOrder {
+		if severity == "" {
+			return fmt.Errorf("SeverityOrder contains an empty severity")
+		}
+		if slices.Contains(w.SeverityOrder[:i], severity) {
+			return fmt.Errorf("SeverityOrder contains the duplicate severity '%s'", severity)
+		}
+	}
+	for _, severity := range DefaultSeverityOrder {
+		if !slices.Contains(w.SeverityOrder, severity) {
+			return fmt.Errorf("SeverityOrder misses the severity '%s'", severity)
+		}
+	}
+	return nil
+}
+
+// SeverityRank returns the rank of the severity in the SeverityOrder, the higher the more severe, or -1 if the severity
+// is unknown. The DefaultSeverityOrder is used when the SeverityOrder is empty or invalid.
+func (w WritableInfo) SeverityRank(severity string) int {
+	order := w.SeverityOrder
+	if len(order) == 0 || w.ValidateSeverityOrder() != nil {
+		order = DefaultSeverityOrder
+	}
+	return slices.Index(order, severity)
+}
+
+const (
+	JitterModeNone  = "none"
+	JitterModeFull  = "full"
+	JitterModeEqual = "equal"
+)
+
+type ResendJitterInfo struct {
+	// Mode is the jitter mode, which can be "none", "full" or "equal". The default "none" keeps the fixed resend interval.
+	Mode string
+	// Fraction is the fraction of the resend interval to randomize, between 0 and 1
+	Fraction float64
+}
+
+// Enabled returns whether the jitter is applied to the resend interval
+func (j ResendJitterInfo) Enabled() bool {
+	return (j.Mode == JitterModeFull || j.Mode == JitterModeEqual) && j.Fraction > 0
+}
+
+type OrderingInfo struct {
+	// Subscriptions are the names of the subscriptions whose notifications sharing an ordering key are dispatched
+	// sequentially, "*" orders all the subscriptions. The ordering key is the subscription name, optionally narrowed by
+	// the caller-supplied ordering key label of the notification.
+	Subscriptions []string
+}
+
+// Enabled returns whether the notifications of the subscription are dispatched in order
+func (o OrderingInfo) Enabled(subscriptionName string) bool {
+	return slices.Contains(o.Subscriptions, "*") || slices.Contains(o.Subscriptions, subscriptionName)
+}
+
+// Any returns whether any subscription is ordered
+func (o OrderingInfo) Any() bool {
+	return len(o.Subscriptions) > 0
+}
+
+type ChannelInfo struct {
+	REST   ChannelLimit
+	EMAIL  ChannelLimit
+	MQTT   ChannelLimit
+	ZeroMQ ChannelLimit
+}
+
+type ChannelLimit struct {
+	// MaxInFlight is the maximum number of the concurrent sends via the channel, zero means unlimited
+	MaxInFlight int
+	// InFlightWaitTimeout is the maximum time for a send to wait when MaxInFlight is reached. The send is failed after
+	// the timeout, so the critical notification is resent later. The format of this field is the same as ResendInterval, Eg, "30s"
+	InFlightWaitTimeout string
+	// CircuitBreakerThreshold is the number of the consecutive failed sends to an endpoint of the channel after which
+	// the circuit of the endpoint is opened, zero disables the circuit breaker
+	CircuitBreakerThreshold int
+	// CircuitBreakerCooldown is the time to short-circuit the sends to the endpoint once the circuit is opened, after
+	// which a trial send is allowed. The format of this field is the same as ResendInterval, Eg, "30s"
+	CircuitBreakerCooldown string
+}
+
+// ChannelLimit returns the backpressure limit of the channel type
+func (c ChannelInfo) ChannelLimit(channelType string) ChannelLimit {
+	switch channelType {
+	case common.REST:
+		return c.REST
+	case common.EMAIL:
+		return c.EMAIL
+	case common.MQTT:
+		return c.MQTT
+	case common.ZeroMQ:
+		return c.ZeroMQ
+	}
+	return ChannelLimit{}
 }
 
 type SmtpInfo struct {
@@ -51,8 +504,101 @@ type SmtpInfo struct {
 	// SecretName is used to specify the secret path to store the credential(username and password) for connecting the SMTP server
 	// User need to store the credential via the /secret API before sending the email notification
 	SecretName string
-	// AuthMode is the SMTP authentication mechanism. Currently, 'usernamepassword' is the only AuthMode supported by this service, and the secret keys are 'username' and 'password'.
+	// AuthMode is the SMTP authentication mechanism, which is either 'usernamepassword' or 'oauth2'. The secret keys of
+	// 'usernamepassword' are 'username' and 'password'. The 'oauth2' mode authenticates the 'username' with SASL XOAUTH2
+	// and the bearer token requested from the TokenEndpoint with the client credentials 'clientId' and 'clientSecret'.
 	AuthMode string
+	// TokenEndpoint is the OAuth2 token endpoint of the 'oauth2' AuthMode
+	TokenEndpoint string
+	// Scope is the space-separated scopes of the token requested by the 'oauth2' AuthMode, empty requests the default
+	// scopes of the client
+	Scope string
+}
+
+// SmtpOverrideInfo is the Writable.Smtp section with the same fields as the SmtpInfo. The EnableSelfSignedCert is a
+// pointer, so it can be overridden to false as well as true, while the unset one keeps the top-level Smtp setting.
+type SmtpOverrideInfo struct {
+	Host                 string
+	Port                 int
+	Sender               string
+	EnableSelfSignedCert *bool
+	Subject              string
+	SecretName           string
+	AuthMode             string
+	TokenEndpoint        string
+	Scope                string
+}
+
+// EffectiveSmtpInfo returns the top-level Smtp section overlaid with the non-empty fields of Writable.Smtp
+func (c *ConfigurationStruct) EffectiveSmtpInfo() SmtpInfo {
+	smtp := c.Smtp
+	override := c.Writable.Smtp
+	if override.Host != "" {
+		smtp.Host = override.Host
+	}
+	if override.Port != 0 {
+		smtp.Port = override.Port
+	}
+	if override.Sender != "" {
+		smtp.Sender = override.Sender
+	}
+	if override.EnableSelfSignedCert != nil {
+		smtp.EnableSelfSignedCert = *override.EnableSelfSignedCert
+	}
+	if override.Subject != "" {
+		smtp.Subject = override.Subject
+	}
+	if override.SecretName != "" {
+		smtp.SecretName = override.SecretName
+	}
+	if override.AuthMode != "" {
+		smtp.AuthMode = override.AuthMode
+	}
+	if override.TokenEndpoint != "" {
+		smtp.TokenEndpoint = override.TokenEndpoint
+	}
+	if override.Scope != "" {
+		smtp.Scope = override.Scope
+	}
+	return smtp
+}
+
+// WebhookInfo defines the webhook which the notifications are posted to with a JSON body rendered from the notification
+type WebhookInfo struct {
+	// URL is the absolute http or https URL of the webhook, empty disables the webhook
+	URL string
+	// Method is the HTTP method of the webhook request, empty is POST
+	Method string
+	// SecretName is used to specify the secret path to store the headers of the webhook request, e.g. Authorization.
+	// Each key of the secret is set as a header with its value. Empty sends the request without the secret headers.
+	SecretName string
+	// Timeout is the timeout of the webhook request, e.g. "10s", empty never times out
+	Timeout string
+	// BodyTemplate is the Go template of the JSON body, which is executed with the notification, and the json function
+	// encodes a value as JSON, e.g. {"text": {{json .Content}}}. Empty posts the content, category, severity and sender.
+	BodyTemplate string
+}
+
+// SlackInfo defines the Slack incoming webhook which the notifications are posted to as the Slack messages
+type SlackInfo struct {
+	// Sender is the username of the posted messages, empty uses the default username of the incoming webhook
+	Sender  string
+	Subject string
+	// SecretName is used to specify the secret path to store the URL of the Slack incoming webhook, and the secret key
+	// is 'url'. User need to store the URL via the /secret API before sending the Slack notification
+	SecretName string
+	// Timeout is the timeout of posting a message, e.g. "10s", empty never times out
+	Timeout string
+}
+
+// TeamsInfo defines the Microsoft Teams incoming webhook which the notifications are posted to as the adaptive cards
+type TeamsInfo struct {
+	Subject string
+	// SecretName is used to specify the secret path to store the URL of the Teams incoming webhook, and the secret key
+	// is 'url'. User need to store the URL via the /secret API before sending the Teams notification
+	SecretName string
+	// Timeout is the timeout of posting a card, e.g. "10s", empty never times out
+	Timeout string
 }
 
 type NotificationRetention struct {
@@ -60,6 +606,109 @@ type NotificationRetention struct {
 	Interval string
 	MaxCap   uint32
 	MinCap   uint32
+	// MaxAge is the age of the notifications to purge, e.g. "720h", empty disables the age purging of the notifications
+	// whose category has no MaxAgeByCategory. The age purging keeps the latest MinCap notifications however old they are.
+	MaxAge string
+	// MaxAgeByCategory maps the notification categories to the age of the notifications to purge, which overrides the
+	// MaxAge for the category, e.g. audit: 8760h, and keeps the latest MinCap notifications as well
+	MaxAgeByCategory map[string]string
+	// Categories is the retention rules of the notification categories. The notifications of a category with a rule
+	// are only purged by the MaxCap, MinCap and MaxAge of the rule, and are excluded from the global MaxCap, MinCap and
+	// MaxAge above, which the notifications of the categories without a rule fall back to.
+	Categories []CategoryRetention
+	// Transmission is the retention policy of the transmissions, which is applied separately from the notification
+	// policy above. Since the transmissions belong to their notifications, purging a notification always purges its
+	// transmissions, while purging the transmissions never purges the notifications.
+	Transmission TransmissionRetention
+	// DeadLetter is the retention policy of the dead letters, which are kept after their notifications are purged
+	DeadLetter DeadLetterRetention
+}
+
+// CategoryRetention is the retention rule of the notifications of a category
+type CategoryRetention struct {
+	Category string
+	// MaxCap is the count of the notifications of the category where they are purged to the MinCap, 0 disables the
+	// capacity purging of the category
+	MaxCap uint32
+	MinCap uint32
+	// MaxAge is the age of the notifications of the category to purge, e.g. "2160h", empty disables the age purging
+	// of the category. The age purging keeps the latest MinCap notifications of the category however old they are.
+	MaxAge string
+}
+
+// ValidateMaxAge validates the durations of the MaxAge and the MaxAgeByCategory
+func (r NotificationRetention) ValidateMaxAge() error {
+	if r.MaxAge != "" {
+		if _, err := time.ParseDuration(r.MaxAge); err != nil {
+			return fmt.Errorf("invalid MaxAge '%s': %w", r.MaxAge, err)
+		}
+	}
+	for category, maxAge := range r.MaxAgeByCategory {
+		if _, err := time.ParseDuration(maxAge); err != nil {
+			return fmt.Errorf("invalid MaxAge '%s' of the category '%s' in MaxAgeByCategory: %w", maxAge, category, err)
+		}
+	}
+	return nil
+}
+
+// ValidateCategories validates the retention rules of the categories, each category has at most one rule and is not in
+// the MaxAgeByCategory as well
+func (r NotificationRetention) ValidateCategories() error {
+	categories := make(map[string]bool, len(r.Categories))
+	for _, rule := range r.Categories {
+		if rule.Category == "" {
+			return fmt.Errorf("the category of the retention rule is empty")
+		}
+		if categories[rule.Category] {
+			return fmt.Errorf("duplicate retention rules of the category '%s'", rule.Category)
+		}
+		categories[rule.Category] = true
+		if _, ok := r.MaxAgeByCategory[rule.Category]; ok {
+			return fmt.Errorf("the category '%s' has both a retention rule and a MaxAgeByCategory", rule.Category)
+		}
+		if rule.MaxCap > 0 && rule.MinCap > rule.MaxCap {
+			return fmt.Errorf("the MinCap %d of the category '%s' is greater than its MaxCap %d", rule.MinCap, rule.Category, rule.MaxCap)
+		}
+		if rule.MaxAge != "" {
+			if _, err := time.ParseDuration(rule.MaxAge); err != nil {
+				return fmt.Errorf("invalid MaxAge '%s' of the category '%s': %w", rule.MaxAge, rule.Category, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateTransmission validates the retention policy of the transmissions, the MinCap is not greater than the MaxCap
+// and the MaxAge is a positive duration
+func (r NotificationRetention) ValidateTransmission() error {
+	if r.Transmission.MaxCap > 0 && r.Transmission.MinCap > r.Transmission.MaxCap {
+		return fmt.Errorf("the MinCap %d of the transmissions is greater than their MaxCap %d", r.Transmission.MinCap, r.Transmission.MaxCap)
+	}
+	if r.Transmission.MaxAge != "" {
+		maxAge, err := time.ParseDuration(r.Transmission.MaxAge)
+		if err != nil {
+			return fmt.Errorf("invalid MaxAge '%s' of the transmissions: %w", r.Transmission.MaxAge, err)
+		}
+		if maxAge <= 0 {
+			return fmt.Errorf("the MaxAge '%s' of the transmissions is not positive", r.Transmission.MaxAge)
+		}
+	}
+	return nil
+}
+
+type TransmissionRetention struct {
+	// MaxCap is the high watermark of the transmissions for purging the processed transmissions down to MinCap, zero
+	// disables the capacity purging
+	MaxCap uint32
+	MinCap uint32
+	// MaxAge is the age of the processed transmissions to purge, e.g. "168h", empty disables the age purging
+	MaxAge string
+}
+
+type DeadLetterRetention struct {
+	// MaxAge is the age of the dead letters to purge, e.g. "2160h", empty keeps the dead letters until they are
+	// re-enqueued or deleted
+	MaxAge string
 }
 
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
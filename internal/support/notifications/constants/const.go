@@ -0,0 +1,59 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+// Report is the path segment of the report APIs
+const Report = "report"
+
+// Ordering is the path segment of the subscription ordering API
+const Ordering = "ordering"
+
+// Enabled is the path segment of the subscription bulk toggle API
+const Enabled = "enabled"
+
+// Search is the path segment of the notification content search API
+const Search = "search"
+
+// Delivery is the path segment of the notification delivery status API
+const Delivery = "delivery"
+
+// Audit is the path segment of the notification dispatch audit API
+const Audit = "audit"
+
+// Resend is the path segment of the notification manual resend API
+const Resend = "resend"
+
+// NotificationTemplate is the path segment of the notification template APIs
+const NotificationTemplate = "notificationtemplate"
+
+// DeadLetter is the path segment of the dead letter APIs
+const DeadLetter = "deadletter"
+
+// ReEnqueue is the path segment of the dead letter re-enqueue API
+const ReEnqueue = "reenqueue"
+
+// Constants related to defined routes in the support notifications service APIs, which will be added to go-mod-core-contracts in the future
+const (
+	ApiNotificationBySenderRoute                 = common.ApiNotificationRoute + "/" + common.Sender + "/:" + common.Sender
+	ApiNotificationContentSearchRoute            = common.ApiNotificationRoute + "/" + Search
+	ApiTransmissionReportBySubscriptionNameRoute = common.ApiTransmissionBySubscriptionNameRoute + "/" + Report
+	ApiSubscriptionOrderingByNameRoute           = common.ApiSubscriptionByNameRoute + "/" + Ordering
+	ApiSubscriptionEnabledByLabelRoute           = common.ApiSubscriptionByLabelRoute + "/" + Enabled + "/:" + Enabled
+	ApiNotificationDeliveryByIdRoute             = common.ApiNotificationByIdRoute + "/" + Delivery
+	ApiNotificationDispatchAuditByIdRoute        = common.ApiNotificationByIdRoute + "/" + Audit
+	ApiNotificationResendByIdRoute               = common.ApiNotificationByIdRoute + "/" + Resend
+	ApiNotificationTemplateRoute                 = common.ApiBase + "/" + NotificationTemplate
+	ApiAllNotificationTemplateRoute              = ApiNotificationTemplateRoute + "/" + common.All
+	ApiNotificationTemplateByNameRoute           = ApiNotificationTemplateRoute + "/" + common.Name + "/:" + common.Name
+	ApiDeadLetterRoute                           = common.ApiBase + "/" + DeadLetter
+	ApiAllDeadLetterRoute                        = ApiDeadLetterRoute + "/" + common.All
+	ApiDeadLetterByIdRoute                       = ApiDeadLetterRoute + "/" + common.Id + "/:" + common.Id
+	ApiDeadLetterReEnqueueByIdRoute              = ApiDeadLetterByIdRoute + "/" + ReEnqueue
+)
@@ -0,0 +1,108 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/labstack/echo/v4"
+)
+
+// MultiDeadLettersResponse defines the response of the multiple dead letters query
+type MultiDeadLettersResponse struct {
+	commonDTO.BaseWithTotalCountResponse `json:",inline"`
+	DeadLetters                          []notificationModels.DeadLetter `json:"deadLetters"`
+}
+
+type DeadLetterController struct {
+	dic *di.Container
+}
+
+// NewDeadLetterController creates and initializes an DeadLetterController
+func NewDeadLetterController(dic *di.Container) *DeadLetterController {
+	return &DeadLetterController{
+		dic: dic,
+	}
+}
+
+// AllDeadLetters queries the dead letters of the notifications failed permanently from the latest one by offset and limit
+func (dc *DeadLetterController) AllDeadLetters(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := notificationContainer.ConfigurationFrom(dc.dic.Get)
+
+	// parse URL query string for offset and limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	deadLetters, totalCount, err := application.ExhaustedNotifications(offset, limit, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := MultiDeadLettersResponse{
+		BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, totalCount),
+		DeadLetters:                deadLetters,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// ReEnqueueDeadLetterById transmits the notification of the dead letter again in the background and deletes the dead
+// letter
+func (dc *DeadLetterController) ReEnqueueDeadLetterById(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	id := c.Param(common.Id)
+
+	err := application.ReEnqueueExhaustedNotification(id, ctx, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusAccepted)
+	utils.WriteHttpHeader(w, ctx, http.StatusAccepted)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (dc *DeadLetterController) DeleteDeadLetterById(c echo.Context) error {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	id := c.Param(common.Id)
+
+	err := application.DeleteDeadLetterById(id, dc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -0,0 +1,205 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllDeadLetters(t *testing.T) {
+	deadLetters := []notificationModels.DeadLetter{
+		{Id: "a0b9f6c1-2a2d-4e25-9a8e-2c1c3ad4d2e1", SubscriptionName: testSubscriptionName, Status: string(models.Escalated), AttemptCount: 3},
+		{Id: "d6c2cf1f-58c3-4b69-8b79-2f7f8e0f1b7a", SubscriptionName: testSubscriptionName, Status: string(models.Failed), AttemptCount: 1},
+	}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeadLetterTotalCount").Return(uint32(len(deadLetters)), nil)
+	dbClientMock.On("AllDeadLetters", 0, 20).Return(deadLetters, nil)
+	dbClientMock.On("AllDeadLetters", 1, 1).Return(deadLetters[1:], nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeadLetterController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		offset             string
+		limit              string
+		errorExpected      bool
+		expectedCount      int
+		expectedTotalCount uint32
+		expectedStatusCode int
+	}{
+		{"Valid - get dead letters without offset and limit", "0", "20", false, 2, 2, http.StatusOK},
+		{"Valid - get dead letters with offset and limit", "1", "1", false, 1, 2, http.StatusOK},
+		{"Invalid - offset out of range", "3", "1", true, 0, 0, http.StatusRequestedRangeNotSatisfiable},
+		{"Invalid - invalid offset format", "aaa", "1", true, 0, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiAllDeadLetterRoute, http.NoBody)
+			query := req.URL.Query()
+			query.Add(common.Offset, testCase.offset)
+			query.Add(common.Limit, testCase.limit)
+			req.URL.RawQuery = query.Encode()
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.AllDeadLetters(c)
+			require.NoError(t, err)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res MultiDeadLettersResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.Equal(t, testCase.expectedCount, len(res.DeadLetters), "Dead letter count not as expected")
+				assert.Equal(t, testCase.expectedTotalCount, res.TotalCount, "Total count not as expected")
+			}
+		})
+	}
+}
+
+func TestReEnqueueDeadLetterById(t *testing.T) {
+	lockedDeadLetter := notificationModels.DeadLetter{Id: "a0b9f6c1-2a2d-4e25-9a8e-2c1c3ad4d2e1", SubscriptionName: "locked"}
+	notFoundId := "d6c2cf1f-58c3-4b69-8b79-2f7f8e0f1b7a"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeadLetterById", lockedDeadLetter.Id).Return(lockedDeadLetter, nil)
+	dbClientMock.On("DeadLetterById", notFoundId).Return(notificationModels.DeadLetter{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "dead letter doesn't exist", nil))
+	dbClientMock.On("SubscriptionByName", lockedDeadLetter.SubscriptionName).Return(models.Subscription{Name: lockedDeadLetter.SubscriptionName, AdminState: models.Locked}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeadLetterController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		deadLetterId       string
+		expectedStatusCode int
+	}{
+		{"Invalid - subscription is locked", lockedDeadLetter.Id, http.StatusConflict},
+		{"Invalid - dead letter not found", notFoundId, http.StatusNotFound},
+		{"Invalid - id is not UUID", "dead-letter", http.StatusBadRequest},
+		{"Invalid - id parameter is empty", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			reqPath := fmt.Sprintf("%s/%s/%s/%s", constants.ApiDeadLetterRoute, common.Id, testCase.deadLetterId, constants.ReEnqueue)
+			req, err := http.NewRequest(http.MethodPost, reqPath, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Id)
+			c.SetParamValues(testCase.deadLetterId)
+			err = controller.ReEnqueueDeadLetterById(c)
+			require.NoError(t, err)
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+		})
+	}
+}
+
+func TestDeleteDeadLetterById(t *testing.T) {
+	validId := "a0b9f6c1-2a2d-4e25-9a8e-2c1c3ad4d2e1"
+	notFoundId := "d6c2cf1f-58c3-4b69-8b79-2f7f8e0f1b7a"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeleteDeadLetterById", validId).Return(nil)
+	dbClientMock.On("DeleteDeadLetterById", notFoundId).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "dead letter doesn't exist", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeadLetterController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		deadLetterId       string
+		expectedStatusCode int
+	}{
+		{"Valid - delete dead letter by id", validId, http.StatusOK},
+		{"Invalid - dead letter not found", notFoundId, http.StatusNotFound},
+		{"Invalid - id parameter is empty", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			reqPath := fmt.Sprintf("%s/%s/%s", constants.ApiDeadLetterRoute, common.Id, testCase.deadLetterId)
+			req, err := http.NewRequest(http.MethodDelete, reqPath, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Id)
+			c.SetParamValues(testCase.deadLetterId)
+			err = controller.DeleteDeadLetterById(c)
+			require.NoError(t, err)
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+		})
+	}
+}
@@ -33,7 +33,10 @@ import (
 )
 
 const (
-	defaultEnd = int64(7289539200000) // December 31st 2200, 12:00:00
+	defaultEnd        = int64(7289539200000) // December 31st 2200, 12:00:00
+	persistQueryParam = "persist"            // query param to specify whether to store the notifications to the database
+	searchQueryParam  = "query"              // query param to specify the text to search in the notification content
+	forceQueryParam   = "force"              // query param to specify whether to resend the processed notification
 )
 
 type NotificationController struct {
@@ -61,6 +64,9 @@ func (nc *NotificationController) AddNotification(c echo.Context) error {
 	ctx := r.Context()
 	correlationId := correlation.FromContext(ctx)
 
+	// parse URL query string for persist, the notifications are persisted by default
+	persist := utils.ParseQueryStringToString(r, persistQueryParam, common.ValueTrue) != common.ValueFalse
+
 	var reqDTOs []requestDTO.AddNotificationRequest
 	err := nc.reader.Read(r.Body, &reqDTOs)
 	if err != nil {
@@ -72,7 +78,12 @@ func (nc *NotificationController) AddNotification(c echo.Context) error {
 	for i, n := range notifications {
 		var response interface{}
 		reqId := reqDTOs[i].RequestId
-		newId, err := application.AddNotification(n, ctx, nc.dic)
+		var newId string
+		if persist {
+			newId, err = application.AddNotification(n, ctx, nc.dic)
+		} else {
+			newId, err = application.DispatchNotification(n, ctx, nc.dic)
+		}
 		if err != nil {
 			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
 			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
@@ -113,6 +124,96 @@ func (nc *NotificationController) NotificationById(c echo.Context) error {
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
 
+// NotificationDeliveryResponse defines the response of the notification delivery status
+type NotificationDeliveryResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Delivery               application.NotificationDeliveryStatus `json:"delivery"`
+}
+
+// NotificationDeliveryById returns the delivery status of the specified notification to each channel of the
+// subscriptions it is transmitted to
+func (nc *NotificationController) NotificationDeliveryById(c echo.Context) error {
+	lc := container.LoggingClientFrom(nc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	id := c.Param(common.Id)
+
+	delivery, err := application.NotificationDeliveryStatusById(id, nc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := NotificationDeliveryResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		Delivery:     delivery,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// NotificationDispatchAuditResponse defines the response of the notification dispatch audit trail
+type NotificationDispatchAuditResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Audit                  application.DispatchAudit `json:"audit"`
+}
+
+// NotificationDispatchAuditById returns the dispatch audit trail of the specified notification, along with whether
+// the hash chain of the trail is intact
+func (nc *NotificationController) NotificationDispatchAuditById(c echo.Context) error {
+	lc := container.LoggingClientFrom(nc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	id := c.Param(common.Id)
+
+	audit, err := application.NotificationDispatchAudit(id, nc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := NotificationDispatchAuditResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		Audit:        audit,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// ResendNotificationResponse defines the response of the notification manual resend
+type ResendNotificationResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	TransmissionCount      int `json:"transmissionCount"`
+}
+
+// ResendNotificationById transmits the notification again to the channels of its subscriptions with fresh transmissions
+func (nc *NotificationController) ResendNotificationById(c echo.Context) error {
+	lc := container.LoggingClientFrom(nc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	id := c.Param(common.Id)
+	force := utils.ParseQueryStringToString(r, forceQueryParam, common.ValueFalse) == common.ValueTrue
+
+	count, err := application.ResendNotificationById(id, force, ctx, nc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := ResendNotificationResponse{
+		BaseResponse:      commonDTO.NewBaseResponse("", "", http.StatusAccepted),
+		TransmissionCount: count,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusAccepted)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
 func (nc *NotificationController) NotificationsByCategory(c echo.Context) error {
 	lc := container.LoggingClientFrom(nc.dic.Get)
 	r := c.Request()
@@ -197,6 +298,54 @@ func (nc *NotificationController) NotificationsByStatus(c echo.Context) error {
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
 
+func (nc *NotificationController) NotificationsBySender(c echo.Context) error {
+	lc := container.LoggingClientFrom(nc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := notificationContainer.ConfigurationFrom(nc.dic.Get)
+
+	sender := c.Param(common.Sender)
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	notifications, totalCount, err := application.NotificationsBySender(offset, limit, sender, nc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewMultiNotificationsResponse("", "", http.StatusOK, totalCount, notifications)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (nc *NotificationController) NotificationsByContentSearch(c echo.Context) error {
+	lc := container.LoggingClientFrom(nc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := notificationContainer.ConfigurationFrom(nc.dic.Get)
+
+	query := utils.ParseQueryStringToString(r, searchQueryParam, "")
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	notifications, totalCount, err := application.NotificationsByContentSearch(offset, limit, query, nc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewMultiNotificationsResponse("", "", http.StatusOK, totalCount, notifications)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
 func (nc *NotificationController) NotificationsByTimeRange(c echo.Context) error {
 	lc := container.LoggingClientFrom(nc.dic.Get)
 	r := c.Request()
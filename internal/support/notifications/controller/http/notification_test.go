@@ -15,8 +15,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 
@@ -60,7 +62,7 @@ func TestAddNotification(t *testing.T) {
 	dbClientMock.On("AddNotification", model).Return(model, nil)
 	model.Status = models.Processed
 	dbClientMock.On("UpdateNotification", model).Return(nil)
-	dbClientMock.On("SubscriptionsByCategoriesAndLabels", 0, -1, []string{testNotificationCategory}, testNotificationLabels).Return([]models.Subscription{}, nil)
+	dbClientMock.On("AllSubscriptions", 0, -1).Return([]models.Subscription{}, nil)
 
 	noRequestId := validRequest
 	noRequestId.RequestId = ""
@@ -150,6 +152,43 @@ func TestAddNotification(t *testing.T) {
 	}
 }
 
+func TestAddNotification_WithoutPersistence(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllSubscriptions", 0, -1).Return([]models.Subscription{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewNotificationController(dic)
+	require.NotNil(t, controller)
+
+	e := echo.New()
+	jsonData, err := json.Marshal([]requests.AddNotificationRequest{buildTestAddNotificationRequest()})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, common.ApiNotificationRoute, strings.NewReader(string(jsonData)))
+	require.NoError(t, err)
+	query := req.URL.Query()
+	query.Add(persistQueryParam, common.ValueFalse)
+	req.URL.RawQuery = query.Encode()
+
+	// Act
+	recorder := httptest.NewRecorder()
+	c := e.NewContext(req, recorder)
+	err = controller.AddNotification(c)
+	require.NoError(t, err)
+
+	// Assert
+	var res []commonDTO.BaseWithIdResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, http.StatusCreated, res[0].StatusCode, "BaseResponse status code not as expected")
+	assert.NotEmpty(t, res[0].Id, "the id of the non-persisted notification should be generated")
+	dbClientMock.AssertNotCalled(t, "AddNotification", mock.Anything)
+}
+
 func TestNotificationById(t *testing.T) {
 	request := buildTestAddNotificationRequest()
 	notification := dtos.ToNotificationModel(request.Notification)
@@ -444,6 +483,164 @@ func TestNotificationsByStatus(t *testing.T) {
 	}
 }
 
+func TestNotificationsBySender(t *testing.T) {
+	testSender := "core-metadata"
+	expectedNotificationCount := uint32(2)
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("NotificationCountBySender", testSender).Return(expectedNotificationCount, nil)
+	dbClientMock.On("NotificationsBySender", 0, 20, testSender).Return([]models.Notification{{Sender: testSender}, {Sender: testSender}}, nil)
+	dbClientMock.On("NotificationsBySender", 0, 1, testSender).Return([]models.Notification{{Sender: testSender}}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewNotificationController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		offset             string
+		limit              string
+		sender             string
+		errorExpected      bool
+		expectedCount      int
+		expectedTotalCount uint32
+		expectedStatusCode int
+	}{
+		{"Valid - get notifications without offset, and limit", "", "", testSender, false, 2, expectedNotificationCount, http.StatusOK},
+		{"Valid - get notifications with offset, and limit", "0", "1", testSender, false, 1, expectedNotificationCount, http.StatusOK},
+		{"Invalid - invalid offset format", "aaa", "1", testSender, true, 0, 0, http.StatusBadRequest},
+		{"Invalid - invalid limit format", "1", "aaa", testSender, true, 0, 0, http.StatusBadRequest},
+		{"Invalid - empty sender", "", "", "", true, 0, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiNotificationBySenderRoute, http.NoBody)
+			query := req.URL.Query()
+			if testCase.offset != "" {
+				query.Add(common.Offset, testCase.offset)
+			}
+			if testCase.limit != "" {
+				query.Add(common.Limit, testCase.limit)
+			}
+			req.URL.RawQuery = query.Encode()
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Sender)
+			c.SetParamValues(testCase.sender)
+			err = controller.NotificationsBySender(c)
+			require.NoError(t, err)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res responseDTO.MultiNotificationsResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.Equal(t, testCase.expectedTotalCount, res.TotalCount, "Response total count not as expected")
+				assert.Len(t, res.Notifications, testCase.expectedCount, "Notification count not as expected")
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+			}
+		})
+	}
+}
+
+func TestNotificationsByContentSearch(t *testing.T) {
+	testQuery := "device-001"
+	expectedNotificationCount := uint32(2)
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("NotificationCountByContentSearch", testQuery).Return(expectedNotificationCount, nil)
+	dbClientMock.On("NotificationsByContentSearch", 0, 20, testQuery).Return([]models.Notification{{Content: "device-001 is down"}, {Content: "device-001 is up"}}, nil)
+	dbClientMock.On("NotificationsByContentSearch", 0, 1, testQuery).Return([]models.Notification{{Content: "device-001 is down"}}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewNotificationController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		offset             string
+		limit              string
+		query              string
+		errorExpected      bool
+		expectedCount      int
+		expectedTotalCount uint32
+		expectedStatusCode int
+	}{
+		{"Valid - search notifications without offset, and limit", "", "", testQuery, false, 2, expectedNotificationCount, http.StatusOK},
+		{"Valid - search notifications with offset, and limit", "0", "1", testQuery, false, 1, expectedNotificationCount, http.StatusOK},
+		{"Invalid - invalid limit format", "1", "aaa", testQuery, true, 0, 0, http.StatusBadRequest},
+		{"Invalid - empty query", "", "", "", true, 0, 0, http.StatusBadRequest},
+		{"Invalid - blank query", "", "", "  ", true, 0, 0, http.StatusBadRequest},
+		{"Invalid - query too long", "", "", strings.Repeat("a", 257), true, 0, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiNotificationContentSearchRoute, http.NoBody)
+			query := req.URL.Query()
+			if testCase.offset != "" {
+				query.Add(common.Offset, testCase.offset)
+			}
+			if testCase.limit != "" {
+				query.Add(common.Limit, testCase.limit)
+			}
+			if testCase.query != "" {
+				query.Add(searchQueryParam, testCase.query)
+			}
+			req.URL.RawQuery = query.Encode()
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.NotificationsByContentSearch(c)
+			require.NoError(t, err)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res responseDTO.MultiNotificationsResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.Equal(t, testCase.expectedTotalCount, res.TotalCount, "Response total count not as expected")
+				assert.Len(t, res.Notifications, testCase.expectedCount, "Notification count not as expected")
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+			}
+		})
+	}
+}
+
 func TestNotificationsByTimeRange(t *testing.T) {
 	expectedNotificationCount := uint32(0)
 	dic := mockDic()
@@ -1057,3 +1254,195 @@ func TestUpdateNotificationAckStatusByIds(t *testing.T) {
 		})
 	}
 }
+
+func TestNotificationDeliveryById(t *testing.T) {
+	notificationId := "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc"
+	notFoundId := "1208bbca-8521-434a-a923-66255a68ba00"
+	subscriptionName := "testSubscription"
+	address := models.RESTAddress{BaseAddress: models.BaseAddress{Type: common.REST, Host: "localhost", Port: 8080}, HTTPMethod: http.MethodPost}
+	transmissions := []models.Transmission{{Id: "1", SubscriptionName: subscriptionName, Channel: address, Status: models.Sent}}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("NotificationById", notificationId).Return(models.Notification{Id: notificationId}, nil)
+	dbClientMock.On("NotificationById", notFoundId).Return(models.Notification{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "notification doesn't exist in the database", nil))
+	dbClientMock.On("TransmissionsByNotificationId", 0, -1, notificationId).Return(transmissions, nil)
+	dbClientMock.On("SubscriptionByName", subscriptionName).Return(models.Subscription{Name: subscriptionName, Channels: []models.Address{address}}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewNotificationController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		notificationId     string
+		expectedStatusCode int
+	}{
+		{"Valid - delivery of the notification", notificationId, http.StatusOK},
+		{"Invalid - ID parameter is not a valid UUID", "invalidId", http.StatusBadRequest},
+		{"Invalid - notification not found by ID", notFoundId, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiNotificationDeliveryByIdRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Id)
+			c.SetParamValues(testCase.notificationId)
+			err = controller.NotificationDeliveryById(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			var res NotificationDeliveryResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.True(t, res.Delivery.Delivered)
+			require.Len(t, res.Delivery.Subscriptions, 1)
+			assert.Equal(t, subscriptionName, res.Delivery.Subscriptions[0].SubscriptionName)
+			require.Len(t, res.Delivery.Subscriptions[0].Channels, 1)
+			assert.Equal(t, common.REST, res.Delivery.Subscriptions[0].Channels[0].Channel.Type)
+		})
+	}
+}
+
+func TestNotificationDispatchAuditById(t *testing.T) {
+	notificationId := "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc"
+	notFoundId := "1208bbca-8521-434a-a923-66255a68ba00"
+	records := []notificationModels.DispatchAuditRecord{
+		{Id: "1", NotificationId: notificationId, Sequence: 1, Event: notificationModels.DispatchAuditAccepted},
+		{Id: "2", NotificationId: notificationId, Sequence: 2, Event: notificationModels.DispatchAuditRouted},
+	}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DispatchAuditRecordsByNotificationId", notificationId).Return(records, nil)
+	dbClientMock.On("DispatchAuditRecordsByNotificationId", notFoundId).Return(nil, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewNotificationController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		notificationId     string
+		expectedStatusCode int
+	}{
+		{"Valid - dispatch audit of the notification", notificationId, http.StatusOK},
+		{"Invalid - ID parameter is not a valid UUID", "invalidId", http.StatusBadRequest},
+		{"Invalid - no dispatch audit of the notification", notFoundId, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiNotificationDispatchAuditByIdRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Id)
+			c.SetParamValues(testCase.notificationId)
+			err = controller.NotificationDispatchAuditById(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			var res NotificationDispatchAuditResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, notificationId, res.Audit.NotificationId)
+			require.Len(t, res.Audit.Records, 2)
+			assert.Equal(t, notificationModels.DispatchAuditRouted, res.Audit.Records[1].Event)
+			assert.False(t, res.Audit.Intact, "the records without the hashes should not be intact")
+		})
+	}
+}
+
+func TestResendNotificationById(t *testing.T) {
+	processedId := "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc"
+	notFoundId := "1208bbca-8521-434a-a923-66255a68ba00"
+	processed := models.Notification{Id: processedId, Category: "resend", Severity: models.Normal, Status: models.Processed}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("NotificationById", processedId).Return(processed, nil)
+	dbClientMock.On("NotificationById", notFoundId).Return(models.Notification{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "notification doesn't exist", nil))
+	dbClientMock.On("TransmissionsByNotificationId", 0, -1, processedId).Return([]models.Transmission{}, nil)
+	dbClientMock.On("AllSubscriptions", 0, -1).Return([]models.Subscription{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewNotificationController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		notificationId     string
+		force              string
+		expectedStatusCode int
+	}{
+		{"Valid - force the resend of the processed notification", processedId, common.ValueTrue, http.StatusAccepted},
+		{"Invalid - processed notification without force", processedId, "", http.StatusConflict},
+		{"Invalid - notification not found", notFoundId, common.ValueTrue, http.StatusNotFound},
+		{"Invalid - ID parameter is not a valid UUID", "invalidId", common.ValueTrue, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodPost, constants.ApiNotificationResendByIdRoute, http.NoBody)
+			require.NoError(t, err)
+			if testCase.force != "" {
+				query := req.URL.Query()
+				query.Add(forceQueryParam, testCase.force)
+				req.URL.RawQuery = query.Encode()
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Id)
+			c.SetParamValues(testCase.notificationId)
+			err = controller.ResendNotificationById(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			var res ResendNotificationResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode != http.StatusAccepted {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			assert.Zero(t, res.TransmissionCount, "no subscription of the notification to resend to")
+		})
+	}
+}
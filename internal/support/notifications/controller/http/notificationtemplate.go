@@ -0,0 +1,180 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// NotificationTemplateRequest defines the request to add or update a notification template
+type NotificationTemplateRequest struct {
+	commonDTO.BaseRequest `json:",inline"`
+	NotificationTemplate  notificationModels.NotificationTemplate `json:"notificationTemplate"`
+}
+
+// NotificationTemplateResponse defines the response of the notification template query
+type NotificationTemplateResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	NotificationTemplate   notificationModels.NotificationTemplate `json:"notificationTemplate"`
+}
+
+// MultiNotificationTemplatesResponse defines the response of the multiple notification templates query
+type MultiNotificationTemplatesResponse struct {
+	commonDTO.BaseWithTotalCountResponse `json:",inline"`
+	NotificationTemplates                []notificationModels.NotificationTemplate `json:"notificationTemplates"`
+}
+
+type NotificationTemplateController struct {
+	reader io.DtoReader
+	dic    *di.Container
+}
+
+// NewNotificationTemplateController creates and initializes an NotificationTemplateController
+func NewNotificationTemplateController(dic *di.Container) *NotificationTemplateController {
+	return &NotificationTemplateController{
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
+	}
+}
+
+func (tc *NotificationTemplateController) AddNotificationTemplate(c echo.Context) error {
+	return tc.saveNotificationTemplates(c, true)
+}
+
+func (tc *NotificationTemplateController) UpdateNotificationTemplate(c echo.Context) error {
+	return tc.saveNotificationTemplates(c, false)
+}
+
+// saveNotificationTemplates adds or updates the notification templates of the request body and writes the multi-status
+// response
+func (tc *NotificationTemplateController) saveNotificationTemplates(c echo.Context, add bool) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(tc.dic.Get)
+
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var reqDTOs []NotificationTemplateRequest
+	err := tc.reader.Read(r.Body, &reqDTOs)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	var responses []interface{}
+	for _, req := range reqDTOs {
+		var response interface{}
+		var newId string
+		var err errors.EdgeX
+		if add {
+			newId, err = application.AddNotificationTemplate(req.NotificationTemplate, ctx, tc.dic)
+		} else {
+			err = application.UpdateNotificationTemplate(req.NotificationTemplate, ctx, tc.dic)
+		}
+		switch {
+		case err != nil:
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			response = commonDTO.NewBaseResponse(req.RequestId, err.Message(), err.Code())
+		case add:
+			response = commonDTO.NewBaseWithIdResponse(req.RequestId, "", http.StatusCreated, newId)
+		default:
+			response = commonDTO.NewBaseResponse(req.RequestId, "", http.StatusOK)
+		}
+		responses = append(responses, response)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	return pkg.EncodeAndWriteResponse(responses, w, lc)
+}
+
+func (tc *NotificationTemplateController) AllNotificationTemplates(c echo.Context) error {
+	lc := container.LoggingClientFrom(tc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := notificationContainer.ConfigurationFrom(tc.dic.Get)
+
+	// parse URL query string for offset and limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	templates, totalCount, err := application.AllNotificationTemplates(offset, limit, tc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := MultiNotificationTemplatesResponse{
+		BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, totalCount),
+		NotificationTemplates:      templates,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (tc *NotificationTemplateController) NotificationTemplateByName(c echo.Context) error {
+	lc := container.LoggingClientFrom(tc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	template, err := application.NotificationTemplateByName(name, tc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := NotificationTemplateResponse{
+		BaseResponse:         commonDTO.NewBaseResponse("", "", http.StatusOK),
+		NotificationTemplate: template,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (tc *NotificationTemplateController) DeleteNotificationTemplateByName(c echo.Context) error {
+	lc := container.LoggingClientFrom(tc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	err := application.DeleteNotificationTemplateByName(name, ctx, tc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
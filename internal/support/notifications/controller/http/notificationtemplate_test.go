@@ -0,0 +1,131 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddNotificationTemplate(t *testing.T) {
+	valid := notificationModels.NotificationTemplate{Name: "alarm", Content: "[{{.Severity}}] {{.Content}}"}
+	duplicate := notificationModels.NotificationTemplate{Name: "duplicate", Content: "{{.Content}}"}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddNotificationTemplate", valid).Return(notificationModels.NotificationTemplate{Id: "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc"}, nil)
+	dbClientMock.On("AddNotificationTemplate", duplicate).Return(duplicate, errors.NewCommonEdgeX(errors.KindDuplicateName, "notification template name duplicate already exists", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewNotificationTemplateController(dic)
+	require.NotNil(t, controller)
+
+	reqs := []NotificationTemplateRequest{
+		{BaseRequest: commonDTO.NewBaseRequest(), NotificationTemplate: valid},
+		{BaseRequest: commonDTO.NewBaseRequest(), NotificationTemplate: duplicate},
+		{BaseRequest: commonDTO.NewBaseRequest(), NotificationTemplate: notificationModels.NotificationTemplate{Name: "broken", Content: "{{.Content"}},
+	}
+	jsonData, err := json.Marshal(reqs)
+	require.NoError(t, err)
+
+	e := echo.New()
+	req, err := http.NewRequest(http.MethodPost, constants.ApiNotificationTemplateRoute, strings.NewReader(string(jsonData)))
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	c := e.NewContext(req, recorder)
+	err = controller.AddNotificationTemplate(c)
+	require.NoError(t, err)
+
+	var res []commonDTO.BaseWithIdResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode)
+	require.Len(t, res, 3)
+	assert.Equal(t, http.StatusCreated, int(res[0].StatusCode))
+	assert.NotEmpty(t, res[0].Id)
+	assert.Equal(t, http.StatusConflict, int(res[1].StatusCode))
+	assert.Equal(t, http.StatusBadRequest, int(res[2].StatusCode))
+}
+
+func TestDeleteNotificationTemplateByName(t *testing.T) {
+	referenced := "referenced"
+	unreferenced := "unreferenced"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("NotificationTemplateByName", referenced).Return(notificationModels.NotificationTemplate{Name: referenced}, nil)
+	dbClientMock.On("NotificationTemplateByName", unreferenced).Return(notificationModels.NotificationTemplate{Name: unreferenced}, nil)
+	dbClientMock.On("SubscriptionCountByLabel", application.TemplateLabelPrefix+referenced).Return(uint32(1), nil)
+	dbClientMock.On("SubscriptionCountByLabel", application.DigestTemplateLabelPrefix+referenced).Return(uint32(0), nil)
+	dbClientMock.On("SubscriptionCountByLabel", application.TemplateLabelPrefix+unreferenced).Return(uint32(0), nil)
+	dbClientMock.On("SubscriptionCountByLabel", application.DigestTemplateLabelPrefix+unreferenced).Return(uint32(0), nil)
+	dbClientMock.On("DeleteNotificationTemplateByName", unreferenced).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewNotificationTemplateController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		templateName       string
+		expectedStatusCode int
+	}{
+		{"Valid - delete notification template by name", unreferenced, http.StatusOK},
+		{"Invalid - notification template referenced by subscription", referenced, http.StatusConflict},
+		{"Invalid - name parameter is empty", "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			reqPath := fmt.Sprintf("%s/%s/%s", constants.ApiNotificationTemplateRoute, common.Name, testCase.templateName)
+			req, err := http.NewRequest(http.MethodDelete, reqPath, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.templateName)
+			err = controller.DeleteNotificationTemplateByName(c)
+			require.NoError(t, err)
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+		})
+	}
+}
@@ -8,12 +8,14 @@ package http
 import (
 	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
 	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
@@ -23,6 +25,7 @@ import (
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	"github.com/labstack/echo/v4"
 )
@@ -59,6 +62,30 @@ func (sc *SubscriptionController) AddSubscription(c echo.Context) error {
 	}
 	subscriptions := requestDTO.AddSubscriptionReqToSubscriptionModels(reqDTOs)
 
+	if onConflict := utils.ParseQueryStringToString(r, onConflictQueryParam, ""); onConflict != "" {
+		if err = application.ValidateConflictStrategy(onConflict); err != nil {
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+		var importResponses []interface{}
+		for i, s := range subscriptions {
+			reqId := reqDTOs[i].RequestId
+			result, err := application.ImportSubscription(s, onConflict, ctx, sc.dic)
+			if err != nil {
+				lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+				lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+				// the failed response still reports the diff of the subscription conflicting with the existing one
+				importResponses = append(importResponses, SubscriptionImportResponse{
+					BaseResponse:             commonDTO.NewBaseResponse(reqId, err.Message(), err.Code()),
+					SubscriptionImportResult: result,
+				})
+				continue
+			}
+			importResponses = append(importResponses, newSubscriptionImportResponse(reqId, result))
+		}
+		utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+		return pkg.EncodeAndWriteResponse(importResponses, w, lc)
+	}
+
 	var addResponses []interface{}
 	for i, s := range subscriptions {
 		var response interface{}
@@ -78,6 +105,29 @@ func (sc *SubscriptionController) AddSubscription(c echo.Context) error {
 	return pkg.EncodeAndWriteResponse(addResponses, w, lc)
 }
 
+// onConflictQueryParam is the query param to specify the strategy to resolve the conflict of the imported subscriptions
+const onConflictQueryParam = "onConflict"
+
+// SubscriptionImportResponse defines the response of a subscription imported with the onConflict strategy
+type SubscriptionImportResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	application.SubscriptionImportResult
+}
+
+// newSubscriptionImportResponse returns the response of the imported subscription. The response of an added
+// subscription has the 201 status code, and the response of a skipped, overwritten or merged subscription has the 200
+// status code.
+func newSubscriptionImportResponse(reqId string, result application.SubscriptionImportResult) SubscriptionImportResponse {
+	statusCode := http.StatusOK
+	if result.Created {
+		statusCode = http.StatusCreated
+	}
+	return SubscriptionImportResponse{
+		BaseResponse:             commonDTO.NewBaseResponse(reqId, "", statusCode),
+		SubscriptionImportResult: result,
+	}
+}
+
 func (sc *SubscriptionController) AllSubscriptions(c echo.Context) error {
 	lc := container.LoggingClientFrom(sc.dic.Get)
 	r := c.Request()
@@ -119,6 +169,75 @@ func (sc *SubscriptionController) SubscriptionByName(c echo.Context) error {
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
 
+// SubscriptionOrderingResponse defines the response of the subscription ordering query
+type SubscriptionOrderingResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Ordering               application.SubscriptionOrdering `json:"ordering"`
+}
+
+// SubscriptionOrderingByName returns whether the notifications of the specified subscription are dispatched in order
+func (sc *SubscriptionController) SubscriptionOrderingByName(c echo.Context) error {
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	ordering, err := application.SubscriptionOrderingByName(name, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := SubscriptionOrderingResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		Ordering:     ordering,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// dryRunQueryParam is the query param to only report the subscriptions which would be changed
+const dryRunQueryParam = "dryRun"
+
+// BulkToggleSubscriptionsResponse defines the response of the subscription bulk enable/disable by label
+type BulkToggleSubscriptionsResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	DryRun                 bool     `json:"dryRun"`
+	SubscriptionNames      []string `json:"subscriptionNames"`
+}
+
+// BulkToggleSubscriptionsByLabel enables or disables the subscriptions carrying the specified label
+func (sc *SubscriptionController) BulkToggleSubscriptionsByLabel(c echo.Context) error {
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	label := c.Param(common.Label)
+	enabled, parsingErr := strconv.ParseBool(c.Param(constants.Enabled))
+	if parsingErr != nil {
+		err := errors.NewCommonEdgeX(errors.KindContractInvalid, "enabled format parsing failed", parsingErr)
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	dryRun := utils.ParseQueryStringToString(r, dryRunQueryParam, common.ValueFalse) == common.ValueTrue
+
+	names, err := application.BulkToggleSubscriptionsByLabel(label, enabled, dryRun, ctx, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := BulkToggleSubscriptionsResponse{
+		BaseResponse:      commonDTO.NewBaseResponse("", "", http.StatusOK),
+		DryRun:            dryRun,
+		SubscriptionNames: names,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
 func (sc *SubscriptionController) SubscriptionsByCategory(c echo.Context) error {
 	lc := container.LoggingClientFrom(sc.dic.Get)
 	r := c.Request()
@@ -13,8 +13,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
 
@@ -52,7 +54,7 @@ var (
 )
 
 func mockDic() *di.Container {
-	return di.NewContainer(di.ServiceConstructorMap{
+	dic := di.NewContainer(di.ServiceConstructorMap{
 		container.ConfigurationName: func(get di.Get) interface{} {
 			return &config.ConfigurationStruct{
 				Writable: config.WritableInfo{
@@ -67,6 +69,8 @@ func mockDic() *di.Container {
 			return logger.NewMockClient()
 		},
 	})
+	application.RegisterDispatchState(dic)
+	return dic
 }
 
 func addSubscriptionRequestData() requests.AddSubscriptionRequest {
@@ -208,6 +212,62 @@ func TestAddSubscription(t *testing.T) {
 	}
 }
 
+func TestAddSubscription_OnConflict(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	request := addSubscriptionRequestData()
+	stored := dtos.ToSubscriptionModel(request.Subscription)
+	stored.Id = ExampleUUID
+	stored.Description = "stored description"
+	dbClientMock.On("SubscriptionByName", stored.Name).Return(stored, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewSubscriptionController(dic)
+
+	tests := []struct {
+		name               string
+		onConflict         string
+		expectedStatusCode int
+		expectedResponse   int
+	}{
+		{"Valid - skip", application.ConflictStrategySkip, http.StatusMultiStatus, http.StatusOK},
+		{"Invalid - fail", application.ConflictStrategyFail, http.StatusMultiStatus, http.StatusConflict},
+		{"Invalid - unknown strategy", "replace", http.StatusBadRequest, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			jsonData, err := json.Marshal([]requests.AddSubscriptionRequest{request})
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, common.ApiSubscriptionRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(onConflictQueryParam, testCase.onConflict)
+			req.URL.RawQuery = query.Encode()
+
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.AddSubscription(c)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusBadRequest {
+				return
+			}
+			var res []SubscriptionImportResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+			assert.Equal(t, testCase.expectedResponse, res[0].StatusCode, "BaseResponse status code not as expected")
+			assert.Equal(t, testCase.onConflict, res[0].AppliedStrategy)
+			require.Len(t, res[0].Diff, 1)
+			assert.Equal(t, "description", res[0].Diff[0].Field)
+		})
+	}
+}
+
 func TestAllSubscriptions(t *testing.T) {
 	subscription := dtos.ToSubscriptionModel(addSubscriptionRequestData().Subscription)
 	subscriptions := []models.Subscription{subscription, subscription, subscription}
@@ -350,6 +410,74 @@ func TestSubscriptionByName(t *testing.T) {
 	}
 }
 
+func TestSubscriptionOrderingByName(t *testing.T) {
+	subscription := dtos.ToSubscriptionModel(addSubscriptionRequestData().Subscription)
+	emptyName := ""
+	notFoundName := "notFoundName"
+
+	dic := mockDic()
+	container.ConfigurationFrom(dic.Get).Writable.Ordering.Subscriptions = []string{subscription.Name}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionByName", subscription.Name).Return(subscription, nil)
+	dbClientMock.On("SubscriptionByName", notFoundName).Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewSubscriptionController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		subscriptionName   string
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid - find subscription ordering by name", subscription.Name, false, http.StatusOK},
+		{"Invalid - name parameter is empty", emptyName, true, http.StatusBadRequest},
+		{"Invalid - subscription not found by name", notFoundName, true, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			reqPath := fmt.Sprintf("%s/%s/%s", common.ApiSubscriptionRoute+"/"+common.Name, testCase.subscriptionName, constants.Ordering)
+			req, err := http.NewRequest(http.MethodGet, reqPath, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.subscriptionName)
+			err = controller.SubscriptionOrderingByName(c)
+			require.NoError(t, err)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res SubscriptionOrderingResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.Equal(t, testCase.subscriptionName, res.Ordering.SubscriptionName, "Name not as expected")
+				assert.True(t, res.Ordering.Ordered, "Ordered not as expected")
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+			}
+		})
+	}
+}
+
 func TestSubscriptionsByCategory(t *testing.T) {
 	testCategory := "category"
 	expectedSubscriptionCount := uint32(0)
@@ -9,6 +9,7 @@ import (
 	"math"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
@@ -25,6 +26,9 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+// reportDefaultRange is the time range of the subscription delivery report when the start is not specified
+const reportDefaultRange = 7 * 24 * time.Hour
+
 type TransmissionController struct {
 	dic *di.Container
 }
@@ -174,6 +178,44 @@ func (tc *TransmissionController) TransmissionsBySubscriptionName(c echo.Context
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
 
+// SubscriptionDeliveryReportResponse defines the response of the subscription delivery report
+type SubscriptionDeliveryReportResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Report                 application.DeliveryReport `json:"report"`
+}
+
+// SubscriptionDeliveryReport summarizes the transmissions of the specified subscription created in the time range given
+// by the start and end query parameters in milliseconds. The time range defaults to the last week.
+func (tc *TransmissionController) SubscriptionDeliveryReport(c echo.Context) error {
+	lc := container.LoggingClientFrom(tc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	subscriptionName := c.Param(common.Name)
+	end, err := utils.ParseQueryStringToInt64(c, common.End, time.Now().UnixMilli(), 0, math.MaxInt64)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	start, err := utils.ParseQueryStringToInt64(c, common.Start, end-reportDefaultRange.Milliseconds(), 0, math.MaxInt64)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	report, err := application.SubscriptionDeliveryReport(subscriptionName, start, end, tc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := SubscriptionDeliveryReportResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		Report:       report,
+	}
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
 // TransmissionsByNotificationId queries transmission by Notification ID
 func (tc *TransmissionController) TransmissionsByNotificationId(c echo.Context) error {
 	lc := container.LoggingClientFrom(tc.dic.Get)
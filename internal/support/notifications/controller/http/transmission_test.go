@@ -11,7 +11,10 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
 
@@ -25,6 +28,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -544,3 +548,84 @@ func TestTransmissionsByNotificationId(t *testing.T) {
 		})
 	}
 }
+
+func TestSubscriptionDeliveryReport(t *testing.T) {
+	testName := "testName"
+	notFoundName := "notFound"
+	transmissions := []models.Transmission{
+		{SubscriptionName: testName, Status: models.Sent, Records: []models.TransmissionRecord{{Status: models.Sent, Sent: 1000}}},
+		{SubscriptionName: testName, Status: models.Acknowledged, ResendCount: 1, Records: []models.TransmissionRecord{{Status: models.Failed, Sent: 1000}, {Status: models.Sent, Sent: 1400}}},
+		{SubscriptionName: testName, Status: models.Escalated, ResendCount: 2, Records: []models.TransmissionRecord{{Status: models.Failed, Sent: 1000}, {Status: models.Failed, Sent: 2000}}},
+	}
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionByName", testName).Return(models.Subscription{Name: testName}, nil)
+	dbClientMock.On("SubscriptionByName", notFoundName).Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
+	dbClientMock.On("TransmissionsBySubscriptionNameAndTimeRange", int64(0), int64(100), 0, -1, testName).Return(transmissions, nil)
+	dbClientMock.On("TransmissionsBySubscriptionNameAndTimeRange", mock.Anything, mock.Anything, 0, -1, testName).Return([]models.Transmission{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewTransmissionController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		subscriptionName   string
+		start              string
+		end                string
+		expectedReport     application.DeliveryReport
+		expectedStatusCode int
+	}{
+		{"Valid - report in the time range", testName, "0", "100", application.DeliveryReport{SubscriptionName: testName, End: 100, Total: 3, Sent: 2, Failed: 1, Retried: 2, AverageLatency: 200}, http.StatusOK},
+		{"Valid - report in the default time range", testName, "", "", application.DeliveryReport{SubscriptionName: testName}, http.StatusOK},
+		{"Invalid - end before start", testName, "100", "50", application.DeliveryReport{}, http.StatusBadRequest},
+		{"Invalid - invalid start format", testName, "aaa", "", application.DeliveryReport{}, http.StatusBadRequest},
+		{"Invalid - subscription name is empty", "", "0", "100", application.DeliveryReport{}, http.StatusBadRequest},
+		{"Invalid - subscription not found", notFoundName, "0", "100", application.DeliveryReport{}, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiTransmissionReportBySubscriptionNameRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			if testCase.start != "" {
+				query.Add(common.Start, testCase.start)
+			}
+			if testCase.end != "" {
+				query.Add(common.End, testCase.end)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.subscriptionName)
+			err = controller.SubscriptionDeliveryReport(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			var res SubscriptionDeliveryReportResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			if testCase.start == "" {
+				assert.Equal(t, (7 * 24 * time.Hour).Milliseconds(), res.Report.End-res.Report.Start, "the default time range should be a week")
+				return
+			}
+			assert.Equal(t, testCase.expectedReport, res.Report)
+		})
+	}
+}
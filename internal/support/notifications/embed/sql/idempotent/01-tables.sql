@@ -25,3 +25,27 @@ CREATE TABLE IF NOT EXISTS support_notifications.transmission (
         REFERENCES support_notifications.notification(id)
         ON DELETE CASCADE
 );
+
+-- support_notifications.notification_template is used to store the named notification templates
+CREATE TABLE IF NOT EXISTS support_notifications.notification_template (
+    id UUID PRIMARY KEY,
+    content JSONB NOT NULL
+);
+
+-- support_notifications.dispatch_audit is used to store the append-only dispatch audit records of the notifications,
+-- which are kept after the notifications are deleted
+CREATE TABLE IF NOT EXISTS support_notifications.dispatch_audit (
+    id UUID PRIMARY KEY,
+    notification_id UUID NOT NULL,
+    sequence INTEGER NOT NULL,
+    content JSONB NOT NULL,
+    UNIQUE (notification_id, sequence)
+);
+
+-- support_notifications.dead_letter is used to store the notifications whose transmissions failed permanently, which
+-- are kept after the notifications are deleted
+CREATE TABLE IF NOT EXISTS support_notifications.dead_letter (
+    id UUID PRIMARY KEY,
+    content JSONB NOT NULL,
+    created timestamp NOT NULL DEFAULT (now() AT TIME ZONE 'utc')
+);
@@ -6,6 +6,8 @@
 package interfaces
 
 import (
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
@@ -34,6 +36,8 @@ type DBClient interface {
 	NotificationsByCategory(offset, limit int, ack, category string) ([]models.Notification, errors.EdgeX)
 	NotificationsByLabel(offset, limit int, ack, label string) ([]models.Notification, errors.EdgeX)
 	NotificationsByStatus(offset, limit int, ack, status string) ([]models.Notification, errors.EdgeX)
+	NotificationsBySender(offset, limit int, sender string) ([]models.Notification, errors.EdgeX)
+	NotificationsByContentSearch(offset, limit int, query string) ([]models.Notification, errors.EdgeX)
 	NotificationsByTimeRange(start int64, end int64, offset int, limit int, ack string) ([]models.Notification, errors.EdgeX)
 	NotificationsByQueryConditions(offset, limit int, condition requests.NotificationQueryCondition, ack string) ([]models.Notification, errors.EdgeX)
 	DeleteNotificationById(id string) errors.EdgeX
@@ -42,15 +46,21 @@ type DBClient interface {
 	UpdateNotification(s models.Notification) errors.EdgeX
 	UpdateNotificationAckStatusByIds(ack bool, ids []string) errors.EdgeX
 	CleanupNotificationsByAge(age int64) errors.EdgeX
+	CleanupNotificationsByCategoryAndAge(category string, age int64) errors.EdgeX
+	CleanupNotificationsByAgeExcludingCategories(age int64, categories []string) errors.EdgeX
 	DeleteProcessedNotificationsByAge(age int64) errors.EdgeX
 	NotificationCountByCategory(category string, ack string) (uint32, errors.EdgeX)
 	NotificationCountByLabel(label string, ack string) (uint32, errors.EdgeX)
 	NotificationCountByStatus(status string, ack string) (uint32, errors.EdgeX)
+	NotificationCountBySender(sender string) (uint32, errors.EdgeX)
+	NotificationCountByContentSearch(query string) (uint32, errors.EdgeX)
 	NotificationCountByTimeRange(start int64, end int64, ack string) (uint32, errors.EdgeX)
 	NotificationCountByCategoriesAndLabels(categories []string, labels []string, ack string) (uint32, errors.EdgeX)
 	NotificationCountByQueryConditions(condition requests.NotificationQueryCondition, ack string) (uint32, errors.EdgeX)
 	NotificationTotalCount() (uint32, errors.EdgeX)
 	LatestNotificationByOffset(offset uint32) (models.Notification, errors.EdgeX)
+	LatestNotificationByCategoryAndOffset(category string, offset uint32) (models.Notification, errors.EdgeX)
+	LatestNotificationByOffsetExcludingCategories(offset uint32, categories []string) (models.Notification, errors.EdgeX)
 
 	AddTransmission(trans models.Transmission) (models.Transmission, errors.EdgeX)
 	UpdateTransmission(trans models.Transmission) errors.EdgeX
@@ -60,10 +70,29 @@ type DBClient interface {
 	TransmissionsByStatus(offset, limit int, status string) ([]models.Transmission, errors.EdgeX)
 	DeleteProcessedTransmissionsByAge(age int64) errors.EdgeX
 	TransmissionsBySubscriptionName(offset, limit int, subscriptionName string) ([]models.Transmission, errors.EdgeX)
+	TransmissionsBySubscriptionNameAndTimeRange(start int64, end int64, offset, limit int, subscriptionName string) ([]models.Transmission, errors.EdgeX)
 	TransmissionTotalCount() (uint32, errors.EdgeX)
 	TransmissionCountBySubscriptionName(subscriptionName string) (uint32, errors.EdgeX)
 	TransmissionCountByStatus(status string) (uint32, errors.EdgeX)
 	TransmissionCountByTimeRange(start int64, end int64) (uint32, errors.EdgeX)
 	TransmissionsByNotificationId(offset, limit int, id string) ([]models.Transmission, errors.EdgeX)
 	TransmissionCountByNotificationId(id string) (uint32, errors.EdgeX)
+
+	AddNotificationTemplate(t notificationModels.NotificationTemplate) (notificationModels.NotificationTemplate, errors.EdgeX)
+	NotificationTemplateByName(name string) (notificationModels.NotificationTemplate, errors.EdgeX)
+	AllNotificationTemplates(offset int, limit int) ([]notificationModels.NotificationTemplate, errors.EdgeX)
+	UpdateNotificationTemplate(t notificationModels.NotificationTemplate) errors.EdgeX
+	DeleteNotificationTemplateByName(name string) errors.EdgeX
+	NotificationTemplateTotalCount() (uint32, errors.EdgeX)
+
+	AddDispatchAuditRecord(r notificationModels.DispatchAuditRecord) errors.EdgeX
+	DispatchAuditRecordsByNotificationId(notificationId string) ([]notificationModels.DispatchAuditRecord, errors.EdgeX)
+	LatestDispatchAuditRecordByNotificationId(notificationId string) (notificationModels.DispatchAuditRecord, errors.EdgeX)
+
+	AddDeadLetter(d notificationModels.DeadLetter) (notificationModels.DeadLetter, errors.EdgeX)
+	DeadLetterById(id string) (notificationModels.DeadLetter, errors.EdgeX)
+	AllDeadLetters(offset int, limit int) ([]notificationModels.DeadLetter, errors.EdgeX)
+	DeadLetterTotalCount() (uint32, errors.EdgeX)
+	DeleteDeadLetterById(id string) errors.EdgeX
+	CleanupDeadLettersByAge(age int64) errors.EdgeX
 }
@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
 	models "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	requests "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
@@ -17,6 +19,56 @@ type DBClient struct {
 	mock.Mock
 }
 
+// AddDeadLetter provides a mock function with given fields: d
+func (_m *DBClient) AddDeadLetter(d notificationModels.DeadLetter) (notificationModels.DeadLetter, errors.EdgeX) {
+	ret := _m.Called(d)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDeadLetter")
+	}
+
+	var r0 notificationModels.DeadLetter
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationModels.DeadLetter) (notificationModels.DeadLetter, errors.EdgeX)); ok {
+		return rf(d)
+	}
+	if rf, ok := ret.Get(0).(func(notificationModels.DeadLetter) notificationModels.DeadLetter); ok {
+		r0 = rf(d)
+	} else {
+		r0 = ret.Get(0).(notificationModels.DeadLetter)
+	}
+
+	if rf, ok := ret.Get(1).(func(notificationModels.DeadLetter) errors.EdgeX); ok {
+		r1 = rf(d)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddDispatchAuditRecord provides a mock function with given fields: r
+func (_m *DBClient) AddDispatchAuditRecord(r notificationModels.DispatchAuditRecord) errors.EdgeX {
+	ret := _m.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDispatchAuditRecord")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationModels.DispatchAuditRecord) errors.EdgeX); ok {
+		r0 = rf(r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // AddNotification provides a mock function with given fields: n
 func (_m *DBClient) AddNotification(n models.Notification) (models.Notification, errors.EdgeX) {
 	ret := _m.Called(n)
@@ -47,6 +99,36 @@ func (_m *DBClient) AddNotification(n models.Notification) (models.Notification,
 	return r0, r1
 }
 
+// AddNotificationTemplate provides a mock function with given fields: t
+func (_m *DBClient) AddNotificationTemplate(t notificationModels.NotificationTemplate) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	ret := _m.Called(t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddNotificationTemplate")
+	}
+
+	var r0 notificationModels.NotificationTemplate
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationModels.NotificationTemplate) (notificationModels.NotificationTemplate, errors.EdgeX)); ok {
+		return rf(t)
+	}
+	if rf, ok := ret.Get(0).(func(notificationModels.NotificationTemplate) notificationModels.NotificationTemplate); ok {
+		r0 = rf(t)
+	} else {
+		r0 = ret.Get(0).(notificationModels.NotificationTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(notificationModels.NotificationTemplate) errors.EdgeX); ok {
+		r1 = rf(t)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddSubscription provides a mock function with given fields: e
 func (_m *DBClient) AddSubscription(e models.Subscription) (models.Subscription, errors.EdgeX) {
 	ret := _m.Called(e)
@@ -107,6 +189,70 @@ func (_m *DBClient) AddTransmission(trans models.Transmission) (models.Transmiss
 	return r0, r1
 }
 
+// AllDeadLetters provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllDeadLetters(offset int, limit int) ([]notificationModels.DeadLetter, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllDeadLetters")
+	}
+
+	var r0 []notificationModels.DeadLetter
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int) ([]notificationModels.DeadLetter, errors.EdgeX)); ok {
+		return rf(offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []notificationModels.DeadLetter); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationModels.DeadLetter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllNotificationTemplates provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllNotificationTemplates(offset int, limit int) ([]notificationModels.NotificationTemplate, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllNotificationTemplates")
+	}
+
+	var r0 []notificationModels.NotificationTemplate
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int) ([]notificationModels.NotificationTemplate, errors.EdgeX)); ok {
+		return rf(offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []notificationModels.NotificationTemplate); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationModels.NotificationTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllSubscriptions provides a mock function with given fields: offset, limit
 func (_m *DBClient) AllSubscriptions(offset int, limit int) ([]models.Subscription, errors.EdgeX) {
 	ret := _m.Called(offset, limit)
@@ -171,6 +317,26 @@ func (_m *DBClient) AllTransmissions(offset int, limit int) ([]models.Transmissi
 	return r0, r1
 }
 
+// CleanupDeadLettersByAge provides a mock function with given fields: age
+func (_m *DBClient) CleanupDeadLettersByAge(age int64) errors.EdgeX {
+	ret := _m.Called(age)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CleanupDeadLettersByAge")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
+		r0 = rf(age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // CleanupNotificationsByAge provides a mock function with given fields: age
 func (_m *DBClient) CleanupNotificationsByAge(age int64) errors.EdgeX {
 	ret := _m.Called(age)
@@ -191,11 +357,131 @@ func (_m *DBClient) CleanupNotificationsByAge(age int64) errors.EdgeX {
 	return r0
 }
 
+// CleanupNotificationsByAgeExcludingCategories provides a mock function with given fields: age, categories
+func (_m *DBClient) CleanupNotificationsByAgeExcludingCategories(age int64, categories []string) errors.EdgeX {
+	ret := _m.Called(age, categories)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CleanupNotificationsByAgeExcludingCategories")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64, []string) errors.EdgeX); ok {
+		r0 = rf(age, categories)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// CleanupNotificationsByCategoryAndAge provides a mock function with given fields: category, age
+func (_m *DBClient) CleanupNotificationsByCategoryAndAge(category string, age int64) errors.EdgeX {
+	ret := _m.Called(category, age)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CleanupNotificationsByCategoryAndAge")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, int64) errors.EdgeX); ok {
+		r0 = rf(category, age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // CloseSession provides a mock function with given fields:
 func (_m *DBClient) CloseSession() {
 	_m.Called()
 }
 
+// DeadLetterById provides a mock function with given fields: id
+func (_m *DBClient) DeadLetterById(id string) (notificationModels.DeadLetter, errors.EdgeX) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeadLetterById")
+	}
+
+	var r0 notificationModels.DeadLetter
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (notificationModels.DeadLetter, errors.EdgeX)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) notificationModels.DeadLetter); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(notificationModels.DeadLetter)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeadLetterTotalCount provides a mock function with given fields:
+func (_m *DBClient) DeadLetterTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeadLetterTotalCount")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func() (uint32, errors.EdgeX)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteDeadLetterById provides a mock function with given fields: id
+func (_m *DBClient) DeleteDeadLetterById(id string) errors.EdgeX {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDeadLetterById")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteNotificationById provides a mock function with given fields: id
 func (_m *DBClient) DeleteNotificationById(id string) errors.EdgeX {
 	ret := _m.Called(id)
@@ -236,6 +522,26 @@ func (_m *DBClient) DeleteNotificationByIds(ids []string) errors.EdgeX {
 	return r0
 }
 
+// DeleteNotificationTemplateByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteNotificationTemplateByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNotificationTemplateByName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteProcessedNotificationsByAge provides a mock function with given fields: age
 func (_m *DBClient) DeleteProcessedNotificationsByAge(age int64) errors.EdgeX {
 	ret := _m.Called(age)
@@ -296,6 +602,98 @@ func (_m *DBClient) DeleteSubscriptionByName(name string) errors.EdgeX {
 	return r0
 }
 
+// DispatchAuditRecordsByNotificationId provides a mock function with given fields: notificationId
+func (_m *DBClient) DispatchAuditRecordsByNotificationId(notificationId string) ([]notificationModels.DispatchAuditRecord, errors.EdgeX) {
+	ret := _m.Called(notificationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DispatchAuditRecordsByNotificationId")
+	}
+
+	var r0 []notificationModels.DispatchAuditRecord
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) ([]notificationModels.DispatchAuditRecord, errors.EdgeX)); ok {
+		return rf(notificationId)
+	}
+	if rf, ok := ret.Get(0).(func(string) []notificationModels.DispatchAuditRecord); ok {
+		r0 = rf(notificationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationModels.DispatchAuditRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(notificationId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// LatestDispatchAuditRecordByNotificationId provides a mock function with given fields: notificationId
+func (_m *DBClient) LatestDispatchAuditRecordByNotificationId(notificationId string) (notificationModels.DispatchAuditRecord, errors.EdgeX) {
+	ret := _m.Called(notificationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestDispatchAuditRecordByNotificationId")
+	}
+
+	var r0 notificationModels.DispatchAuditRecord
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (notificationModels.DispatchAuditRecord, errors.EdgeX)); ok {
+		return rf(notificationId)
+	}
+	if rf, ok := ret.Get(0).(func(string) notificationModels.DispatchAuditRecord); ok {
+		r0 = rf(notificationId)
+	} else {
+		r0 = ret.Get(0).(notificationModels.DispatchAuditRecord)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(notificationId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// LatestNotificationByCategoryAndOffset provides a mock function with given fields: category, offset
+func (_m *DBClient) LatestNotificationByCategoryAndOffset(category string, offset uint32) (models.Notification, errors.EdgeX) {
+	ret := _m.Called(category, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestNotificationByCategoryAndOffset")
+	}
+
+	var r0 models.Notification
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, uint32) (models.Notification, errors.EdgeX)); ok {
+		return rf(category, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, uint32) models.Notification); ok {
+		r0 = rf(category, offset)
+	} else {
+		r0 = ret.Get(0).(models.Notification)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, uint32) errors.EdgeX); ok {
+		r1 = rf(category, offset)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // LatestNotificationByOffset provides a mock function with given fields: offset
 func (_m *DBClient) LatestNotificationByOffset(offset uint32) (models.Notification, errors.EdgeX) {
 	ret := _m.Called(offset)
@@ -326,6 +724,36 @@ func (_m *DBClient) LatestNotificationByOffset(offset uint32) (models.Notificati
 	return r0, r1
 }
 
+// LatestNotificationByOffsetExcludingCategories provides a mock function with given fields: offset, categories
+func (_m *DBClient) LatestNotificationByOffsetExcludingCategories(offset uint32, categories []string) (models.Notification, errors.EdgeX) {
+	ret := _m.Called(offset, categories)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestNotificationByOffsetExcludingCategories")
+	}
+
+	var r0 models.Notification
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(uint32, []string) (models.Notification, errors.EdgeX)); ok {
+		return rf(offset, categories)
+	}
+	if rf, ok := ret.Get(0).(func(uint32, []string) models.Notification); ok {
+		r0 = rf(offset, categories)
+	} else {
+		r0 = ret.Get(0).(models.Notification)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint32, []string) errors.EdgeX); ok {
+		r1 = rf(offset, categories)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // NotificationById provides a mock function with given fields: id
 func (_m *DBClient) NotificationById(id string) (models.Notification, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -416,6 +844,36 @@ func (_m *DBClient) NotificationCountByCategory(category string, ack string) (ui
 	return r0, r1
 }
 
+// NotificationCountByContentSearch provides a mock function with given fields: query
+func (_m *DBClient) NotificationCountByContentSearch(query string) (uint32, errors.EdgeX) {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationCountByContentSearch")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (uint32, errors.EdgeX)); ok {
+		return rf(query)
+	}
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(query)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(query)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // NotificationCountByLabel provides a mock function with given fields: label, ack
 func (_m *DBClient) NotificationCountByLabel(label string, ack string) (uint32, errors.EdgeX) {
 	ret := _m.Called(label, ack)
@@ -476,6 +934,36 @@ func (_m *DBClient) NotificationCountByQueryConditions(condition requests.Notifi
 	return r0, r1
 }
 
+// NotificationCountBySender provides a mock function with given fields: sender
+func (_m *DBClient) NotificationCountBySender(sender string) (uint32, errors.EdgeX) {
+	ret := _m.Called(sender)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationCountBySender")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (uint32, errors.EdgeX)); ok {
+		return rf(sender)
+	}
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(sender)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(sender)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // NotificationCountByStatus provides a mock function with given fields: status, ack
 func (_m *DBClient) NotificationCountByStatus(status string, ack string) (uint32, errors.EdgeX) {
 	ret := _m.Called(status, ack)
@@ -536,6 +1024,66 @@ func (_m *DBClient) NotificationCountByTimeRange(start int64, end int64, ack str
 	return r0, r1
 }
 
+// NotificationTemplateByName provides a mock function with given fields: name
+func (_m *DBClient) NotificationTemplateByName(name string) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationTemplateByName")
+	}
+
+	var r0 notificationModels.NotificationTemplate
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (notificationModels.NotificationTemplate, errors.EdgeX)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) notificationModels.NotificationTemplate); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(notificationModels.NotificationTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// NotificationTemplateTotalCount provides a mock function with given fields:
+func (_m *DBClient) NotificationTemplateTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationTemplateTotalCount")
+	}
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func() (uint32, errors.EdgeX)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // NotificationTotalCount provides a mock function with given fields:
 func (_m *DBClient) NotificationTotalCount() (uint32, errors.EdgeX) {
 	ret := _m.Called()
@@ -630,6 +1178,38 @@ func (_m *DBClient) NotificationsByCategory(offset int, limit int, ack string, c
 	return r0, r1
 }
 
+// NotificationsByContentSearch provides a mock function with given fields: offset, limit, query
+func (_m *DBClient) NotificationsByContentSearch(offset int, limit int, query string) ([]models.Notification, errors.EdgeX) {
+	ret := _m.Called(offset, limit, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationsByContentSearch")
+	}
+
+	var r0 []models.Notification
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]models.Notification, errors.EdgeX)); ok {
+		return rf(offset, limit, query)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string) []models.Notification); ok {
+		r0 = rf(offset, limit, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, query)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // NotificationsByLabel provides a mock function with given fields: offset, limit, ack, label
 func (_m *DBClient) NotificationsByLabel(offset int, limit int, ack string, label string) ([]models.Notification, errors.EdgeX) {
 	ret := _m.Called(offset, limit, ack, label)
@@ -694,6 +1274,38 @@ func (_m *DBClient) NotificationsByQueryConditions(offset int, limit int, condit
 	return r0, r1
 }
 
+// NotificationsBySender provides a mock function with given fields: offset, limit, sender
+func (_m *DBClient) NotificationsBySender(offset int, limit int, sender string) ([]models.Notification, errors.EdgeX) {
+	ret := _m.Called(offset, limit, sender)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationsBySender")
+	}
+
+	var r0 []models.Notification
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]models.Notification, errors.EdgeX)); ok {
+		return rf(offset, limit, sender)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string) []models.Notification); ok {
+		r0 = rf(offset, limit, sender)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, sender)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // NotificationsByStatus provides a mock function with given fields: offset, limit, ack, status
 func (_m *DBClient) NotificationsByStatus(offset int, limit int, ack string, status string) ([]models.Notification, errors.EdgeX) {
 	ret := _m.Called(offset, limit, ack, status)
@@ -1342,6 +1954,38 @@ func (_m *DBClient) TransmissionsBySubscriptionName(offset int, limit int, subsc
 	return r0, r1
 }
 
+// TransmissionsBySubscriptionNameAndTimeRange provides a mock function with given fields: start, end, offset, limit, subscriptionName
+func (_m *DBClient) TransmissionsBySubscriptionNameAndTimeRange(start int64, end int64, offset int, limit int, subscriptionName string) ([]models.Transmission, errors.EdgeX) {
+	ret := _m.Called(start, end, offset, limit, subscriptionName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransmissionsBySubscriptionNameAndTimeRange")
+	}
+
+	var r0 []models.Transmission
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64, int64, int, int, string) ([]models.Transmission, errors.EdgeX)); ok {
+		return rf(start, end, offset, limit, subscriptionName)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64, int, int, string) []models.Transmission); ok {
+		r0 = rf(start, end, offset, limit, subscriptionName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Transmission)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64, int, int, string) errors.EdgeX); ok {
+		r1 = rf(start, end, offset, limit, subscriptionName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // TransmissionsByTimeRange provides a mock function with given fields: start, end, offset, limit
 func (_m *DBClient) TransmissionsByTimeRange(start int64, end int64, offset int, limit int) ([]models.Transmission, errors.EdgeX) {
 	ret := _m.Called(start, end, offset, limit)
@@ -1414,6 +2058,26 @@ func (_m *DBClient) UpdateNotificationAckStatusByIds(ack bool, ids []string) err
 	return r0
 }
 
+// UpdateNotificationTemplate provides a mock function with given fields: t
+func (_m *DBClient) UpdateNotificationTemplate(t notificationModels.NotificationTemplate) errors.EdgeX {
+	ret := _m.Called(t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNotificationTemplate")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationModels.NotificationTemplate) errors.EdgeX); ok {
+		r0 = rf(t)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // UpdateSubscription provides a mock function with given fields: s
 func (_m *DBClient) UpdateSubscription(s models.Subscription) errors.EdgeX {
 	ret := _m.Called(s)
@@ -54,6 +54,9 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 	emailSender := channel.NewEmailSender(dic)
 	mqttSender := channel.NewMQTTSender(ctx, wg, dic)
 	zeroMQSender := channel.NewZeroMQSender(ctx, wg, dic)
+	webhookSender := channel.NewWebhookSender(dic)
+	slackSender := channel.NewSlackSender(dic)
+	teamsSender := channel.NewTeamsSender(dic)
 	dic.Update(di.ServiceConstructorMap{
 		channel.RESTSenderName: func(get di.Get) interface{} {
 			return restSender
@@ -67,11 +70,97 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 		channel.ZeroMQTSenderName: func(get di.Get) interface{} {
 			return zeroMQSender
 		},
+		channel.WebhookSenderName: func(get di.Get) interface{} {
+			return webhookSender
+		},
+		channel.SlackSenderName: func(get di.Get) interface{} {
+			return slackSender
+		},
+		channel.TeamsSenderName: func(get di.Get) interface{} {
+			return teamsSender
+		},
 	})
+	application.RegisterDispatchState(dic)
+
+	application.RegisterMetrics(dic)
 
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	if err := application.RedistributePendingNotifications(dic); err != nil {
+		lc.Errorf("Failed to redistribute the pending notifications, %v", err)
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+		application.DrainNotificationDispatch(dic)
+	}()
+
 	config := container.ConfigurationFrom(dic.Get)
+	if err := config.Writable.ValidateSeverityOrder(); err != nil {
+		lc.Errorf("Invalid notification severity order configuration: %v", err)
+		return false
+	}
+	if err := config.Writable.ValidateCategoryRateLimits(); err != nil {
+		lc.Errorf("Invalid notification category rate limit configuration: %v", err)
+		return false
+	}
+	if err := config.Writable.ValidateSubscriptionSendRates(); err != nil {
+		lc.Errorf("Invalid notification subscription send rate configuration: %v", err)
+		return false
+	}
+	if err := config.Writable.ValidateDeliveryPolicy(); err != nil {
+		lc.Errorf("Invalid notification delivery policy configuration: %v", err)
+		return false
+	}
+	if err := config.Writable.ValidateDeliveryWindows(); err != nil {
+		lc.Errorf("Invalid notification delivery window configuration: %v", err)
+		return false
+	}
+	if err := config.Writable.ValidateFailureAlert(); err != nil {
+		lc.Errorf("Invalid notification failure alert configuration: %v", err)
+		return false
+	}
+	if err := config.Writable.ValidateMaxRecipients(); err != nil {
+		lc.Errorf("Invalid notification max recipients configuration: %v", err)
+		return false
+	}
+	if err := config.Writable.ValidateDigestCallback(); err != nil {
+		lc.Errorf("Invalid notification digest callback configuration: %v", err)
+		return false
+	}
+	if err := channel.ValidateSmtpAuthMode(config.EffectiveSmtpInfo()); err != nil {
+		lc.Errorf("Invalid notification SMTP configuration: %v", err)
+		return false
+	}
+	if err := channel.ValidateWebhookInfo(config.Webhook); err != nil {
+		lc.Errorf("Invalid notification webhook configuration: %v", err)
+		return false
+	}
+	if err := channel.ValidateSlackInfo(config.Slack); err != nil {
+		lc.Errorf("Invalid notification Slack configuration: %v", err)
+		return false
+	}
+	if err := channel.ValidateTeamsInfo(config.Teams); err != nil {
+		lc.Errorf("Invalid notification Teams configuration: %v", err)
+		return false
+	}
+	if err := channel.ValidateResendLimitByFailure(config.Writable.ResendLimitByFailure); err != nil {
+		lc.Errorf("Invalid notification resend limit configuration: %v", err)
+		return false
+	}
 	if config.Retention.Enabled {
+		if err := config.Retention.ValidateMaxAge(); err != nil {
+			lc.Errorf("Invalid notification retention configuration: %v", err)
+			return false
+		}
+		if err := config.Retention.ValidateCategories(); err != nil {
+			lc.Errorf("Invalid notification retention configuration: %v", err)
+			return false
+		}
+		if err := config.Retention.ValidateTransmission(); err != nil {
+			lc.Errorf("Invalid transmission retention configuration: %v", err)
+			return false
+		}
 		retentionInterval, err := time.ParseDuration(config.Retention.Interval)
 		if err != nil {
 			lc.Errorf("Failed to parse notification retention interval, %v", err)
@@ -79,5 +168,13 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 		}
 		application.AsyncPurgeNotification(retentionInterval, ctx, dic)
 	}
+	if config.SubscriptionExpiry.Enabled {
+		expiryInterval, err := time.ParseDuration(config.SubscriptionExpiry.Interval)
+		if err != nil || expiryInterval <= 0 {
+			lc.Errorf("Invalid subscription expiry interval '%s', %v", config.SubscriptionExpiry.Interval, err)
+			return false
+		}
+		application.AsyncPurgeExpiredSubscriptions(expiryInterval, ctx, dic)
+	}
 	return true
 }
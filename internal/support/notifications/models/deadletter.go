@@ -0,0 +1,29 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+)
+
+// DeadLetter records a notification whose transmission to a channel of a subscription failed permanently, i.e. the
+// failed transmission wasn't resent, or it was escalated after exhausting the resend limit. The dead letter keeps a copy
+// of the notification along with the last error and the attempt count, so the failure can be diagnosed after the
+// notification and its transmissions are purged, until the dead letter is re-enqueued, deleted or purged.
+type DeadLetter struct {
+	Id               string            `json:"id"`
+	Notification     dtos.Notification `json:"notification"`
+	SubscriptionName string            `json:"subscriptionName"`
+	Channel          dtos.Address      `json:"channel"`
+	TransmissionId   string            `json:"transmissionId"`
+	// Status is the final status of the transmission, which is FAILED or ESCALATED
+	Status string `json:"status"`
+	// AttemptCount is the count of the sends of the transmission, including the resends
+	AttemptCount int `json:"attemptCount"`
+	// LastError is the response of the last failed send
+	LastError string `json:"lastError,omitempty"`
+	Created   int64  `json:"created"`
+}
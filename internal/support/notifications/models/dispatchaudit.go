@@ -0,0 +1,59 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+)
+
+// The events of the dispatch audit records
+const (
+	// DispatchAuditAccepted records the notification accepted for the dispatch, along with the correlation id
+	DispatchAuditAccepted = "ACCEPTED"
+	// DispatchAuditRouted records the subscriptions the notification is routed to and the criteria they matched by
+	DispatchAuditRouted = "ROUTED"
+	// DispatchAuditExpired records an expired subscription the notification is not dispatched to
+	DispatchAuditExpired = "EXPIRED"
+	// DispatchAuditThrottled records the notification not dispatched for exceeding the rate limit of its category
+	DispatchAuditThrottled = "THROTTLED"
+	// DispatchAuditAttempted records a send or a resend of the notification to a channel of a subscription
+	DispatchAuditAttempted = "ATTEMPTED"
+	// DispatchAuditResent records the manual resend of the notification, along with the correlation id and the subscriptions
+	// the notification is resent to
+	DispatchAuditResent = "RESENT"
+	// DispatchAuditEscalated records the transmission escalated after the resend limit is exceeded
+	DispatchAuditEscalated = "ESCALATED"
+)
+
+// DispatchAuditRecord is an append-only record of a dispatch decision of a notification, which is kept separately from
+// the transmissions. The records of a notification are numbered by the Sequence, and the Hash of each record covers the
+// PreviousHash of the record before it, so a modified, removed or reordered record breaks the hash chain.
+type DispatchAuditRecord struct {
+	Id             string `json:"id"`
+	NotificationId string `json:"notificationId"`
+	Sequence       int    `json:"sequence"`
+	Timestamp      int64  `json:"timestamp"`
+	CorrelationId  string `json:"correlationId,omitempty"`
+	Event          string `json:"event"`
+	// Matches are the subscriptions the notification is routed to, with the routing criteria they matched by
+	Matches          []SubscriptionMatch `json:"matches,omitempty"`
+	SubscriptionName string              `json:"subscriptionName,omitempty"`
+	Channel          *dtos.Address       `json:"channel,omitempty"`
+	TransmissionId   string              `json:"transmissionId,omitempty"`
+	Status           string              `json:"status,omitempty"`
+	Response         string              `json:"response,omitempty"`
+	ResendCount      int                 `json:"resendCount,omitempty"`
+	PreviousHash     string              `json:"previousHash,omitempty"`
+	Hash             string              `json:"hash"`
+}
+
+// SubscriptionMatch is a subscription the notification is routed to, along with the categories and the labels of the
+// subscription which matched the notification
+type SubscriptionMatch struct {
+	SubscriptionName string   `json:"subscriptionName"`
+	Categories       []string `json:"categories,omitempty"`
+	Labels           []string `json:"labels,omitempty"`
+}
@@ -0,0 +1,19 @@
+//
+// Copyright (C) 2025 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// NotificationTemplate is a named, reusable template of the notification content. The Content is a Go text/template
+// rendered with the notification when it is dispatched to the subscriptions referencing the template.
+type NotificationTemplate struct {
+	Id          string `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name        string `json:"name" validate:"required,edgex-dto-none-empty-string"`
+	Description string `json:"description,omitempty"`
+	Content     string `json:"content" validate:"required"`
+	// ContentType replaces the content type of the rendered notification if not empty
+	ContentType string `json:"contentType,omitempty"`
+	Created     int64  `json:"created,omitempty"`
+	Modified    int64  `json:"modified,omitempty"`
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
 	notificationsController "github.com/edgexfoundry/edgex-go/internal/support/notifications/controller/http"
 
 	"github.com/labstack/echo/v4"
@@ -28,22 +29,43 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.POST(common.ApiSubscriptionRoute, sc.AddSubscription, authenticationHook)
 	r.GET(common.ApiAllSubscriptionRoute, sc.AllSubscriptions, authenticationHook)
 	r.GET(common.ApiSubscriptionByNameRoute, sc.SubscriptionByName, authenticationHook)
+	r.GET(constants.ApiSubscriptionOrderingByNameRoute, sc.SubscriptionOrderingByName, authenticationHook)
 	r.GET(common.ApiSubscriptionByCategoryRoute, sc.SubscriptionsByCategory, authenticationHook)
 	r.GET(common.ApiSubscriptionByLabelRoute, sc.SubscriptionsByLabel, authenticationHook)
 	r.GET(common.ApiSubscriptionByReceiverRoute, sc.SubscriptionsByReceiver, authenticationHook)
 	r.DELETE(common.ApiSubscriptionByNameRoute, sc.DeleteSubscriptionByName, authenticationHook)
 	r.PATCH(common.ApiSubscriptionRoute, sc.PatchSubscription, authenticationHook)
+	r.PUT(constants.ApiSubscriptionEnabledByLabelRoute, sc.BulkToggleSubscriptionsByLabel, authenticationHook)
+
+	// NotificationTemplate
+	ntc := notificationsController.NewNotificationTemplateController(dic)
+	r.POST(constants.ApiNotificationTemplateRoute, ntc.AddNotificationTemplate, authenticationHook)
+	r.PUT(constants.ApiNotificationTemplateRoute, ntc.UpdateNotificationTemplate, authenticationHook)
+	r.GET(constants.ApiAllNotificationTemplateRoute, ntc.AllNotificationTemplates, authenticationHook)
+	r.GET(constants.ApiNotificationTemplateByNameRoute, ntc.NotificationTemplateByName, authenticationHook)
+	r.DELETE(constants.ApiNotificationTemplateByNameRoute, ntc.DeleteNotificationTemplateByName, authenticationHook)
+
+	// DeadLetter
+	dc := notificationsController.NewDeadLetterController(dic)
+	r.GET(constants.ApiAllDeadLetterRoute, dc.AllDeadLetters, authenticationHook)
+	r.POST(constants.ApiDeadLetterReEnqueueByIdRoute, dc.ReEnqueueDeadLetterById, authenticationHook)
+	r.DELETE(constants.ApiDeadLetterByIdRoute, dc.DeleteDeadLetterById, authenticationHook)
 
 	// Notification
 	nc := notificationsController.NewNotificationController(dic)
 	r.POST(common.ApiNotificationRoute, nc.AddNotification, authenticationHook)
 	r.GET(common.ApiNotificationRoute, nc.NotificationsByQueryConditions, authenticationHook)
 	r.GET(common.ApiNotificationByIdRoute, nc.NotificationById, authenticationHook)
+	r.GET(constants.ApiNotificationDeliveryByIdRoute, nc.NotificationDeliveryById, authenticationHook)
+	r.GET(constants.ApiNotificationDispatchAuditByIdRoute, nc.NotificationDispatchAuditById, authenticationHook)
+	r.POST(constants.ApiNotificationResendByIdRoute, nc.ResendNotificationById, authenticationHook)
 	r.DELETE(common.ApiNotificationByIdRoute, nc.DeleteNotificationById, authenticationHook)
 	r.DELETE(common.ApiNotificationByIdsRoute, nc.DeleteNotificationByIds, authenticationHook)
 	r.GET(common.ApiNotificationByCategoryRoute, nc.NotificationsByCategory, authenticationHook)
 	r.GET(common.ApiNotificationByLabelRoute, nc.NotificationsByLabel, authenticationHook)
 	r.GET(common.ApiNotificationByStatusRoute, nc.NotificationsByStatus, authenticationHook)
+	r.GET(constants.ApiNotificationBySenderRoute, nc.NotificationsBySender, authenticationHook)
+	r.GET(constants.ApiNotificationContentSearchRoute, nc.NotificationsByContentSearch, authenticationHook)
 	r.GET(common.ApiNotificationByTimeRangeRoute, nc.NotificationsByTimeRange, authenticationHook)
 	r.GET(common.ApiNotificationBySubscriptionNameRoute, nc.NotificationsBySubscriptionName, authenticationHook)
 	r.DELETE(common.ApiNotificationCleanupByAgeRoute, nc.CleanupNotificationsByAge, authenticationHook)
@@ -60,5 +82,6 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiTransmissionByStatusRoute, trans.TransmissionsByStatus, authenticationHook)
 	r.DELETE(common.ApiTransmissionByAgeRoute, trans.DeleteProcessedTransmissionsByAge, authenticationHook)
 	r.GET(common.ApiTransmissionBySubscriptionNameRoute, trans.TransmissionsBySubscriptionName, authenticationHook)
+	r.GET(constants.ApiTransmissionReportBySubscriptionNameRoute, trans.SubscriptionDeliveryReport, authenticationHook)
 	r.GET(common.ApiTransmissionByNotificationIdRoute, trans.TransmissionsByNotificationId, authenticationHook)
 }